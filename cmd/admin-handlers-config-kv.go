@@ -31,6 +31,7 @@ import (
 	"minio/cmd/config/cache"
 	xldap "minio/cmd/config/identity/ldap"
 	"minio/cmd/config/identity/openid"
+	idplugin "minio/cmd/config/identity/plugin"
 	"minio/cmd/config/policy/opa"
 	"minio/cmd/config/storageclass"
 	"minio/cmd/logger"
@@ -447,6 +448,8 @@ func (a adminAPIHandlers) GetConfigHandler(w http.ResponseWriter, r *http.Reques
 				off = !openid.Enabled(kv)
 			case config.IdentityLDAPSubSys:
 				off = !xldap.Enabled(kv)
+			case config.IdentityPluginSubSys:
+				off = !idplugin.Enabled(kv)
 			}
 			if off {
 				s.WriteString(config.KvComment)
@@ -0,0 +1,114 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"minio/cmd/logger"
+	"minio/pkg/event"
+	iampolicy "minio/pkg/iam/policy"
+)
+
+// ListNotifyTargetEventsHandler - GET /minio/admin/v3/notify/target/events?target={id:name}&deadletter={bool}&max={n}
+// ----------
+// Lists the undelivered events in the queue store of the notification
+// target on all servers.
+func (a adminAPIHandlers) ListNotifyTargetEventsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := NewContext(r, w, "ListNotifyTargetEvents")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ServerInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	var max int
+	if maxStr := r.URL.Query().Get("max"); maxStr != "" {
+		var err error
+		if max, err = strconv.Atoi(maxStr); err != nil {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminInvalidArgument), r.URL)
+			return
+		}
+	}
+
+	a.notifyTargetQueue(w, r, notifyTargetQueueList, max)
+}
+
+// ReplayNotifyTargetEventsHandler - POST /minio/admin/v3/notify/target/replay?target={id:name}
+// ----------
+// Moves the dead-lettered events of the notification target back to its
+// queue on all servers.
+func (a adminAPIHandlers) ReplayNotifyTargetEventsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := NewContext(r, w, "ReplayNotifyTargetEvents")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ConfigUpdateAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	a.notifyTargetQueue(w, r, notifyTargetQueueReplay, 0)
+}
+
+// PurgeNotifyTargetEventsHandler - DELETE /minio/admin/v3/notify/target/events?target={id:name}&deadletter={bool}
+// ----------
+// Deletes the queued, or dead-lettered, events of the notification
+// target on all servers.
+func (a adminAPIHandlers) PurgeNotifyTargetEventsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := NewContext(r, w, "PurgeNotifyTargetEvents")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ConfigUpdateAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	a.notifyTargetQueue(w, r, notifyTargetQueuePurge, 0)
+}
+
+// notifyTargetQueue - performs op on the queue store of the target given
+// by the request on all servers and replies with the per server results.
+func (a adminAPIHandlers) notifyTargetQueue(w http.ResponseWriter, r *http.Request, op string, max int) {
+	ctx := r.Context()
+
+	targetID, err := event.ParseTargetID(r.URL.Query().Get("target"))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminInvalidArgument), r.URL)
+		return
+	}
+	deadLetter := r.URL.Query().Get("deadletter") == "true"
+
+	queues, err := GlobalNotificationSys.NotifyTargetQueue(ctx, r, *targetID, op, deadLetter, max)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(queues)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
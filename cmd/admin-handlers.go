@@ -881,6 +881,67 @@ func getAggregatedBackgroundHealState(ctx context.Context, o ObjectLayer) (madmi
 	return bgHealStates, nil
 }
 
+// getAggregatedBitrotScrubStatus returns the bitrot scrub report of all disks.
+func getAggregatedBitrotScrubStatus(ctx context.Context, o ObjectLayer) (madmin.BitrotScrubStatus, error) {
+	// Get local scrub status first
+	status := getLocalBitrotScrubStatus(ctx, o)
+
+	var peersStatus []madmin.BitrotScrubStatus
+	if globalIsDistErasure {
+		// Get scrub status from other peers
+		var nerrs []NotificationPeerErr
+		peersStatus, nerrs = GlobalNotificationSys.BitrotScrubStatus()
+		var errCount int
+		for _, nerr := range nerrs {
+			if nerr.Err != nil {
+				logger.LogIf(ctx, nerr.Err)
+				errCount++
+			}
+		}
+		if errCount == len(nerrs) {
+			return madmin.BitrotScrubStatus{}, fmt.Errorf("all remote servers failed to report bitrot scrub status, cluster is unhealthy")
+		}
+	}
+	status.Merge(peersStatus...)
+
+	return status, nil
+}
+
+// BitrotScrubStatusHandler - GET /minio/admin/v3/scrub/status
+// ----------
+// Returns the bitrot scrub progress and the corrupted shards found
+// and repaired during the current or last cycle of every disk.
+func (a adminAPIHandlers) BitrotScrubStatusHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := NewContext(r, w, "BitrotScrubStatus")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	// Check if this setup has an erasure coded backend.
+	if !globalIsErasure {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrHealNotImplemented), r.URL)
+		return
+	}
+
+	status, err := getAggregatedBitrotScrubStatus(ctx, objectAPI)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	data, err := json.Marshal(status)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
 func (a adminAPIHandlers) BackgroundHealStatusHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := NewContext(r, w, "HealBackgroundStatus")
 
@@ -911,6 +972,163 @@ func (a adminAPIHandlers) BackgroundHealStatusHandler(w http.ResponseWriter, r *
 	w.(http.Flusher).Flush()
 }
 
+// HealDriveHandler - POST /minio/admin/v3/heal-drive?endpoint={endpoint}&action={replace|pause|resume}&buckets={b1,b2}
+// ----------
+// Marks the drive as replaced and heals it right away, healing the given
+// buckets first, or pauses and resumes healing of the drive.
+func (a adminAPIHandlers) HealDriveHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := NewContext(r, w, "HealDrive")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.HealAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	// Check if this setup has an erasure coded backend.
+	if !globalIsErasure {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrHealNotImplemented), r.URL)
+		return
+	}
+
+	vars := mux.Vars(r)
+	ep, ok := globalEndpoints.FindEndpoint(vars["endpoint"])
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminInvalidArgument), r.URL)
+		return
+	}
+	action := vars["action"]
+	switch action {
+	case healDriveReplace, healDrivePause, healDriveResume:
+	default:
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminInvalidArgument), r.URL)
+		return
+	}
+	var buckets []string
+	if b := r.URL.Query().Get("buckets"); b != "" {
+		buckets = strings.Split(b, ",")
+	}
+
+	var err error
+	if ep.IsLocal {
+		err = healLocalDrive(ctx, ep, action, buckets)
+	} else {
+		err = GlobalNotificationSys.HealDrive(ep, action, buckets)
+	}
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessNoContent(w)
+}
+
+// MaintenanceHandler - POST /minio/admin/v3/maintenance?node={host:port}&action={start|stop}&drain-timeout={duration}
+// ----------
+// Puts a node in maintenance, draining its in-flight requests and taking
+// its drives offline for new writes, or takes it out of maintenance
+// healing the objects written while it was in maintenance.
+func (a adminAPIHandlers) MaintenanceHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := NewContext(r, w, "Maintenance")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ServiceRestartAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	// Maintenance is only supported by distributed setups.
+	z, ok := objectAPI.(*erasureServerPools)
+	if !ok || !globalIsDistErasure {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	vars := mux.Vars(r)
+	node, action := vars["node"], vars["action"]
+	if !globalEndpoints.HasHost(node) {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminInvalidArgument), r.URL)
+		return
+	}
+	drainTimeout := defaultMaintenanceDrainTimeout
+	if v := r.URL.Query().Get("drain-timeout"); v != "" {
+		var err error
+		if drainTimeout, err = time.ParseDuration(v); err != nil || drainTimeout <= 0 {
+			writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminInvalidArgument), r.URL)
+			return
+		}
+	}
+
+	switch action {
+	case maintenanceStart:
+		if err := z.checkMaintenanceWriteQuorum(globalMaintenance.nodesWith(node)); err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+	case maintenanceStop:
+	default:
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminInvalidArgument), r.URL)
+		return
+	}
+
+	// A node which fails to drain its writes must not be left in
+	// maintenance, read-only, while the other servers still write
+	// to its drives, unless it already was in maintenance.
+	wasInMaintenance := globalMaintenance.inMaintenance(node)
+	rollback := func(err error) {
+		if action == maintenanceStart && !wasInMaintenance {
+			logger.LogIf(ctx, rollbackMaintenance(GlobalContext, objectAPI, node))
+		}
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+	}
+
+	if err := setLocalMaintenance(ctx, node, action, drainTimeout); err != nil {
+		rollback(err)
+		return
+	}
+	if err := globalMaintenance.save(ctx, objectAPI); err != nil {
+		rollback(err)
+		return
+	}
+
+	// The state is persisted, servers which fail to apply it
+	// pick it up on restart, the caller may also retry.
+	if err := applyMaintenance(ctx, node, action, drainTimeout); err != nil {
+		if errors.Is(err, errMaintenancePeers) {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+		rollback(err)
+		return
+	}
+
+	writeSuccessNoContent(w)
+}
+
+// MaintenanceStatusHandler - GET /minio/admin/v3/maintenance
+// ----------
+// Returns the nodes in maintenance.
+func (a adminAPIHandlers) MaintenanceStatusHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := NewContext(r, w, "MaintenanceStatus")
+
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.ServerInfoAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	data, err := json.Marshal(globalMaintenance.status())
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	writeSuccessResponseJSON(w, data)
+}
+
 func validateAdminReq(ctx context.Context, w http.ResponseWriter, r *http.Request, action iampolicy.AdminAction) (ObjectLayer, auth.Credentials) {
 	var cred auth.Credentials
 	var adminAPIErr APIErrorCode
@@ -1005,12 +1223,42 @@ func toAdminAPIErr(ctx context.Context, err error) APIError {
 				Description:    err.Error(),
 				HTTPStatusCode: http.StatusServiceUnavailable,
 			}
-		case errors.Is(err, crypto.ErrKESKeyExists):
+		case errors.Is(err, crypto.ErrKESKeyExists), errors.Is(err, kms.ErrKeyExists):
 			apiErr = APIError{
 				Code:           "XMinioKMSKeyExists",
 				Description:    err.Error(),
 				HTTPStatusCode: http.StatusConflict,
 			}
+		case errors.Is(err, errHealDriveNotHealing):
+			apiErr = APIError{
+				Code:           "XMinioHealDriveNotHealing",
+				Description:    err.Error(),
+				HTTPStatusCode: http.StatusConflict,
+			}
+		case errors.Is(err, errMaintenanceWriteQuorum):
+			apiErr = APIError{
+				Code:           "XMinioMaintenanceWriteQuorum",
+				Description:    err.Error(),
+				HTTPStatusCode: http.StatusConflict,
+			}
+		case errors.Is(err, errMaintenanceDrainTimeout):
+			apiErr = APIError{
+				Code:           "XMinioMaintenanceDrainTimeout",
+				Description:    err.Error(),
+				HTTPStatusCode: http.StatusServiceUnavailable,
+			}
+		case errors.Is(err, errMaintenancePeers):
+			apiErr = APIError{
+				Code:           "XMinioMaintenancePartialFailure",
+				Description:    err.Error(),
+				HTTPStatusCode: http.StatusServiceUnavailable,
+			}
+		case errors.Is(err, kms.ErrKeyNotFound):
+			apiErr = APIError{
+				Code:           "XMinioKMSKeyNotFound",
+				Description:    err.Error(),
+				HTTPStatusCode: http.StatusNotFound,
+			}
 		default:
 			apiErr = errorCodes.ToAPIErrWithErr(toAdminAPIErrCode(ctx, err), err)
 		}
@@ -1247,6 +1495,342 @@ func (a adminAPIHandlers) KMSCreateKeyHandler(w http.ResponseWriter, r *http.Req
 	writeSuccessResponseHeadersOnly(w)
 }
 
+// KMSRotateKeyHandler - POST /minio/admin/v3/kms/key/rotate?bucket=<bucket>&prefix=<prefix>&key-id=<master-key-id>
+// ----------
+// Starts re-sealing the object keys of a bucket with the current
+// version of their KMS master keys in the background.
+func (a adminAPIHandlers) KMSRotateKeyHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := NewContext(r, w, "KMSRotateKey")
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.KMSRotateKeyAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	if GlobalKMS == nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrKMSNotConfigured), r.URL)
+		return
+	}
+
+	query := r.URL.Query()
+	bucket := query.Get("bucket")
+	if _, err := objectAPI.GetBucketInfo(ctx, bucket); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	// The rotation outlives the request.
+	status, err := globalKMSKeyRotationSys.Start(GlobalContext, objectAPI, bucket, query.Get("prefix"), query.Get("key-id"))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	resp, err := json.Marshal(status)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, resp)
+}
+
+// KMSKeyRotationStatusHandler - GET /minio/admin/v3/kms/key/rotate/status?bucket=<bucket>
+func (a adminAPIHandlers) KMSKeyRotationStatusHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := NewContext(r, w, "KMSKeyRotationStatus")
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.KMSKeyStatusAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	status, err := globalKMSKeyRotationSys.Status(ctx, objectAPI, r.URL.Query().Get("bucket"))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	resp, err := json.Marshal(status)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, resp)
+}
+
+// KMSMigrateConfigHandler - POST /minio/admin/v3/kms/config/migrate
+// ----------
+// Starts re-encrypting the server config, IAM data and bucket metadata,
+// which is encrypted with the root credentials or stored in plaintext,
+// with the KMS in the background.
+func (a adminAPIHandlers) KMSMigrateConfigHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := NewContext(r, w, "KMSMigrateConfig")
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.KMSMigrateConfigAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	if GlobalKMS == nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrKMSNotConfigured), r.URL)
+		return
+	}
+
+	// The migration outlives the request.
+	status, err := globalKMSConfigMigrationSys.Start(GlobalContext, objectAPI)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	resp, err := json.Marshal(status)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, resp)
+}
+
+// KMSConfigMigrationStatusHandler - GET /minio/admin/v3/kms/config/migrate/status
+func (a adminAPIHandlers) KMSConfigMigrationStatusHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := NewContext(r, w, "KMSConfigMigrationStatus")
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.KMSKeyStatusAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	status, err := globalKMSConfigMigrationSys.Status(ctx, objectAPI)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	resp, err := json.Marshal(status)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, resp)
+}
+
+// KMSListKeysHandler - GET /minio/admin/v3/kms/key/list?pattern=<pattern>
+// ----------
+// Lists the master keys of the KMS matching the glob pattern together
+// with the buckets whose default encryption uses them.
+func (a adminAPIHandlers) KMSListKeysHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := NewContext(r, w, "KMSListKeys")
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.KMSListKeysAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	if GlobalKMS == nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrKMSNotConfigured), r.URL)
+		return
+	}
+	lister, ok := GlobalKMS.(kms.KeyLister)
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	keys, err := lister.ListKeys(r.URL.Query().Get("pattern"))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	stat, err := GlobalKMS.Stat()
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	infos := make([]madmin.KMSKeyInfo, 0, len(keys))
+	for _, key := range keys {
+		buckets, err := bucketsUsingKMSKey(ctx, objectAPI, key.KeyID, stat.DefaultKey)
+		if err != nil {
+			writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+			return
+		}
+		infos = append(infos, madmin.KMSKeyInfo{
+			KeyID:     key.KeyID,
+			CreatedAt: key.CreatedAt,
+			Versions:  key.Versions,
+			Default:   key.KeyID == stat.DefaultKey,
+			Buckets:   buckets,
+		})
+	}
+
+	resp, err := json.Marshal(infos)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, resp)
+}
+
+// KMSDescribeKeyHandler - GET /minio/admin/v3/kms/key/describe?key-id=<master-key-id>
+func (a adminAPIHandlers) KMSDescribeKeyHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := NewContext(r, w, "KMSDescribeKey")
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.KMSKeyStatusAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	if GlobalKMS == nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrKMSNotConfigured), r.URL)
+		return
+	}
+	lister, ok := GlobalKMS.(kms.KeyLister)
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	stat, err := GlobalKMS.Stat()
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	keyID := r.URL.Query().Get("key-id")
+	if keyID == "" {
+		keyID = stat.DefaultKey
+	}
+
+	keys, err := lister.ListKeys(keyID)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	var info *madmin.KMSKeyInfo
+	for _, key := range keys {
+		if key.KeyID == keyID {
+			info = &madmin.KMSKeyInfo{
+				KeyID:     key.KeyID,
+				CreatedAt: key.CreatedAt,
+				Versions:  key.Versions,
+				Default:   key.KeyID == stat.DefaultKey,
+			}
+			break
+		}
+	}
+	if info == nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, kms.ErrKeyNotFound), r.URL)
+		return
+	}
+	if info.Buckets, err = bucketsUsingKMSKey(ctx, objectAPI, keyID, stat.DefaultKey); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+
+	resp, err := json.Marshal(info)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseJSON(w, resp)
+}
+
+// KMSDeleteKeyHandler - DELETE /minio/admin/v3/kms/key/delete?key-id=<master-key-id>
+// ----------
+// Deletes a master key at the KMS. The default key and keys used by the
+// default encryption of a bucket cannot be deleted. Objects encrypted
+// with a deleted key can no longer be decrypted.
+func (a adminAPIHandlers) KMSDeleteKeyHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := NewContext(r, w, "KMSDeleteKey")
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, _ := validateAdminReq(ctx, w, r, iampolicy.KMSDeleteKeyAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	if GlobalKMS == nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrKMSNotConfigured), r.URL)
+		return
+	}
+	deleter, ok := GlobalKMS.(kms.KeyDeleter)
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	stat, err := GlobalKMS.Stat()
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	keyID := r.URL.Query().Get("key-id")
+	if keyID == "" || keyID == stat.DefaultKey {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, errKMSKeyInUse), r.URL)
+		return
+	}
+	buckets, err := bucketsUsingKMSKey(ctx, objectAPI, keyID, stat.DefaultKey)
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	if len(buckets) > 0 {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, errKMSKeyInUse), r.URL)
+		return
+	}
+
+	if err = deleter.DeleteKey(keyID); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseHeadersOnly(w)
+}
+
+// KMSImportKeyHandler - POST /minio/admin/v3/kms/key/import?key-id=<master-key-id>
+// ----------
+// Creates a new master key from externally generated key material. The
+// request body contains the key material encrypted with the secret key
+// of the requester.
+func (a adminAPIHandlers) KMSImportKeyHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := NewContext(r, w, "KMSImportKey")
+	defer logger.AuditLog(ctx, w, r, mustGetClaimsFromToken(r))
+
+	objectAPI, cred := validateAdminReq(ctx, w, r, iampolicy.KMSImportKeyAdminAction)
+	if objectAPI == nil {
+		return
+	}
+
+	if GlobalKMS == nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrKMSNotConfigured), r.URL)
+		return
+	}
+	importer, ok := GlobalKMS.(kms.KeyImporter)
+	if !ok {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrNotImplemented), r.URL)
+		return
+	}
+
+	if r.ContentLength > maxEConfigJSONSize || r.ContentLength == -1 {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminConfigTooLarge), r.URL)
+		return
+	}
+	key, err := madmin.DecryptData(cred.SecretKey, io.LimitReader(r.Body, r.ContentLength))
+	if err != nil {
+		writeErrorResponseJSON(ctx, w, errorCodes.ToAPIErr(ErrAdminConfigBadJSON), r.URL)
+		return
+	}
+
+	if err = importer.ImportKey(r.URL.Query().Get("key-id"), key); err != nil {
+		writeErrorResponseJSON(ctx, w, toAdminAPIErr(ctx, err), r.URL)
+		return
+	}
+	writeSuccessResponseHeadersOnly(w)
+}
+
 // KMSKeyStatusHandler - GET /minio/admin/v3/kms/key/status?key-id=<master-key-id>
 func (a adminAPIHandlers) KMSKeyStatusHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := NewContext(r, w, "KMSKeyStatus")
@@ -25,12 +25,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"path/filepath"
 	"sync"
 	"testing"
 
 	"github.com/gorilla/mux"
 
 	"minio/pkg/auth"
+	"minio/pkg/kms"
 	"minio/pkg/madmin"
 )
 
@@ -285,6 +287,89 @@ func TestAdminServerInfo(t *testing.T) {
 	}
 }
 
+func TestKMSKeyAdminHandlers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	adminTestBed, err := prepareAdminErasureTestBed(ctx)
+	if err != nil {
+		t.Fatal("Failed to initialize a single node Erasure backend for admin handler tests.")
+	}
+	defer adminTestBed.TearDown()
+
+	store, err := kms.NewKeyStore(filepath.Join(t.TempDir(), "keys.json"), "default-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func(k kms.KMS) { GlobalKMS = k }(GlobalKMS)
+	GlobalKMS = store
+
+	do := func(method, path string, queryVal url.Values, body []byte) *httptest.ResponseRecorder {
+		req, err := buildAdminRequest(queryVal, method, path, int64(len(body)), bytes.NewReader(body))
+		if err != nil {
+			t.Fatalf("Failed to construct %s request - %v", path, err)
+		}
+		rec := httptest.NewRecorder()
+		adminTestBed.router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	keyMaterial := make([]byte, 32)
+	body, err := madmin.EncryptData(globalActiveCred.SecretKey, keyMaterial)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec := do(http.MethodPost, "/kms/key/import", url.Values{"key-id": {"imported-key"}}, body); rec.Code != http.StatusOK {
+		t.Fatalf("Failed to import key: %d %s", rec.Code, rec.Body.String())
+	}
+	if rec := do(http.MethodPost, "/kms/key/import", url.Values{"key-id": {"imported-key"}}, body); rec.Code != http.StatusConflict {
+		t.Fatalf("Importing an existing key: expected %d but got %d", http.StatusConflict, rec.Code)
+	}
+
+	rec := do(http.MethodGet, "/kms/key/list", url.Values{"pattern": {"imported-*"}}, nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Failed to list keys: %d %s", rec.Code, rec.Body.String())
+	}
+	var keys []madmin.KMSKeyInfo
+	if err = json.NewDecoder(rec.Body).Decode(&keys); err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || keys[0].KeyID != "imported-key" || keys[0].Versions != 1 {
+		t.Fatalf("Unexpected key list: %v", keys)
+	}
+
+	rec = do(http.MethodGet, "/kms/key/describe", url.Values{"key-id": {"imported-key"}}, nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Failed to describe key: %d %s", rec.Code, rec.Body.String())
+	}
+	var info madmin.KMSKeyInfo
+	if err = json.NewDecoder(rec.Body).Decode(&info); err != nil {
+		t.Fatal(err)
+	}
+	if info.KeyID != "imported-key" || info.CreatedAt.IsZero() || info.Default {
+		t.Fatalf("Unexpected key description: %v", info)
+	}
+
+	if rec = do(http.MethodDelete, "/kms/key/delete", url.Values{"key-id": {"default-key"}}, nil); rec.Code != http.StatusConflict {
+		t.Fatalf("Deleting the default key: expected %d but got %d", http.StatusConflict, rec.Code)
+	}
+	if rec = do(http.MethodDelete, "/kms/key/delete", url.Values{"key-id": {"imported-key"}}, nil); rec.Code != http.StatusOK {
+		t.Fatalf("Failed to delete key: %d %s", rec.Code, rec.Body.String())
+	}
+	if rec = do(http.MethodGet, "/kms/key/describe", url.Values{"key-id": {"imported-key"}}, nil); rec.Code != http.StatusNotFound {
+		t.Fatalf("Describing a deleted key: expected %d but got %d", http.StatusNotFound, rec.Code)
+	}
+
+	// The single key KMS does not support managing keys.
+	GlobalKMS, err = kms.New("my-key", make([]byte, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec = do(http.MethodGet, "/kms/key/list", url.Values{}, nil); rec.Code != http.StatusNotImplemented {
+		t.Fatalf("Listing keys of a single key KMS: expected %d but got %d", http.StatusNotImplemented, rec.Code)
+	}
+}
+
 // TestToAdminAPIErrCode - test for toAdminAPIErrCode helper function.
 func TestToAdminAPIErrCode(t *testing.T) {
 	testCases := []struct {
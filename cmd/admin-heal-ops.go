@@ -92,14 +92,22 @@ type allHealState struct {
 	healSeqMap     map[string]*healSequence // Indexed by endpoint
 	healLocalDisks map[Endpoint]struct{}
 	healStatus     map[string]healingTracker // Indexed by disk ID
+
+	// Admin controls of local disk heals.
+	healPausedDisks   map[Endpoint]bool
+	healPriorityDisks map[Endpoint][]string
+	healDisksTrigger  chan struct{}
 }
 
 // newHealState - initialize global heal state management
 func newHealState(cleanup bool) *allHealState {
 	hstate := &allHealState{
-		healSeqMap:     make(map[string]*healSequence),
-		healLocalDisks: map[Endpoint]struct{}{},
-		healStatus:     make(map[string]healingTracker),
+		healSeqMap:        make(map[string]*healSequence),
+		healLocalDisks:    map[Endpoint]struct{}{},
+		healStatus:        make(map[string]healingTracker),
+		healPausedDisks:   map[Endpoint]bool{},
+		healPriorityDisks: map[Endpoint][]string{},
+		healDisksTrigger:  make(chan struct{}, 1),
 	}
 	if cleanup {
 		go hstate.periodicHealSeqsClean(GlobalContext)
@@ -120,6 +128,8 @@ func (ahs *allHealState) popHealLocalDisks(healLocalDisks ...Endpoint) {
 
 	for _, ep := range healLocalDisks {
 		delete(ahs.healLocalDisks, ep)
+		delete(ahs.healPausedDisks, ep)
+		delete(ahs.healPriorityDisks, ep)
 	}
 	for id, disk := range ahs.healStatus {
 		for _, ep := range healLocalDisks {
@@ -183,6 +193,67 @@ func (ahs *allHealState) pushHealLocalDisks(healLocalDisks ...Endpoint) {
 	}
 }
 
+// isHealLocalDisk returns whether the local disk is queued for healing.
+func (ahs *allHealState) isHealLocalDisk(ep Endpoint) bool {
+	ahs.RLock()
+	defer ahs.RUnlock()
+
+	_, ok := ahs.healLocalDisks[ep]
+	return ok
+}
+
+// triggerHealLocalDisks wakes up the monitoring of local
+// disks to heal without waiting for the next interval.
+func (ahs *allHealState) triggerHealLocalDisks() {
+	select {
+	case ahs.healDisksTrigger <- struct{}{}:
+	default:
+	}
+}
+
+// setHealLocalDiskPaused pauses or resumes healing of the local disk.
+func (ahs *allHealState) setHealLocalDiskPaused(ep Endpoint, paused bool) {
+	ahs.Lock()
+	defer ahs.Unlock()
+
+	ahs.healPausedDisks[ep] = paused
+}
+
+// restoreHealLocalDiskPaused restores the pause state of the local disk
+// persisted before a restart, unless it was changed since.
+func (ahs *allHealState) restoreHealLocalDiskPaused(ep Endpoint, paused bool) {
+	ahs.Lock()
+	defer ahs.Unlock()
+
+	if _, ok := ahs.healPausedDisks[ep]; !ok {
+		ahs.healPausedDisks[ep] = paused
+	}
+}
+
+// isHealLocalDiskPaused returns whether healing of the local disk is paused.
+func (ahs *allHealState) isHealLocalDiskPaused(ep Endpoint) bool {
+	ahs.RLock()
+	defer ahs.RUnlock()
+
+	return ahs.healPausedDisks[ep]
+}
+
+// setHealLocalDiskPriority sets the buckets to heal first on the local disk.
+func (ahs *allHealState) setHealLocalDiskPriority(ep Endpoint, buckets []string) {
+	ahs.Lock()
+	defer ahs.Unlock()
+
+	ahs.healPriorityDisks[ep] = buckets
+}
+
+// getHealLocalDiskPriority returns the buckets to heal first on the local disk.
+func (ahs *allHealState) getHealLocalDiskPriority(ep Endpoint) []string {
+	ahs.RLock()
+	defer ahs.RUnlock()
+
+	return ahs.healPriorityDisks[ep]
+}
+
 func (ahs *allHealState) periodicHealSeqsClean(ctx context.Context) {
 	// Launch clean-up routine to remove this heal sequence (after
 	// it ends) from the global state after timeout has elapsed.
@@ -72,6 +72,10 @@ func registerAdminRouter(router *mux.Router, enableConfigOps, enableIAMOps bool)
 			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/heal/{bucket}/{prefix:.*}").HandlerFunc(HTTPTraceAll(adminAPI.HealHandler))
 
 			adminRouter.Methods(http.MethodPost).Path(adminVersion + "/background-heal/status").HandlerFunc(HTTPTraceAll(adminAPI.BackgroundHealStatusHandler))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion+"/heal-drive").HandlerFunc(HTTPTraceAll(adminAPI.HealDriveHandler)).Queries("endpoint", "{endpoint:.*}", "action", "{action:.*}")
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/scrub/status").HandlerFunc(HTTPTraceAll(adminAPI.BitrotScrubStatusHandler))
+			adminRouter.Methods(http.MethodPost).Path(adminVersion+"/maintenance").HandlerFunc(HTTPTraceAll(adminAPI.MaintenanceHandler)).Queries("node", "{node:.*}", "action", "{action:.*}")
+			adminRouter.Methods(http.MethodGet).Path(adminVersion + "/maintenance").HandlerFunc(HTTPTraceAll(adminAPI.MaintenanceStatusHandler))
 
 			/// Health operations
 
@@ -178,6 +182,12 @@ func registerAdminRouter(router *mux.Router, enableConfigOps, enableIAMOps bool)
 			// PutBucketQuotaConfig
 			adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-quota").HandlerFunc(
 				HTTPTraceHdrs(adminAPI.PutBucketQuotaConfigHandler)).Queries("bucket", "{bucket:.*}")
+			// GetBucketStorageClassConfig
+			adminRouter.Methods(http.MethodGet).Path(adminVersion+"/get-bucket-storage-class").HandlerFunc(
+				HTTPTraceHdrs(adminAPI.GetBucketStorageClassConfigHandler)).Queries("bucket", "{bucket:.*}")
+			// PutBucketStorageClassConfig
+			adminRouter.Methods(http.MethodPut).Path(adminVersion+"/set-bucket-storage-class").HandlerFunc(
+				HTTPTraceHdrs(adminAPI.PutBucketStorageClassConfigHandler)).Queries("bucket", "{bucket:.*}")
 
 			// Bucket replication operations
 			// GetBucketTargetHandler
@@ -205,10 +215,23 @@ func registerAdminRouter(router *mux.Router, enableConfigOps, enableIAMOps bool)
 		// Console Logs
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/log").HandlerFunc(HTTPTraceAll(adminAPI.ConsoleLogHandler))
 
+		// -- Notification target queue APIs --
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/notify/target/events").HandlerFunc(HTTPTraceHdrs(adminAPI.ListNotifyTargetEventsHandler)).Queries("target", "{target:.*}")
+		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/notify/target/replay").HandlerFunc(HTTPTraceHdrs(adminAPI.ReplayNotifyTargetEventsHandler)).Queries("target", "{target:.*}")
+		adminRouter.Methods(http.MethodDelete).Path(adminVersion+"/notify/target/events").HandlerFunc(HTTPTraceHdrs(adminAPI.PurgeNotifyTargetEventsHandler)).Queries("target", "{target:.*}")
+
 		// -- KMS APIs --
 		//
 		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/kms/key/create").HandlerFunc(HTTPTraceAll(adminAPI.KMSCreateKeyHandler)).Queries("key-id", "{key-id:.*}")
 		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/kms/key/status").HandlerFunc(HTTPTraceAll(adminAPI.KMSKeyStatusHandler))
+		adminRouter.Methods(http.MethodPost).Path(adminVersion + "/kms/config/migrate").HandlerFunc(HTTPTraceAll(adminAPI.KMSMigrateConfigHandler))
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/kms/config/migrate/status").HandlerFunc(HTTPTraceAll(adminAPI.KMSConfigMigrationStatusHandler))
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/kms/key/list").HandlerFunc(HTTPTraceAll(adminAPI.KMSListKeysHandler))
+		adminRouter.Methods(http.MethodGet).Path(adminVersion + "/kms/key/describe").HandlerFunc(HTTPTraceAll(adminAPI.KMSDescribeKeyHandler))
+		adminRouter.Methods(http.MethodDelete).Path(adminVersion+"/kms/key/delete").HandlerFunc(HTTPTraceAll(adminAPI.KMSDeleteKeyHandler)).Queries("key-id", "{key-id:.*}")
+		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/kms/key/import").HandlerFunc(HTTPTraceHdrs(adminAPI.KMSImportKeyHandler)).Queries("key-id", "{key-id:.*}")
+		adminRouter.Methods(http.MethodPost).Path(adminVersion+"/kms/key/rotate").HandlerFunc(HTTPTraceAll(adminAPI.KMSRotateKeyHandler)).Queries("bucket", "{bucket:.*}")
+		adminRouter.Methods(http.MethodGet).Path(adminVersion+"/kms/key/rotate/status").HandlerFunc(HTTPTraceAll(adminAPI.KMSKeyRotationStatusHandler)).Queries("bucket", "{bucket:.*}")
 
 		if !GlobalIsGateway {
 			// Keep obdinfo for backward compatibility with mc
@@ -213,6 +213,7 @@ const (
 	ErrRegionNotification
 	ErrOverlappingFilterNotification
 	ErrFilterNameInvalid
+	ErrDuplicateFilterName
 	ErrFilterNamePrefix
 	ErrFilterNameSuffix
 	ErrFilterValueInvalid
@@ -969,7 +970,12 @@ var errorCodes = errorCodeMap{
 	},
 	ErrFilterNameInvalid: {
 		Code:           "InvalidArgument",
-		Description:    "filter rule name must be either prefix or suffix",
+		Description:    "filter rule name must be one of prefix, suffix, size-min, size-max, content-type, metadata:<name> or tag:<key>",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrDuplicateFilterName: {
+		Code:           "InvalidArgument",
+		Description:    "Cannot specify more than one rule with the same name in a filter.",
 		HTTPStatusCode: http.StatusBadRequest,
 	},
 	ErrFilterNamePrefix: {
@@ -1835,6 +1841,8 @@ func toAPIErrorCode(ctx context.Context, err error) (apiErr APIErrorCode) {
 		apiErr = ErrIncompatibleEncryptionMethod
 	case errKMSNotConfigured:
 		apiErr = ErrKMSNotConfigured
+	case errKMSKeyAccessDenied:
+		apiErr = ErrAccessDenied
 	case context.Canceled, context.DeadlineExceeded:
 		apiErr = ErrOperationTimedOut
 	case errDiskNotFound:
@@ -1985,6 +1993,8 @@ func toAPIErrorCode(ctx context.Context, err error) (apiErr APIErrorCode) {
 		apiErr = ErrFilterNamePrefix
 	case *event.ErrFilterNameSuffix:
 		apiErr = ErrFilterNameSuffix
+	case *event.ErrDuplicateFilterName:
+		apiErr = ErrDuplicateFilterName
 	case *event.ErrInvalidFilterValue:
 		apiErr = ErrFilterValueInvalid
 	case *event.ErrDuplicateEventName:
@@ -24,6 +24,7 @@ import (
 	"testing"
 
 	"minio/cmd/crypto"
+	"minio/pkg/event"
 	"minio/pkg/hash"
 )
 
@@ -61,6 +62,10 @@ var toAPIErrorTests = []struct {
 	{err: crypto.ErrCustomerKeyMD5Mismatch, errCode: ErrSSECustomerKeyMD5Mismatch},
 	{err: errObjectTampered, errCode: ErrObjectTampered},
 
+	// Bucket notification errors
+	{err: &event.ErrInvalidFilterName{FilterName: "unknown"}, errCode: ErrFilterNameInvalid},
+	{err: &event.ErrDuplicateFilterName{FilterName: "size-min"}, errCode: ErrDuplicateFilterName},
+
 	{err: nil, errCode: ErrNone},
 	{err: errors.New("Custom error"), errCode: ErrInternalError}, // Case where err type is unknown.
 }
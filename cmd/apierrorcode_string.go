@@ -145,149 +145,150 @@ func _() {
 	_ = x[ErrRegionNotification-134]
 	_ = x[ErrOverlappingFilterNotification-135]
 	_ = x[ErrFilterNameInvalid-136]
-	_ = x[ErrFilterNamePrefix-137]
-	_ = x[ErrFilterNameSuffix-138]
-	_ = x[ErrFilterValueInvalid-139]
-	_ = x[ErrOverlappingConfigs-140]
-	_ = x[ErrUnsupportedNotification-141]
-	_ = x[ErrContentSHA256Mismatch-142]
-	_ = x[ErrReadQuorum-143]
-	_ = x[ErrWriteQuorum-144]
-	_ = x[ErrParentIsObject-145]
-	_ = x[ErrStorageFull-146]
-	_ = x[ErrRequestBodyParse-147]
-	_ = x[ErrObjectExistsAsDirectory-148]
-	_ = x[ErrInvalidObjectName-149]
-	_ = x[ErrInvalidObjectNamePrefixSlash-150]
-	_ = x[ErrInvalidResourceName-151]
-	_ = x[ErrServerNotInitialized-152]
-	_ = x[ErrOperationTimedOut-153]
-	_ = x[ErrClientDisconnected-154]
-	_ = x[ErrOperationMaxedOut-155]
-	_ = x[ErrInvalidRequest-156]
-	_ = x[ErrInvalidStorageClass-157]
-	_ = x[ErrBackendDown-158]
-	_ = x[ErrMalformedJSON-159]
-	_ = x[ErrAdminNoSuchUser-160]
-	_ = x[ErrAdminNoSuchGroup-161]
-	_ = x[ErrAdminGroupNotEmpty-162]
-	_ = x[ErrAdminNoSuchPolicy-163]
-	_ = x[ErrAdminInvalidArgument-164]
-	_ = x[ErrAdminInvalidAccessKey-165]
-	_ = x[ErrAdminInvalidSecretKey-166]
-	_ = x[ErrAdminConfigNoQuorum-167]
-	_ = x[ErrAdminConfigTooLarge-168]
-	_ = x[ErrAdminConfigBadJSON-169]
-	_ = x[ErrAdminConfigDuplicateKeys-170]
-	_ = x[ErrAdminCredentialsMismatch-171]
-	_ = x[ErrInsecureClientRequest-172]
-	_ = x[ErrObjectTampered-173]
-	_ = x[ErrAdminBucketQuotaExceeded-174]
-	_ = x[ErrAdminNoSuchQuotaConfiguration-175]
-	_ = x[ErrHealNotImplemented-176]
-	_ = x[ErrHealNoSuchProcess-177]
-	_ = x[ErrHealInvalidClientToken-178]
-	_ = x[ErrHealMissingBucket-179]
-	_ = x[ErrHealAlreadyRunning-180]
-	_ = x[ErrHealOverlappingPaths-181]
-	_ = x[ErrIncorrectContinuationToken-182]
-	_ = x[ErrEmptyRequestBody-183]
-	_ = x[ErrUnsupportedFunction-184]
-	_ = x[ErrInvalidExpressionType-185]
-	_ = x[ErrBusy-186]
-	_ = x[ErrUnauthorizedAccess-187]
-	_ = x[ErrExpressionTooLong-188]
-	_ = x[ErrIllegalSQLFunctionArgument-189]
-	_ = x[ErrInvalidKeyPath-190]
-	_ = x[ErrInvalidCompressionFormat-191]
-	_ = x[ErrInvalidFileHeaderInfo-192]
-	_ = x[ErrInvalidJSONType-193]
-	_ = x[ErrInvalidQuoteFields-194]
-	_ = x[ErrInvalidRequestParameter-195]
-	_ = x[ErrInvalidDataType-196]
-	_ = x[ErrInvalidTextEncoding-197]
-	_ = x[ErrInvalidDataSource-198]
-	_ = x[ErrInvalidTableAlias-199]
-	_ = x[ErrMissingRequiredParameter-200]
-	_ = x[ErrObjectSerializationConflict-201]
-	_ = x[ErrUnsupportedSQLOperation-202]
-	_ = x[ErrUnsupportedSQLStructure-203]
-	_ = x[ErrUnsupportedSyntax-204]
-	_ = x[ErrUnsupportedRangeHeader-205]
-	_ = x[ErrLexerInvalidChar-206]
-	_ = x[ErrLexerInvalidOperator-207]
-	_ = x[ErrLexerInvalidLiteral-208]
-	_ = x[ErrLexerInvalidIONLiteral-209]
-	_ = x[ErrParseExpectedDatePart-210]
-	_ = x[ErrParseExpectedKeyword-211]
-	_ = x[ErrParseExpectedTokenType-212]
-	_ = x[ErrParseExpected2TokenTypes-213]
-	_ = x[ErrParseExpectedNumber-214]
-	_ = x[ErrParseExpectedRightParenBuiltinFunctionCall-215]
-	_ = x[ErrParseExpectedTypeName-216]
-	_ = x[ErrParseExpectedWhenClause-217]
-	_ = x[ErrParseUnsupportedToken-218]
-	_ = x[ErrParseUnsupportedLiteralsGroupBy-219]
-	_ = x[ErrParseExpectedMember-220]
-	_ = x[ErrParseUnsupportedSelect-221]
-	_ = x[ErrParseUnsupportedCase-222]
-	_ = x[ErrParseUnsupportedCaseClause-223]
-	_ = x[ErrParseUnsupportedAlias-224]
-	_ = x[ErrParseUnsupportedSyntax-225]
-	_ = x[ErrParseUnknownOperator-226]
-	_ = x[ErrParseMissingIdentAfterAt-227]
-	_ = x[ErrParseUnexpectedOperator-228]
-	_ = x[ErrParseUnexpectedTerm-229]
-	_ = x[ErrParseUnexpectedToken-230]
-	_ = x[ErrParseUnexpectedKeyword-231]
-	_ = x[ErrParseExpectedExpression-232]
-	_ = x[ErrParseExpectedLeftParenAfterCast-233]
-	_ = x[ErrParseExpectedLeftParenValueConstructor-234]
-	_ = x[ErrParseExpectedLeftParenBuiltinFunctionCall-235]
-	_ = x[ErrParseExpectedArgumentDelimiter-236]
-	_ = x[ErrParseCastArity-237]
-	_ = x[ErrParseInvalidTypeParam-238]
-	_ = x[ErrParseEmptySelect-239]
-	_ = x[ErrParseSelectMissingFrom-240]
-	_ = x[ErrParseExpectedIdentForGroupName-241]
-	_ = x[ErrParseExpectedIdentForAlias-242]
-	_ = x[ErrParseUnsupportedCallWithStar-243]
-	_ = x[ErrParseNonUnaryAgregateFunctionCall-244]
-	_ = x[ErrParseMalformedJoin-245]
-	_ = x[ErrParseExpectedIdentForAt-246]
-	_ = x[ErrParseAsteriskIsNotAloneInSelectList-247]
-	_ = x[ErrParseCannotMixSqbAndWildcardInSelectList-248]
-	_ = x[ErrParseInvalidContextForWildcardInSelectList-249]
-	_ = x[ErrIncorrectSQLFunctionArgumentType-250]
-	_ = x[ErrValueParseFailure-251]
-	_ = x[ErrEvaluatorInvalidArguments-252]
-	_ = x[ErrIntegerOverflow-253]
-	_ = x[ErrLikeInvalidInputs-254]
-	_ = x[ErrCastFailed-255]
-	_ = x[ErrInvalidCast-256]
-	_ = x[ErrEvaluatorInvalidTimestampFormatPattern-257]
-	_ = x[ErrEvaluatorInvalidTimestampFormatPatternSymbolForParsing-258]
-	_ = x[ErrEvaluatorTimestampFormatPatternDuplicateFields-259]
-	_ = x[ErrEvaluatorTimestampFormatPatternHourClockAmPmMismatch-260]
-	_ = x[ErrEvaluatorUnterminatedTimestampFormatPatternToken-261]
-	_ = x[ErrEvaluatorInvalidTimestampFormatPatternToken-262]
-	_ = x[ErrEvaluatorInvalidTimestampFormatPatternSymbol-263]
-	_ = x[ErrEvaluatorBindingDoesNotExist-264]
-	_ = x[ErrMissingHeaders-265]
-	_ = x[ErrInvalidColumnIndex-266]
-	_ = x[ErrAdminConfigNotificationTargetsFailed-267]
-	_ = x[ErrAdminProfilerNotEnabled-268]
-	_ = x[ErrInvalidDecompressedSize-269]
-	_ = x[ErrAddUserInvalidArgument-270]
-	_ = x[ErrAdminAccountNotEligible-271]
-	_ = x[ErrAccountNotEligible-272]
-	_ = x[ErrAdminServiceAccountNotFound-273]
-	_ = x[ErrPostPolicyConditionInvalidFormat-274]
+	_ = x[ErrDuplicateFilterName-137]
+	_ = x[ErrFilterNamePrefix-138]
+	_ = x[ErrFilterNameSuffix-139]
+	_ = x[ErrFilterValueInvalid-140]
+	_ = x[ErrOverlappingConfigs-141]
+	_ = x[ErrUnsupportedNotification-142]
+	_ = x[ErrContentSHA256Mismatch-143]
+	_ = x[ErrReadQuorum-144]
+	_ = x[ErrWriteQuorum-145]
+	_ = x[ErrParentIsObject-146]
+	_ = x[ErrStorageFull-147]
+	_ = x[ErrRequestBodyParse-148]
+	_ = x[ErrObjectExistsAsDirectory-149]
+	_ = x[ErrInvalidObjectName-150]
+	_ = x[ErrInvalidObjectNamePrefixSlash-151]
+	_ = x[ErrInvalidResourceName-152]
+	_ = x[ErrServerNotInitialized-153]
+	_ = x[ErrOperationTimedOut-154]
+	_ = x[ErrClientDisconnected-155]
+	_ = x[ErrOperationMaxedOut-156]
+	_ = x[ErrInvalidRequest-157]
+	_ = x[ErrInvalidStorageClass-158]
+	_ = x[ErrBackendDown-159]
+	_ = x[ErrMalformedJSON-160]
+	_ = x[ErrAdminNoSuchUser-161]
+	_ = x[ErrAdminNoSuchGroup-162]
+	_ = x[ErrAdminGroupNotEmpty-163]
+	_ = x[ErrAdminNoSuchPolicy-164]
+	_ = x[ErrAdminInvalidArgument-165]
+	_ = x[ErrAdminInvalidAccessKey-166]
+	_ = x[ErrAdminInvalidSecretKey-167]
+	_ = x[ErrAdminConfigNoQuorum-168]
+	_ = x[ErrAdminConfigTooLarge-169]
+	_ = x[ErrAdminConfigBadJSON-170]
+	_ = x[ErrAdminConfigDuplicateKeys-171]
+	_ = x[ErrAdminCredentialsMismatch-172]
+	_ = x[ErrInsecureClientRequest-173]
+	_ = x[ErrObjectTampered-174]
+	_ = x[ErrAdminBucketQuotaExceeded-175]
+	_ = x[ErrAdminNoSuchQuotaConfiguration-176]
+	_ = x[ErrHealNotImplemented-177]
+	_ = x[ErrHealNoSuchProcess-178]
+	_ = x[ErrHealInvalidClientToken-179]
+	_ = x[ErrHealMissingBucket-180]
+	_ = x[ErrHealAlreadyRunning-181]
+	_ = x[ErrHealOverlappingPaths-182]
+	_ = x[ErrIncorrectContinuationToken-183]
+	_ = x[ErrEmptyRequestBody-184]
+	_ = x[ErrUnsupportedFunction-185]
+	_ = x[ErrInvalidExpressionType-186]
+	_ = x[ErrBusy-187]
+	_ = x[ErrUnauthorizedAccess-188]
+	_ = x[ErrExpressionTooLong-189]
+	_ = x[ErrIllegalSQLFunctionArgument-190]
+	_ = x[ErrInvalidKeyPath-191]
+	_ = x[ErrInvalidCompressionFormat-192]
+	_ = x[ErrInvalidFileHeaderInfo-193]
+	_ = x[ErrInvalidJSONType-194]
+	_ = x[ErrInvalidQuoteFields-195]
+	_ = x[ErrInvalidRequestParameter-196]
+	_ = x[ErrInvalidDataType-197]
+	_ = x[ErrInvalidTextEncoding-198]
+	_ = x[ErrInvalidDataSource-199]
+	_ = x[ErrInvalidTableAlias-200]
+	_ = x[ErrMissingRequiredParameter-201]
+	_ = x[ErrObjectSerializationConflict-202]
+	_ = x[ErrUnsupportedSQLOperation-203]
+	_ = x[ErrUnsupportedSQLStructure-204]
+	_ = x[ErrUnsupportedSyntax-205]
+	_ = x[ErrUnsupportedRangeHeader-206]
+	_ = x[ErrLexerInvalidChar-207]
+	_ = x[ErrLexerInvalidOperator-208]
+	_ = x[ErrLexerInvalidLiteral-209]
+	_ = x[ErrLexerInvalidIONLiteral-210]
+	_ = x[ErrParseExpectedDatePart-211]
+	_ = x[ErrParseExpectedKeyword-212]
+	_ = x[ErrParseExpectedTokenType-213]
+	_ = x[ErrParseExpected2TokenTypes-214]
+	_ = x[ErrParseExpectedNumber-215]
+	_ = x[ErrParseExpectedRightParenBuiltinFunctionCall-216]
+	_ = x[ErrParseExpectedTypeName-217]
+	_ = x[ErrParseExpectedWhenClause-218]
+	_ = x[ErrParseUnsupportedToken-219]
+	_ = x[ErrParseUnsupportedLiteralsGroupBy-220]
+	_ = x[ErrParseExpectedMember-221]
+	_ = x[ErrParseUnsupportedSelect-222]
+	_ = x[ErrParseUnsupportedCase-223]
+	_ = x[ErrParseUnsupportedCaseClause-224]
+	_ = x[ErrParseUnsupportedAlias-225]
+	_ = x[ErrParseUnsupportedSyntax-226]
+	_ = x[ErrParseUnknownOperator-227]
+	_ = x[ErrParseMissingIdentAfterAt-228]
+	_ = x[ErrParseUnexpectedOperator-229]
+	_ = x[ErrParseUnexpectedTerm-230]
+	_ = x[ErrParseUnexpectedToken-231]
+	_ = x[ErrParseUnexpectedKeyword-232]
+	_ = x[ErrParseExpectedExpression-233]
+	_ = x[ErrParseExpectedLeftParenAfterCast-234]
+	_ = x[ErrParseExpectedLeftParenValueConstructor-235]
+	_ = x[ErrParseExpectedLeftParenBuiltinFunctionCall-236]
+	_ = x[ErrParseExpectedArgumentDelimiter-237]
+	_ = x[ErrParseCastArity-238]
+	_ = x[ErrParseInvalidTypeParam-239]
+	_ = x[ErrParseEmptySelect-240]
+	_ = x[ErrParseSelectMissingFrom-241]
+	_ = x[ErrParseExpectedIdentForGroupName-242]
+	_ = x[ErrParseExpectedIdentForAlias-243]
+	_ = x[ErrParseUnsupportedCallWithStar-244]
+	_ = x[ErrParseNonUnaryAgregateFunctionCall-245]
+	_ = x[ErrParseMalformedJoin-246]
+	_ = x[ErrParseExpectedIdentForAt-247]
+	_ = x[ErrParseAsteriskIsNotAloneInSelectList-248]
+	_ = x[ErrParseCannotMixSqbAndWildcardInSelectList-249]
+	_ = x[ErrParseInvalidContextForWildcardInSelectList-250]
+	_ = x[ErrIncorrectSQLFunctionArgumentType-251]
+	_ = x[ErrValueParseFailure-252]
+	_ = x[ErrEvaluatorInvalidArguments-253]
+	_ = x[ErrIntegerOverflow-254]
+	_ = x[ErrLikeInvalidInputs-255]
+	_ = x[ErrCastFailed-256]
+	_ = x[ErrInvalidCast-257]
+	_ = x[ErrEvaluatorInvalidTimestampFormatPattern-258]
+	_ = x[ErrEvaluatorInvalidTimestampFormatPatternSymbolForParsing-259]
+	_ = x[ErrEvaluatorTimestampFormatPatternDuplicateFields-260]
+	_ = x[ErrEvaluatorTimestampFormatPatternHourClockAmPmMismatch-261]
+	_ = x[ErrEvaluatorUnterminatedTimestampFormatPatternToken-262]
+	_ = x[ErrEvaluatorInvalidTimestampFormatPatternToken-263]
+	_ = x[ErrEvaluatorInvalidTimestampFormatPatternSymbol-264]
+	_ = x[ErrEvaluatorBindingDoesNotExist-265]
+	_ = x[ErrMissingHeaders-266]
+	_ = x[ErrInvalidColumnIndex-267]
+	_ = x[ErrAdminConfigNotificationTargetsFailed-268]
+	_ = x[ErrAdminProfilerNotEnabled-269]
+	_ = x[ErrInvalidDecompressedSize-270]
+	_ = x[ErrAddUserInvalidArgument-271]
+	_ = x[ErrAdminAccountNotEligible-272]
+	_ = x[ErrAccountNotEligible-273]
+	_ = x[ErrAdminServiceAccountNotFound-274]
+	_ = x[ErrPostPolicyConditionInvalidFormat-275]
 }
 
-const _APIErrorCode_name = "NoneAccessDeniedBadDigestEntityTooSmallEntityTooLargePolicyTooLargeIncompleteBodyInternalErrorInvalidAccessKeyIDInvalidBucketNameInvalidDigestInvalidRangeInvalidRangePartNumberInvalidCopyPartRangeInvalidCopyPartRangeSourceInvalidMaxKeysInvalidEncodingMethodInvalidMaxUploadsInvalidMaxPartsInvalidPartNumberMarkerInvalidPartNumberInvalidRequestBodyInvalidCopySourceInvalidMetadataDirectiveInvalidCopyDestInvalidPolicyDocumentInvalidObjectStateMalformedXMLMissingContentLengthMissingContentMD5MissingRequestBodyErrorMissingSecurityHeaderNoSuchBucketNoSuchBucketPolicyNoSuchBucketLifecycleNoSuchLifecycleConfigurationNoSuchBucketSSEConfigNoSuchCORSConfigurationNoSuchWebsiteConfigurationReplicationConfigurationNotFoundErrorRemoteDestinationNotFoundErrorReplicationDestinationMissingLockRemoteTargetNotFoundErrorReplicationRemoteConnectionErrorBucketRemoteIdenticalToSourceBucketRemoteAlreadyExistsBucketRemoteLabelInUseBucketRemoteArnTypeInvalidBucketRemoteArnInvalidBucketRemoteRemoveDisallowedRemoteTargetNotVersionedErrorReplicationSourceNotVersionedErrorReplicationNeedsVersioningErrorReplicationBucketNeedsVersioningErrorObjectRestoreAlreadyInProgressNoSuchKeyNoSuchUploadInvalidVersionIDNoSuchVersionNotImplementedPreconditionFailedRequestTimeTooSkewedSignatureDoesNotMatchMethodNotAllowedInvalidPartInvalidPartOrderAuthorizationHeaderMalformedMalformedPOSTRequestPOSTFileRequiredSignatureVersionNotSupportedBucketNotEmptyAllAccessDisabledMalformedPolicyMissingFieldsMissingFieldsV2MissingCredTagCredMalformedInvalidRegionInvalidServiceS3InvalidServiceSTSInvalidRequestVersionMissingSignTagMissingSignHeadersTagMalformedDateMalformedPresignedDateMalformedCredentialDateMalformedCredentialRegionMalformedExpiresNegativeExpiresAuthHeaderEmptyExpiredPresignRequestRequestNotReadyYetUnsignedHeadersMissingDateHeaderInvalidQuerySignatureAlgoInvalidQueryParamsBucketAlreadyOwnedByYouInvalidDurationBucketAlreadyExistsMetadataTooLargeUnsupportedMetadataMaximumExpiresSlowDownInvalidPrefixMarkerBadRequestKeyTooLongErrorInvalidBucketObjectLockConfigurationObjectLockConfigurationNotFoundObjectLockConfigurationNotAllowedNoSuchObjectLockConfigurationObjectLockedInvalidRetentionDatePastObjectLockRetainDateUnknownWORMModeDirectiveBucketTaggingNotFoundObjectLockInvalidHeadersInvalidTagDirectiveInvalidEncryptionMethodInsecureSSECustomerRequestSSEMultipartEncryptedSSEEncryptedObjectInvalidEncryptionParametersInvalidSSECustomerAlgorithmInvalidSSECustomerKeyMissingSSECustomerKeyMissingSSECustomerKeyMD5SSECustomerKeyMD5MismatchInvalidSSECustomerParametersIncompatibleEncryptionMethodKMSNotConfiguredNoAccessKeyInvalidTokenEventNotificationARNNotificationRegionNotificationOverlappingFilterNotificationFilterNameInvalidFilterNamePrefixFilterNameSuffixFilterValueInvalidOverlappingConfigsUnsupportedNotificationContentSHA256MismatchReadQuorumWriteQuorumParentIsObjectStorageFullRequestBodyParseObjectExistsAsDirectoryInvalidObjectNameInvalidObjectNamePrefixSlashInvalidResourceNameServerNotInitializedOperationTimedOutClientDisconnectedOperationMaxedOutInvalidRequestInvalidStorageClassBackendDownMalformedJSONAdminNoSuchUserAdminNoSuchGroupAdminGroupNotEmptyAdminNoSuchPolicyAdminInvalidArgumentAdminInvalidAccessKeyAdminInvalidSecretKeyAdminConfigNoQuorumAdminConfigTooLargeAdminConfigBadJSONAdminConfigDuplicateKeysAdminCredentialsMismatchInsecureClientRequestObjectTamperedAdminBucketQuotaExceededAdminNoSuchQuotaConfigurationHealNotImplementedHealNoSuchProcessHealInvalidClientTokenHealMissingBucketHealAlreadyRunningHealOverlappingPathsIncorrectContinuationTokenEmptyRequestBodyUnsupportedFunctionInvalidExpressionTypeBusyUnauthorizedAccessExpressionTooLongIllegalSQLFunctionArgumentInvalidKeyPathInvalidCompressionFormatInvalidFileHeaderInfoInvalidJSONTypeInvalidQuoteFieldsInvalidRequestParameterInvalidDataTypeInvalidTextEncodingInvalidDataSourceInvalidTableAliasMissingRequiredParameterObjectSerializationConflictUnsupportedSQLOperationUnsupportedSQLStructureUnsupportedSyntaxUnsupportedRangeHeaderLexerInvalidCharLexerInvalidOperatorLexerInvalidLiteralLexerInvalidIONLiteralParseExpectedDatePartParseExpectedKeywordParseExpectedTokenTypeParseExpected2TokenTypesParseExpectedNumberParseExpectedRightParenBuiltinFunctionCallParseExpectedTypeNameParseExpectedWhenClauseParseUnsupportedTokenParseUnsupportedLiteralsGroupByParseExpectedMemberParseUnsupportedSelectParseUnsupportedCaseParseUnsupportedCaseClauseParseUnsupportedAliasParseUnsupportedSyntaxParseUnknownOperatorParseMissingIdentAfterAtParseUnexpectedOperatorParseUnexpectedTermParseUnexpectedTokenParseUnexpectedKeywordParseExpectedExpressionParseExpectedLeftParenAfterCastParseExpectedLeftParenValueConstructorParseExpectedLeftParenBuiltinFunctionCallParseExpectedArgumentDelimiterParseCastArityParseInvalidTypeParamParseEmptySelectParseSelectMissingFromParseExpectedIdentForGroupNameParseExpectedIdentForAliasParseUnsupportedCallWithStarParseNonUnaryAgregateFunctionCallParseMalformedJoinParseExpectedIdentForAtParseAsteriskIsNotAloneInSelectListParseCannotMixSqbAndWildcardInSelectListParseInvalidContextForWildcardInSelectListIncorrectSQLFunctionArgumentTypeValueParseFailureEvaluatorInvalidArgumentsIntegerOverflowLikeInvalidInputsCastFailedInvalidCastEvaluatorInvalidTimestampFormatPatternEvaluatorInvalidTimestampFormatPatternSymbolForParsingEvaluatorTimestampFormatPatternDuplicateFieldsEvaluatorTimestampFormatPatternHourClockAmPmMismatchEvaluatorUnterminatedTimestampFormatPatternTokenEvaluatorInvalidTimestampFormatPatternTokenEvaluatorInvalidTimestampFormatPatternSymbolEvaluatorBindingDoesNotExistMissingHeadersInvalidColumnIndexAdminConfigNotificationTargetsFailedAdminProfilerNotEnabledInvalidDecompressedSizeAddUserInvalidArgumentAdminAccountNotEligibleAccountNotEligibleAdminServiceAccountNotFoundPostPolicyConditionInvalidFormat"
+const _APIErrorCode_name = "NoneAccessDeniedBadDigestEntityTooSmallEntityTooLargePolicyTooLargeIncompleteBodyInternalErrorInvalidAccessKeyIDInvalidBucketNameInvalidDigestInvalidRangeInvalidRangePartNumberInvalidCopyPartRangeInvalidCopyPartRangeSourceInvalidMaxKeysInvalidEncodingMethodInvalidMaxUploadsInvalidMaxPartsInvalidPartNumberMarkerInvalidPartNumberInvalidRequestBodyInvalidCopySourceInvalidMetadataDirectiveInvalidCopyDestInvalidPolicyDocumentInvalidObjectStateMalformedXMLMissingContentLengthMissingContentMD5MissingRequestBodyErrorMissingSecurityHeaderNoSuchBucketNoSuchBucketPolicyNoSuchBucketLifecycleNoSuchLifecycleConfigurationNoSuchBucketSSEConfigNoSuchCORSConfigurationNoSuchWebsiteConfigurationReplicationConfigurationNotFoundErrorRemoteDestinationNotFoundErrorReplicationDestinationMissingLockRemoteTargetNotFoundErrorReplicationRemoteConnectionErrorBucketRemoteIdenticalToSourceBucketRemoteAlreadyExistsBucketRemoteLabelInUseBucketRemoteArnTypeInvalidBucketRemoteArnInvalidBucketRemoteRemoveDisallowedRemoteTargetNotVersionedErrorReplicationSourceNotVersionedErrorReplicationNeedsVersioningErrorReplicationBucketNeedsVersioningErrorObjectRestoreAlreadyInProgressNoSuchKeyNoSuchUploadInvalidVersionIDNoSuchVersionNotImplementedPreconditionFailedRequestTimeTooSkewedSignatureDoesNotMatchMethodNotAllowedInvalidPartInvalidPartOrderAuthorizationHeaderMalformedMalformedPOSTRequestPOSTFileRequiredSignatureVersionNotSupportedBucketNotEmptyAllAccessDisabledMalformedPolicyMissingFieldsMissingFieldsV2MissingCredTagCredMalformedInvalidRegionInvalidServiceS3InvalidServiceSTSInvalidRequestVersionMissingSignTagMissingSignHeadersTagMalformedDateMalformedPresignedDateMalformedCredentialDateMalformedCredentialRegionMalformedExpiresNegativeExpiresAuthHeaderEmptyExpiredPresignRequestRequestNotReadyYetUnsignedHeadersMissingDateHeaderInvalidQuerySignatureAlgoInvalidQueryParamsBucketAlreadyOwnedByYouInvalidDurationBucketAlreadyExistsMetadataTooLargeUnsupportedMetadataMaximumExpiresSlowDownInvalidPrefixMarkerBadRequestKeyTooLongErrorInvalidBucketObjectLockConfigurationObjectLockConfigurationNotFoundObjectLockConfigurationNotAllowedNoSuchObjectLockConfigurationObjectLockedInvalidRetentionDatePastObjectLockRetainDateUnknownWORMModeDirectiveBucketTaggingNotFoundObjectLockInvalidHeadersInvalidTagDirectiveInvalidEncryptionMethodInsecureSSECustomerRequestSSEMultipartEncryptedSSEEncryptedObjectInvalidEncryptionParametersInvalidSSECustomerAlgorithmInvalidSSECustomerKeyMissingSSECustomerKeyMissingSSECustomerKeyMD5SSECustomerKeyMD5MismatchInvalidSSECustomerParametersIncompatibleEncryptionMethodKMSNotConfiguredNoAccessKeyInvalidTokenEventNotificationARNNotificationRegionNotificationOverlappingFilterNotificationFilterNameInvalidDuplicateFilterNameFilterNamePrefixFilterNameSuffixFilterValueInvalidOverlappingConfigsUnsupportedNotificationContentSHA256MismatchReadQuorumWriteQuorumParentIsObjectStorageFullRequestBodyParseObjectExistsAsDirectoryInvalidObjectNameInvalidObjectNamePrefixSlashInvalidResourceNameServerNotInitializedOperationTimedOutClientDisconnectedOperationMaxedOutInvalidRequestInvalidStorageClassBackendDownMalformedJSONAdminNoSuchUserAdminNoSuchGroupAdminGroupNotEmptyAdminNoSuchPolicyAdminInvalidArgumentAdminInvalidAccessKeyAdminInvalidSecretKeyAdminConfigNoQuorumAdminConfigTooLargeAdminConfigBadJSONAdminConfigDuplicateKeysAdminCredentialsMismatchInsecureClientRequestObjectTamperedAdminBucketQuotaExceededAdminNoSuchQuotaConfigurationHealNotImplementedHealNoSuchProcessHealInvalidClientTokenHealMissingBucketHealAlreadyRunningHealOverlappingPathsIncorrectContinuationTokenEmptyRequestBodyUnsupportedFunctionInvalidExpressionTypeBusyUnauthorizedAccessExpressionTooLongIllegalSQLFunctionArgumentInvalidKeyPathInvalidCompressionFormatInvalidFileHeaderInfoInvalidJSONTypeInvalidQuoteFieldsInvalidRequestParameterInvalidDataTypeInvalidTextEncodingInvalidDataSourceInvalidTableAliasMissingRequiredParameterObjectSerializationConflictUnsupportedSQLOperationUnsupportedSQLStructureUnsupportedSyntaxUnsupportedRangeHeaderLexerInvalidCharLexerInvalidOperatorLexerInvalidLiteralLexerInvalidIONLiteralParseExpectedDatePartParseExpectedKeywordParseExpectedTokenTypeParseExpected2TokenTypesParseExpectedNumberParseExpectedRightParenBuiltinFunctionCallParseExpectedTypeNameParseExpectedWhenClauseParseUnsupportedTokenParseUnsupportedLiteralsGroupByParseExpectedMemberParseUnsupportedSelectParseUnsupportedCaseParseUnsupportedCaseClauseParseUnsupportedAliasParseUnsupportedSyntaxParseUnknownOperatorParseMissingIdentAfterAtParseUnexpectedOperatorParseUnexpectedTermParseUnexpectedTokenParseUnexpectedKeywordParseExpectedExpressionParseExpectedLeftParenAfterCastParseExpectedLeftParenValueConstructorParseExpectedLeftParenBuiltinFunctionCallParseExpectedArgumentDelimiterParseCastArityParseInvalidTypeParamParseEmptySelectParseSelectMissingFromParseExpectedIdentForGroupNameParseExpectedIdentForAliasParseUnsupportedCallWithStarParseNonUnaryAgregateFunctionCallParseMalformedJoinParseExpectedIdentForAtParseAsteriskIsNotAloneInSelectListParseCannotMixSqbAndWildcardInSelectListParseInvalidContextForWildcardInSelectListIncorrectSQLFunctionArgumentTypeValueParseFailureEvaluatorInvalidArgumentsIntegerOverflowLikeInvalidInputsCastFailedInvalidCastEvaluatorInvalidTimestampFormatPatternEvaluatorInvalidTimestampFormatPatternSymbolForParsingEvaluatorTimestampFormatPatternDuplicateFieldsEvaluatorTimestampFormatPatternHourClockAmPmMismatchEvaluatorUnterminatedTimestampFormatPatternTokenEvaluatorInvalidTimestampFormatPatternTokenEvaluatorInvalidTimestampFormatPatternSymbolEvaluatorBindingDoesNotExistMissingHeadersInvalidColumnIndexAdminConfigNotificationTargetsFailedAdminProfilerNotEnabledInvalidDecompressedSizeAddUserInvalidArgumentAdminAccountNotEligibleAccountNotEligibleAdminServiceAccountNotFoundPostPolicyConditionInvalidFormat"
 
-var _APIErrorCode_index = [...]uint16{0, 4, 16, 25, 39, 53, 67, 81, 94, 112, 129, 142, 154, 176, 196, 222, 236, 257, 274, 289, 312, 329, 347, 364, 388, 403, 424, 442, 454, 474, 491, 514, 535, 547, 565, 586, 614, 635, 658, 684, 721, 751, 784, 809, 841, 870, 895, 917, 943, 965, 993, 1022, 1056, 1087, 1124, 1154, 1163, 1175, 1191, 1204, 1218, 1236, 1256, 1277, 1293, 1304, 1320, 1348, 1368, 1384, 1412, 1426, 1443, 1458, 1471, 1486, 1500, 1513, 1526, 1542, 1559, 1580, 1594, 1615, 1628, 1650, 1673, 1698, 1714, 1729, 1744, 1765, 1783, 1798, 1815, 1840, 1858, 1881, 1896, 1915, 1931, 1950, 1964, 1972, 1991, 2001, 2016, 2052, 2083, 2116, 2145, 2157, 2177, 2201, 2225, 2246, 2270, 2289, 2312, 2338, 2359, 2377, 2404, 2431, 2452, 2473, 2497, 2522, 2550, 2578, 2594, 2605, 2617, 2634, 2649, 2667, 2696, 2713, 2729, 2745, 2763, 2781, 2804, 2825, 2835, 2846, 2860, 2871, 2887, 2910, 2927, 2955, 2974, 2994, 3011, 3029, 3046, 3060, 3079, 3090, 3103, 3118, 3134, 3152, 3169, 3189, 3210, 3231, 3250, 3269, 3287, 3311, 3335, 3356, 3370, 3394, 3423, 3441, 3458, 3480, 3497, 3515, 3535, 3561, 3577, 3596, 3617, 3621, 3639, 3656, 3682, 3696, 3720, 3741, 3756, 3774, 3797, 3812, 3831, 3848, 3865, 3889, 3916, 3939, 3962, 3979, 4001, 4017, 4037, 4056, 4078, 4099, 4119, 4141, 4165, 4184, 4226, 4247, 4270, 4291, 4322, 4341, 4363, 4383, 4409, 4430, 4452, 4472, 4496, 4519, 4538, 4558, 4580, 4603, 4634, 4672, 4713, 4743, 4757, 4778, 4794, 4816, 4846, 4872, 4900, 4933, 4951, 4974, 5009, 5049, 5091, 5123, 5140, 5165, 5180, 5197, 5207, 5218, 5256, 5310, 5356, 5408, 5456, 5499, 5543, 5571, 5585, 5603, 5639, 5662, 5685, 5707, 5730, 5748, 5775, 5807}
+var _APIErrorCode_index = [...]uint16{0, 4, 16, 25, 39, 53, 67, 81, 94, 112, 129, 142, 154, 176, 196, 222, 236, 257, 274, 289, 312, 329, 347, 364, 388, 403, 424, 442, 454, 474, 491, 514, 535, 547, 565, 586, 614, 635, 658, 684, 721, 751, 784, 809, 841, 870, 895, 917, 943, 965, 993, 1022, 1056, 1087, 1124, 1154, 1163, 1175, 1191, 1204, 1218, 1236, 1256, 1277, 1293, 1304, 1320, 1348, 1368, 1384, 1412, 1426, 1443, 1458, 1471, 1486, 1500, 1513, 1526, 1542, 1559, 1580, 1594, 1615, 1628, 1650, 1673, 1698, 1714, 1729, 1744, 1765, 1783, 1798, 1815, 1840, 1858, 1881, 1896, 1915, 1931, 1950, 1964, 1972, 1991, 2001, 2016, 2052, 2083, 2116, 2145, 2157, 2177, 2201, 2225, 2246, 2270, 2289, 2312, 2338, 2359, 2377, 2404, 2431, 2452, 2473, 2497, 2522, 2550, 2578, 2594, 2605, 2617, 2634, 2649, 2667, 2696, 2713, 2732, 2748, 2764, 2782, 2800, 2823, 2844, 2854, 2865, 2879, 2890, 2906, 2929, 2946, 2974, 2993, 3013, 3030, 3048, 3065, 3079, 3098, 3109, 3122, 3137, 3153, 3171, 3188, 3208, 3229, 3250, 3269, 3288, 3306, 3330, 3354, 3375, 3389, 3413, 3442, 3460, 3477, 3499, 3516, 3534, 3554, 3580, 3596, 3615, 3636, 3640, 3658, 3675, 3701, 3715, 3739, 3760, 3775, 3793, 3816, 3831, 3850, 3867, 3884, 3908, 3935, 3958, 3981, 3998, 4020, 4036, 4056, 4075, 4097, 4118, 4138, 4160, 4184, 4203, 4245, 4266, 4289, 4310, 4341, 4360, 4382, 4402, 4428, 4449, 4471, 4491, 4515, 4538, 4557, 4577, 4599, 4622, 4653, 4691, 4732, 4762, 4776, 4797, 4813, 4835, 4865, 4891, 4919, 4952, 4970, 4993, 5028, 5068, 5110, 5142, 5159, 5184, 5199, 5216, 5226, 5237, 5275, 5329, 5375, 5427, 5475, 5518, 5562, 5590, 5604, 5622, 5658, 5681, 5704, 5726, 5749, 5767, 5794, 5826}
 
 func (i APIErrorCode) String() string {
 	if i < 0 || i >= APIErrorCode(len(_APIErrorCode_index)-1) {
@@ -690,3 +690,77 @@ func isPutActionAllowed(ctx context.Context, atype authType, bucketName, objectN
 	}
 	return ErrAccessDenied
 }
+
+// isKMSActionAllowed - check if the requester may use the KMS master key
+// keyID on the object, i.e. kms:GenerateKey to encrypt and kms:Decrypt to
+// read SSE-KMS encrypted objects. Anonymous requests may use the key if the
+// bucket policy grants them the corresponding S3 action.
+func isKMSActionAllowed(r *http.Request, action iampolicy.Action, bucketName, objectName, keyID string) APIErrorCode {
+	var (
+		accessKey string
+		groups    []string
+		owner     bool
+		claims    map[string]interface{}
+	)
+	switch atype := getRequestAuthType(r); {
+	case atype == authTypeJWT || r.URL.Query().Get("token") != "" && strings.HasPrefix(r.URL.Path, minioReservedBucketPath):
+		// Browser requests carry the token either in the Authorization
+		// header or, for downloads, as query parameter.
+		var (
+			webClaims *xjwt.MapClaims
+			err       error
+		)
+		if atype == authTypeJWT {
+			webClaims, owner, err = webRequestAuthenticate(r)
+		} else {
+			webClaims, owner, err = webTokenAuthenticate(r.URL.Query().Get("token"))
+		}
+		if err != nil {
+			return ErrAccessDenied
+		}
+		accessKey, claims = webClaims.AccessKey, webClaims.Map()
+	default:
+		cred := getReqAccessCred(r, globalServerRegion)
+		if cred.AccessKey == "" {
+			// Bucket policies have no KMS actions, access to the
+			// object grants access to its key.
+			var s3Action policy.Action = policy.GetObjectAction
+			if action == iampolicy.KMSGenerateKeyAction {
+				s3Action = policy.PutObjectAction
+			}
+			conditionValues := getConditionValues(r, "", "", nil)
+			conditionValues[xhttp.AmzServerSideEncryptionKmsID] = []string{keyID}
+			if globalPolicySys.IsAllowed(policy.Args{
+				Action:          s3Action,
+				BucketName:      bucketName,
+				ConditionValues: conditionValues,
+				ObjectName:      objectName,
+			}) {
+				return ErrNone
+			}
+			return ErrAccessDenied
+		}
+		var s3Err APIErrorCode
+		if claims, s3Err = checkClaimsFromToken(r, cred); s3Err != ErrNone {
+			return s3Err
+		}
+		accessKey, groups = cred.AccessKey, cred.Groups
+		owner = cred.AccessKey == globalActiveCred.AccessKey
+	}
+
+	conditionValues := getConditionValues(r, "", accessKey, claims)
+	conditionValues[xhttp.AmzServerSideEncryptionKmsID] = []string{keyID}
+	if GlobalIAMSys.IsAllowed(iampolicy.Args{
+		AccountName:     accessKey,
+		Groups:          groups,
+		Action:          action,
+		BucketName:      bucketName,
+		ConditionValues: conditionValues,
+		ObjectName:      objectName,
+		IsOwner:         owner,
+		Claims:          claims,
+	}) {
+		return ErrNone
+	}
+	return ErrAccessDenied
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/dustin/go-humanize"
 	"github.com/minio/minio-go/v7/pkg/set"
 
+	"minio/cmd/config/heal"
 	"minio/cmd/logger"
 	"minio/pkg/color"
 	"minio/pkg/console"
@@ -42,6 +43,19 @@ const (
 	healingTrackerFilename        = ".healing.bin"
 )
 
+// Admin operations on the healing of a drive.
+const (
+	healDriveReplace = "replace"
+	healDrivePause   = "pause"
+	healDriveResume  = "resume"
+)
+
+var errHealDriveNotHealing = errors.New("drive is not being healed")
+
+// errHealPaused is returned by healErasureSet when healing of the
+// disk was paused, the disk stays queued until it is resumed.
+var errHealPaused = errors.New("healing of the drive is paused")
+
 //go:generate msgp -file $GOFILE -unexported
 
 // healingTracker is used to persist healing information during a heal.
@@ -77,6 +91,21 @@ type healingTracker struct {
 
 	// Filled during heal.
 	HealedBuckets []string
+
+	// Buckets to heal first, set by the admin when
+	// the drive was replaced.
+	PriorityBuckets []string
+
+	// Paused by the admin, the nanoseconds spent
+	// paused are excluded from the ETA.
+	Paused      bool
+	PausedAt    time.Time
+	PausedNanos int64
+
+	// Estimated objects and bytes to heal in the set.
+	ObjectsTotal uint64
+	BytesTotal   uint64
+
 	// Add future tracking capabilities
 	// Be sure that they are included in toHealingDisk
 }
@@ -209,6 +238,100 @@ func (h *healingTracker) setQueuedBuckets(buckets []BucketInfo) {
 	}
 }
 
+// prioritizeBuckets returns buckets with h.PriorityBuckets moved to the
+// front, in the order given, after the buckets under .minio.sys.
+func (h *healingTracker) prioritizeBuckets(buckets []BucketInfo) []BucketInfo {
+	if len(h.PriorityBuckets) == 0 {
+		return buckets
+	}
+	prio := make(map[string]int, len(h.PriorityBuckets))
+	for i, b := range h.PriorityBuckets {
+		prio[b] = i
+	}
+	sorted := make([]BucketInfo, len(buckets))
+	copy(sorted, buckets)
+	rank := func(b BucketInfo) int {
+		if strings.HasPrefix(b.Name, minioMetaBucket) {
+			return -1
+		}
+		if i, ok := prio[b.Name]; ok {
+			return i
+		}
+		return len(prio)
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return rank(sorted[i]) < rank(sorted[j])
+	})
+	return sorted
+}
+
+// setTotals estimates the objects and bytes to heal in the erasure set
+// of the disk, assuming the buckets are spread evenly over all sets.
+func (h *healingTracker) setTotals(buckets []BucketInfo, usage madmin.DataUsageInfo, setCount int) {
+	if setCount <= 0 {
+		return
+	}
+	var objects, bytes uint64
+	for _, b := range buckets {
+		u := usage.BucketsUsage[b.Name]
+		objects += u.ObjectsCount
+		bytes += u.Size
+	}
+	h.ObjectsTotal = objects / uint64(setCount)
+	h.BytesTotal = bytes / uint64(setCount)
+}
+
+// eta returns the estimated time left to heal the disk, based on the
+// rate at which bytes were healed so far while not paused.
+func (h *healingTracker) eta(now time.Time) time.Duration {
+	done := h.BytesDone + h.BytesFailed
+	if done == 0 || done >= h.BytesTotal {
+		return 0
+	}
+	active := now.Sub(h.Started) - time.Duration(h.PausedNanos)
+	if h.Paused {
+		active -= now.Sub(h.PausedAt)
+	}
+	if active <= 0 {
+		return 0
+	}
+	return time.Duration(float64(active) * float64(h.BytesTotal-done) / float64(done))
+}
+
+// setPaused records the start or end of a pause.
+func (h *healingTracker) setPaused(paused bool) {
+	if h.Paused == paused {
+		return
+	}
+	now := time.Now().UTC()
+	if paused {
+		h.PausedAt = now
+	} else {
+		h.PausedNanos += int64(now.Sub(h.PausedAt))
+		h.PausedAt = time.Time{}
+	}
+	h.Paused = paused
+}
+
+// checkPaused returns errHealPaused if healing of the disk is paused,
+// recording the pause in the saved tracker. It never blocks so that
+// the other disks of the erasure set keep healing meanwhile.
+func (h *healingTracker) checkPaused(ctx context.Context) error {
+	if !globalBackgroundHealState.isHealLocalDiskPaused(h.disk.Endpoint()) {
+		if h.Paused {
+			logger.Info("Healing disk '%s' resumed", h.disk)
+			h.setPaused(false)
+			logger.LogIf(ctx, h.update(ctx))
+		}
+		return nil
+	}
+
+	logger.Info("Healing disk '%s' paused", h.disk)
+	h.setPaused(true)
+	logger.LogIf(ctx, h.update(ctx))
+	return errHealPaused
+}
+
 func (h *healingTracker) printTo(writer io.Writer) {
 	b, err := json.MarshalIndent(h, "", "  ")
 	if err != nil {
@@ -236,9 +359,74 @@ func (h *healingTracker) toHealingDisk() madmin.HealingDisk {
 		Object:        h.Object,
 		QueuedBuckets: h.QueuedBuckets,
 		HealedBuckets: h.HealedBuckets,
+
+		PriorityBuckets: h.PriorityBuckets,
+		Paused:          h.Paused,
+		ObjectsTotal:    h.ObjectsTotal,
+		BytesTotal:      h.BytesTotal,
+		ETA:             h.eta(time.Now().UTC()),
 	}
 }
 
+// sortHealBuckets sorts the buckets of a drive to heal in the given
+// order, the buckets under .minio.sys always come first.
+func sortHealBuckets(buckets []BucketInfo, order string, usage madmin.DataUsageInfo) {
+	sort.SliceStable(buckets, func(i, j int) bool {
+		a, b := strings.HasPrefix(buckets[i].Name, minioMetaBucket), strings.HasPrefix(buckets[j].Name, minioMetaBucket)
+		if a != b {
+			return a
+		}
+		switch order {
+		case heal.DriveOrderOldest:
+			return buckets[i].Created.Before(buckets[j].Created)
+		case heal.DriveOrderSmallest:
+			return usage.BucketsUsage[buckets[i].Name].Size < usage.BucketsUsage[buckets[j].Name].Size
+		case heal.DriveOrderLargest:
+			return usage.BucketsUsage[buckets[i].Name].Size > usage.BucketsUsage[buckets[j].Name].Size
+		}
+		// Heal latest buckets first.
+		return buckets[i].Created.After(buckets[j].Created)
+	})
+}
+
+// healLocalDrive performs the admin heal operation op on the local drive.
+func healLocalDrive(ctx context.Context, ep Endpoint, op string, buckets []string) error {
+	if !ep.IsLocal {
+		return errDiskNotFound
+	}
+
+	switch op {
+	case healDriveReplace:
+		disk, format, err := connectEndpoint(ep)
+		switch {
+		case errors.Is(err, errUnformattedDisk):
+			// Healing the format marks the drive as healing.
+		case err != nil:
+			return err
+		case disk.Healing() == nil:
+			// A formatted drive was put in place, heal all of its content.
+			disk.SetDiskID(format.Erasure.This)
+			if err = newHealingTracker(disk).save(ctx); err != nil {
+				return err
+			}
+		}
+		globalBackgroundHealState.setHealLocalDiskPriority(ep, buckets)
+		globalBackgroundHealState.setHealLocalDiskPaused(ep, false)
+		globalBackgroundHealState.pushHealLocalDisks(ep)
+		logger.Info(fmt.Sprintf("Drive %s marked as replaced, attempting to heal...", ep))
+	case healDrivePause, healDriveResume:
+		if !globalBackgroundHealState.isHealLocalDisk(ep) {
+			return errHealDriveNotHealing
+		}
+		globalBackgroundHealState.setHealLocalDiskPaused(ep, op == healDrivePause)
+	default:
+		return errInvalidArgument
+	}
+
+	globalBackgroundHealState.triggerHealLocalDisks()
+	return nil
+}
+
 func initAutoHeal(ctx context.Context, objAPI ObjectLayer) {
 	z, ok := objAPI.(*erasureServerPools)
 	if !ok {
@@ -275,6 +463,10 @@ func initAutoHeal(ctx context.Context, objAPI ObjectLayer) {
 	}
 
 	go monitorLocalDisksAndHeal(ctx, z, bgSeq)
+
+	go monitorLocalDisksAndScrub(ctx, z)
+
+	go monitorLocalDrivesSmart(ctx)
 }
 
 func getLocalDisksToHeal() (disksToHeal Endpoints) {
@@ -317,6 +509,9 @@ func monitorLocalDisksAndHeal(ctx context.Context, z *erasureServerPools, bgSeq
 		select {
 		case <-ctx.Done():
 			return
+		case <-globalBackgroundHealState.healDisksTrigger:
+			// Look for disks to heal right away.
+			diskCheckTimer.Reset(0)
 		case <-diskCheckTimer.C:
 			// Reset to next interval.
 			diskCheckTimer.Reset(defaultMonitorNewDiskInterval)
@@ -382,14 +577,20 @@ func monitorLocalDisksAndHeal(ctx context.Context, z *erasureServerPools, bgSeq
 				{Name: pathJoin(minioMetaBucket, bucketMetaPrefix)},
 			}...)
 
-			// Heal latest buckets first.
-			sort.Slice(buckets, func(i, j int) bool {
-				a, b := strings.HasPrefix(buckets[i].Name, minioMetaBucket), strings.HasPrefix(buckets[j].Name, minioMetaBucket)
-				if a != b {
-					return a
+			globalHealConfigMu.Lock()
+			order := globalHealConfig.DriveOrder
+			globalHealConfigMu.Unlock()
+
+			// Bucket sizes for the heal order and the ETA.
+			var usage madmin.DataUsageInfo
+			var setCount int
+			if len(healDisks) > 0 {
+				usage, _ = loadDataUsageFromBackend(ctx, z)
+				for _, pool := range z.serverPools {
+					setCount += len(pool.sets)
 				}
-				return buckets[i].Created.After(buckets[j].Created)
-			})
+			}
+			sortHealBuckets(buckets, order, usage)
 
 			// TODO(klauspost): This will block until all heals are done,
 			// in the future this should be able to start healing other sets at once.
@@ -413,8 +614,22 @@ func monitorLocalDisksAndHeal(ctx context.Context, z *erasureServerPools, bgSeq
 								tracker = newHealingTracker(disk)
 							}
 
+							ep := disk.Endpoint()
+							if prio := globalBackgroundHealState.getHealLocalDiskPriority(ep); len(prio) > 0 {
+								tracker.PriorityBuckets = prio
+							}
+							globalBackgroundHealState.restoreHealLocalDiskPaused(ep, tracker.Paused)
+							diskBuckets := tracker.prioritizeBuckets(buckets)
+
 							tracker.PoolIndex, tracker.SetIndex, tracker.DiskIndex = disk.GetDiskLoc()
-							tracker.setQueuedBuckets(buckets)
+							tracker.setQueuedBuckets(diskBuckets)
+							tracker.setTotals(diskBuckets, usage, setCount)
+							if globalBackgroundHealState.isHealLocalDiskPaused(ep) {
+								// Leave the disk queued until healing is resumed.
+								tracker.setPaused(true)
+								logger.LogIf(ctx, tracker.save(ctx))
+								continue
+							}
 							if err := tracker.save(ctx); err != nil {
 								logger.LogIf(ctx, err)
 								// Unable to write healing tracker, permission denied or some
@@ -423,7 +638,11 @@ func monitorLocalDisksAndHeal(ctx context.Context, z *erasureServerPools, bgSeq
 								return
 							}
 
-							err = z.serverPools[i].sets[setIndex].healErasureSet(ctx, buckets, tracker)
+							err = z.serverPools[i].sets[setIndex].healErasureSet(ctx, diskBuckets, tracker)
+							if err == errHealPaused {
+								// Leave the disk queued until healing is resumed.
+								continue
+							}
 							if err != nil {
 								logger.LogIf(ctx, err)
 								continue
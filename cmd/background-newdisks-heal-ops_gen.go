@@ -170,6 +170,55 @@ func (z *healingTracker) DecodeMsg(dc *msgp.Reader) (err error) {
 					return
 				}
 			}
+		case "PriorityBuckets":
+			var zb0004 uint32
+			zb0004, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "PriorityBuckets")
+				return
+			}
+			if cap(z.PriorityBuckets) >= int(zb0004) {
+				z.PriorityBuckets = (z.PriorityBuckets)[:zb0004]
+			} else {
+				z.PriorityBuckets = make([]string, zb0004)
+			}
+			for za0003 := range z.PriorityBuckets {
+				z.PriorityBuckets[za0003], err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "PriorityBuckets", za0003)
+					return
+				}
+			}
+		case "Paused":
+			z.Paused, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "Paused")
+				return
+			}
+		case "PausedAt":
+			z.PausedAt, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "PausedAt")
+				return
+			}
+		case "PausedNanos":
+			z.PausedNanos, err = dc.ReadInt64()
+			if err != nil {
+				err = msgp.WrapError(err, "PausedNanos")
+				return
+			}
+		case "ObjectsTotal":
+			z.ObjectsTotal, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "ObjectsTotal")
+				return
+			}
+		case "BytesTotal":
+			z.BytesTotal, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "BytesTotal")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -183,9 +232,9 @@ func (z *healingTracker) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *healingTracker) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 20
+	// map header, size 26
 	// write "ID"
-	err = en.Append(0xde, 0x0, 0x14, 0xa2, 0x49, 0x44)
+	err = en.Append(0xde, 0x0, 0x1a, 0xa2, 0x49, 0x44)
 	if err != nil {
 		return
 	}
@@ -398,15 +447,82 @@ func (z *healingTracker) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
+	// write "PriorityBuckets"
+	err = en.Append(0xaf, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.PriorityBuckets)))
+	if err != nil {
+		err = msgp.WrapError(err, "PriorityBuckets")
+		return
+	}
+	for za0003 := range z.PriorityBuckets {
+		err = en.WriteString(z.PriorityBuckets[za0003])
+		if err != nil {
+			err = msgp.WrapError(err, "PriorityBuckets", za0003)
+			return
+		}
+	}
+	// write "Paused"
+	err = en.Append(0xa6, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Paused)
+	if err != nil {
+		err = msgp.WrapError(err, "Paused")
+		return
+	}
+	// write "PausedAt"
+	err = en.Append(0xa8, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.PausedAt)
+	if err != nil {
+		err = msgp.WrapError(err, "PausedAt")
+		return
+	}
+	// write "PausedNanos"
+	err = en.Append(0xab, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x4e, 0x61, 0x6e, 0x6f, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.PausedNanos)
+	if err != nil {
+		err = msgp.WrapError(err, "PausedNanos")
+		return
+	}
+	// write "ObjectsTotal"
+	err = en.Append(0xac, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.ObjectsTotal)
+	if err != nil {
+		err = msgp.WrapError(err, "ObjectsTotal")
+		return
+	}
+	// write "BytesTotal"
+	err = en.Append(0xaa, 0x42, 0x79, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.BytesTotal)
+	if err != nil {
+		err = msgp.WrapError(err, "BytesTotal")
+		return
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *healingTracker) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 20
+	// map header, size 26
 	// string "ID"
-	o = append(o, 0xde, 0x0, 0x14, 0xa2, 0x49, 0x44)
+	o = append(o, 0xde, 0x0, 0x1a, 0xa2, 0x49, 0x44)
 	o = msgp.AppendString(o, z.ID)
 	// string "PoolIndex"
 	o = append(o, 0xa9, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78)
@@ -471,6 +587,27 @@ func (z *healingTracker) MarshalMsg(b []byte) (o []byte, err error) {
 	for za0002 := range z.HealedBuckets {
 		o = msgp.AppendString(o, z.HealedBuckets[za0002])
 	}
+	// string "PriorityBuckets"
+	o = append(o, 0xaf, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.PriorityBuckets)))
+	for za0003 := range z.PriorityBuckets {
+		o = msgp.AppendString(o, z.PriorityBuckets[za0003])
+	}
+	// string "Paused"
+	o = append(o, 0xa6, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64)
+	o = msgp.AppendBool(o, z.Paused)
+	// string "PausedAt"
+	o = append(o, 0xa8, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendTime(o, z.PausedAt)
+	// string "PausedNanos"
+	o = append(o, 0xab, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x4e, 0x61, 0x6e, 0x6f, 0x73)
+	o = msgp.AppendInt64(o, z.PausedNanos)
+	// string "ObjectsTotal"
+	o = append(o, 0xac, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c)
+	o = msgp.AppendUint64(o, z.ObjectsTotal)
+	// string "BytesTotal"
+	o = append(o, 0xaa, 0x42, 0x79, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c)
+	o = msgp.AppendUint64(o, z.BytesTotal)
 	return
 }
 
@@ -638,6 +775,55 @@ func (z *healingTracker) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					return
 				}
 			}
+		case "PriorityBuckets":
+			var zb0004 uint32
+			zb0004, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PriorityBuckets")
+				return
+			}
+			if cap(z.PriorityBuckets) >= int(zb0004) {
+				z.PriorityBuckets = (z.PriorityBuckets)[:zb0004]
+			} else {
+				z.PriorityBuckets = make([]string, zb0004)
+			}
+			for za0003 := range z.PriorityBuckets {
+				z.PriorityBuckets[za0003], bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "PriorityBuckets", za0003)
+					return
+				}
+			}
+		case "Paused":
+			z.Paused, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Paused")
+				return
+			}
+		case "PausedAt":
+			z.PausedAt, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PausedAt")
+				return
+			}
+		case "PausedNanos":
+			z.PausedNanos, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PausedNanos")
+				return
+			}
+		case "ObjectsTotal":
+			z.ObjectsTotal, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ObjectsTotal")
+				return
+			}
+		case "BytesTotal":
+			z.BytesTotal, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BytesTotal")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -660,5 +846,10 @@ func (z *healingTracker) Msgsize() (s int) {
 	for za0002 := range z.HealedBuckets {
 		s += msgp.StringPrefixSize + len(z.HealedBuckets[za0002])
 	}
+	s += 16 + msgp.ArrayHeaderSize
+	for za0003 := range z.PriorityBuckets {
+		s += msgp.StringPrefixSize + len(z.PriorityBuckets[za0003])
+	}
+	s += 7 + msgp.BoolSize + 9 + msgp.TimeSize + 12 + msgp.Int64Size + 13 + msgp.Uint64Size + 11 + msgp.Uint64Size
 	return
 }
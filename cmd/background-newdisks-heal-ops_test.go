@@ -0,0 +1,174 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"minio/cmd/config/heal"
+	"minio/pkg/madmin"
+)
+
+func healBucketNames(buckets []BucketInfo) []string {
+	names := make([]string, 0, len(buckets))
+	for _, b := range buckets {
+		names = append(names, b.Name)
+	}
+	return names
+}
+
+func TestSortHealBuckets(t *testing.T) {
+	now := time.Now()
+	newBuckets := func() []BucketInfo {
+		return []BucketInfo{
+			{Name: "small", Created: now.Add(-2 * time.Hour)},
+			{Name: pathJoin(minioMetaBucket, minioConfigPrefix)},
+			{Name: "large", Created: now.Add(-time.Hour)},
+			{Name: "medium", Created: now.Add(-3 * time.Hour)},
+		}
+	}
+	usage := madmin.DataUsageInfo{
+		BucketsUsage: map[string]madmin.BucketUsageInfo{
+			"small":  {Size: 10},
+			"medium": {Size: 100},
+			"large":  {Size: 1000},
+		},
+	}
+	config := pathJoin(minioMetaBucket, minioConfigPrefix)
+
+	testCases := []struct {
+		order string
+		want  []string
+	}{
+		{heal.DriveOrderNewest, []string{config, "large", "small", "medium"}},
+		{heal.DriveOrderOldest, []string{config, "medium", "small", "large"}},
+		{heal.DriveOrderSmallest, []string{config, "small", "medium", "large"}},
+		{heal.DriveOrderLargest, []string{config, "large", "medium", "small"}},
+	}
+	for _, testCase := range testCases {
+		buckets := newBuckets()
+		sortHealBuckets(buckets, testCase.order, usage)
+		if got := healBucketNames(buckets); !reflect.DeepEqual(got, testCase.want) {
+			t.Errorf("order %s: expected %v, got %v", testCase.order, testCase.want, got)
+		}
+	}
+}
+
+func TestHealingTrackerPrioritizeBuckets(t *testing.T) {
+	config := pathJoin(minioMetaBucket, minioConfigPrefix)
+	buckets := []BucketInfo{{Name: config}, {Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}}
+
+	h := healingTracker{}
+	if got := healBucketNames(h.prioritizeBuckets(buckets)); !reflect.DeepEqual(got, []string{config, "a", "b", "c", "d"}) {
+		t.Errorf("expected order to be unchanged, got %v", got)
+	}
+
+	h.PriorityBuckets = []string{"d", "missing", "b"}
+	want := []string{config, "d", "b", "a", "c"}
+	if got := healBucketNames(h.prioritizeBuckets(buckets)); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if got := healBucketNames(buckets); !reflect.DeepEqual(got, []string{config, "a", "b", "c", "d"}) {
+		t.Errorf("expected input to be unchanged, got %v", got)
+	}
+}
+
+func TestHealingTrackerETA(t *testing.T) {
+	now := time.Now().UTC()
+	h := healingTracker{
+		Started:    now.Add(-time.Hour),
+		BytesTotal: 400,
+	}
+	if eta := h.eta(now); eta != 0 {
+		t.Errorf("expected no ETA before healing any bytes, got %v", eta)
+	}
+
+	h.BytesDone = 90
+	h.BytesFailed = 10
+	if eta := h.eta(now); eta != 3*time.Hour {
+		t.Errorf("expected ETA of 3h, got %v", eta)
+	}
+
+	// Time spent paused is not counted.
+	h.Started = now.Add(-2 * time.Hour)
+	h.PausedNanos = int64(30 * time.Minute)
+	h.Paused = true
+	h.PausedAt = now.Add(-30 * time.Minute)
+	if eta := h.eta(now); eta != 3*time.Hour {
+		t.Errorf("expected ETA of 3h while paused, got %v", eta)
+	}
+
+	h.BytesDone = 400
+	if eta := h.eta(now); eta != 0 {
+		t.Errorf("expected no ETA when done, got %v", eta)
+	}
+}
+
+func TestHealingTrackerCheckPaused(t *testing.T) {
+	disk, diskPath, err := newXLStorageTestSetup()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(diskPath)
+
+	ctx := context.Background()
+	h := newHealingTracker(disk)
+	if err = h.save(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	ep := disk.Endpoint()
+	defer globalBackgroundHealState.popHealLocalDisks(ep)
+	globalBackgroundHealState.setHealLocalDiskPaused(ep, true)
+	if err = h.checkPaused(ctx); err != errHealPaused {
+		t.Fatalf("expected %v, got %v", errHealPaused, err)
+	}
+	saved, err := loadHealingTracker(ctx, disk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !saved.Paused {
+		t.Error("expected the pause to be saved in the tracker")
+	}
+
+	globalBackgroundHealState.setHealLocalDiskPaused(ep, false)
+	if err = h.checkPaused(ctx); err != nil {
+		t.Fatalf("expected no error after resuming, got %v", err)
+	}
+	if h.Paused || !h.PausedAt.IsZero() {
+		t.Errorf("expected the pause to be recorded as ended, got paused %v since %v", h.Paused, h.PausedAt)
+	}
+}
+
+func TestHealingTrackerSetTotals(t *testing.T) {
+	usage := madmin.DataUsageInfo{
+		BucketsUsage: map[string]madmin.BucketUsageInfo{
+			"a": {Size: 1000, ObjectsCount: 40},
+			"b": {Size: 600, ObjectsCount: 8},
+			"c": {Size: 1 << 20, ObjectsCount: 1 << 10},
+		},
+	}
+	var h healingTracker
+	h.setTotals([]BucketInfo{{Name: "a"}, {Name: "b"}, {Name: "missing"}}, usage, 4)
+	if h.BytesTotal != 400 || h.ObjectsTotal != 12 {
+		t.Errorf("expected 400 bytes and 12 objects, got %d bytes and %d objects", h.BytesTotal, h.ObjectsTotal)
+	}
+}
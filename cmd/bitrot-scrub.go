@@ -0,0 +1,470 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"minio/cmd/logger"
+	"minio/pkg/color"
+	"minio/pkg/console"
+	"minio/pkg/madmin"
+)
+
+const (
+	defaultMonitorScrubInterval = time.Minute * 10
+	scrubTrackerFilename        = ".scrub.bin"
+
+	// Maximum number of corrupted shards reported per disk and cycle.
+	scrubMaxCorruptedShards = 1000
+)
+
+//go:generate msgp -file $GOFILE -unexported
+
+// scrubShard is a corrupted shard found by bitrot scrubbing.
+type scrubShard struct {
+	Bucket    string
+	Object    string
+	VersionID string
+	Found     time.Time
+	Error     string
+	Repaired  bool
+}
+
+// scrubTracker is used to persist bitrot scrub progress of a disk,
+// an interrupted cycle resumes from the last object scanned.
+type scrubTracker struct {
+	disk StorageAPI `msg:"-"`
+
+	ID        string
+	PoolIndex int
+	SetIndex  int
+	DiskIndex int
+	Path      string
+	Endpoint  string
+
+	// Start of the current or last cycle.
+	Started    time.Time
+	LastUpdate time.Time
+	// Completion of the last full cycle.
+	LastCycle time.Time
+	Cycles    uint64
+
+	ObjectsScanned  uint64
+	BytesScanned    uint64
+	ShardsCorrupted uint64
+	ShardsRepaired  uint64
+	ShardsFailed    uint64
+
+	// Last object scanned.
+	Bucket string
+	Object string
+
+	// Filled during scrub.
+	ScrubbedBuckets []string
+	CorruptedShards []scrubShard
+	// Add future tracking capabilities
+	// Be sure that they are included in toScrubDisk
+}
+
+// loadScrubTracker will load the scrub tracker from the supplied disk.
+// The disk ID will be validated against the loaded one.
+func loadScrubTracker(ctx context.Context, disk StorageAPI) (*scrubTracker, error) {
+	if disk == nil {
+		return nil, errors.New("loadScrubTracker: nil disk given")
+	}
+	diskID, err := disk.GetDiskID()
+	if err != nil {
+		return nil, err
+	}
+	b, err := disk.ReadAll(ctx, minioMetaBucket,
+		pathJoin(bucketMetaPrefix, slashSeparator, scrubTrackerFilename))
+	if err != nil {
+		return nil, err
+	}
+	var t scrubTracker
+	if _, err = t.UnmarshalMsg(b); err != nil {
+		return nil, err
+	}
+	if t.ID != diskID && t.ID != "" {
+		return nil, fmt.Errorf("loadScrubTracker: disk id mismatch expected %s, got %s", t.ID, diskID)
+	}
+	t.disk = disk
+	t.ID = diskID
+	return &t, nil
+}
+
+// newScrubTracker will create a new scrub tracker for the disk.
+func newScrubTracker(disk StorageAPI) *scrubTracker {
+	diskID, _ := disk.GetDiskID()
+	t := scrubTracker{
+		disk:     disk,
+		ID:       diskID,
+		Path:     disk.String(),
+		Endpoint: disk.Endpoint().String(),
+	}
+	t.PoolIndex, t.SetIndex, t.DiskIndex = disk.GetDiskLoc()
+	return &t
+}
+
+// save will unconditionally save the tracker and will be created if not existing.
+func (t *scrubTracker) save(ctx context.Context) error {
+	t.PoolIndex, t.SetIndex, t.DiskIndex = t.disk.GetDiskLoc()
+	t.LastUpdate = UTCNow()
+	b, err := t.MarshalMsg(nil)
+	if err != nil {
+		return err
+	}
+	return t.disk.WriteAll(ctx, minioMetaBucket,
+		pathJoin(bucketMetaPrefix, slashSeparator, scrubTrackerFilename), b)
+}
+
+// inProgress returns true if a cycle was started and not completed.
+func (t *scrubTracker) inProgress() bool {
+	return t.Started.After(t.LastCycle)
+}
+
+// due returns true if a cycle is to be resumed or
+// the last cycle completed longer than cycle ago.
+func (t *scrubTracker) due(cycle time.Duration, now time.Time) bool {
+	return t.inProgress() || now.Sub(t.LastCycle) >= cycle
+}
+
+// startCycle resets the progress and the report, unless
+// an interrupted cycle is resumed.
+func (t *scrubTracker) startCycle(now time.Time) {
+	if t.inProgress() {
+		return
+	}
+	t.Started = now
+	t.ObjectsScanned = 0
+	t.BytesScanned = 0
+	t.ShardsCorrupted = 0
+	t.ShardsRepaired = 0
+	t.ShardsFailed = 0
+	t.Bucket = ""
+	t.Object = ""
+	t.ScrubbedBuckets = nil
+	t.CorruptedShards = nil
+}
+
+// finishCycle should be called when all buckets are scrubbed.
+func (t *scrubTracker) finishCycle(now time.Time) {
+	t.LastCycle = now
+	t.Cycles++
+	t.Bucket = ""
+	t.Object = ""
+	t.ScrubbedBuckets = nil
+}
+
+func (t *scrubTracker) isScrubbed(bucket string) bool {
+	for _, v := range t.ScrubbedBuckets {
+		if v == bucket {
+			return true
+		}
+	}
+	return false
+}
+
+// bucketDone should be called when a bucket is done scrubbing.
+func (t *scrubTracker) bucketDone(bucket string) {
+	t.ScrubbedBuckets = append(t.ScrubbedBuckets, bucket)
+}
+
+// addCorrupted records a corrupted shard, the report is
+// capped at scrubMaxCorruptedShards shards per cycle.
+func (t *scrubTracker) addCorrupted(shard scrubShard) {
+	t.ShardsCorrupted++
+	if shard.Repaired {
+		t.ShardsRepaired++
+	} else {
+		t.ShardsFailed++
+	}
+	if len(t.CorruptedShards) < scrubMaxCorruptedShards {
+		t.CorruptedShards = append(t.CorruptedShards, shard)
+	}
+}
+
+// toScrubDisk converts the information to madmin.ScrubDisk
+func (t *scrubTracker) toScrubDisk() madmin.ScrubDisk {
+	d := madmin.ScrubDisk{
+		ID:              t.ID,
+		PoolIndex:       t.PoolIndex,
+		SetIndex:        t.SetIndex,
+		DiskIndex:       t.DiskIndex,
+		Endpoint:        t.Endpoint,
+		Path:            t.Path,
+		Started:         t.Started.UTC(),
+		LastUpdate:      t.LastUpdate.UTC(),
+		LastCycle:       t.LastCycle.UTC(),
+		Cycles:          t.Cycles,
+		ObjectsScanned:  t.ObjectsScanned,
+		BytesScanned:    t.BytesScanned,
+		ShardsCorrupted: t.ShardsCorrupted,
+		ShardsRepaired:  t.ShardsRepaired,
+		ShardsFailed:    t.ShardsFailed,
+		Bucket:          t.Bucket,
+		Object:          t.Object,
+	}
+	for _, shard := range t.CorruptedShards {
+		d.CorruptedShards = append(d.CorruptedShards, madmin.ScrubShard{
+			Bucket:    shard.Bucket,
+			Object:    shard.Object,
+			VersionID: shard.VersionID,
+			Found:     shard.Found.UTC(),
+			Error:     shard.Error,
+			Repaired:  shard.Repaired,
+		})
+	}
+	return d
+}
+
+// verifyShard verifies the shard of the object version read from
+// the disk against its bitrot checksum, inlined data included.
+func verifyShard(ctx context.Context, disk StorageAPI, bucket, object string, fi FileInfo) error {
+	if (len(fi.Data) > 0 || fi.Size == 0) && len(fi.Parts) > 0 {
+		checksumInfo := fi.Erasure.GetChecksumInfo(fi.Parts[0].Number)
+		return bitrotVerify(bytes.NewBuffer(fi.Data),
+			int64(len(fi.Data)),
+			fi.Erasure.ShardFileSize(fi.Size),
+			checksumInfo.Algorithm,
+			checksumInfo.Hash, fi.Erasure.ShardSize())
+	}
+	return disk.VerifyFile(ctx, bucket, object, fi)
+}
+
+// scrubVersion verifies the shard of the object version on the disk,
+// a corrupted or missing shard is healed and verified again.
+func (z *erasureServerPools) scrubVersion(ctx context.Context, disk StorageAPI, tracker *scrubTracker, bucket string, version FileInfo, sleeper *dynamicSleeper) {
+	if version.Deleted || version.TransitionStatus != "" {
+		return
+	}
+	object := version.Name
+	versionID := version.VersionID
+	if versionID == "" {
+		versionID = nullVersionID
+	}
+
+	wait := sleeper.Timer(ctx)
+	defer wait()
+
+	fi, err := disk.ReadVersion(ctx, bucket, object, versionID, true)
+	if err != nil {
+		// Ignore versions removed since listed.
+		if !errors.Is(err, errFileNotFound) && !errors.Is(err, errFileVersionNotFound) {
+			logger.LogIf(ctx, err)
+		}
+		return
+	}
+	err = verifyShard(ctx, disk, bucket, object, fi)
+	if err != nil && !errors.Is(err, errFileCorrupt) && !errors.Is(err, errFileNotFound) {
+		if !errors.Is(err, context.Canceled) {
+			logger.LogIf(ctx, err)
+		}
+		return
+	}
+
+	tracker.ObjectsScanned++
+	tracker.BytesScanned += uint64(fi.Size)
+	if err == nil {
+		return
+	}
+
+	if serverDebugLog {
+		console.Debugf(color.Green("scrubDisk:")+" corrupted shard of %s/%s (%s) on %s: %v\n", bucket, object, versionID, disk, err)
+	}
+
+	shard := scrubShard{
+		Bucket:    bucket,
+		Object:    object,
+		VersionID: version.VersionID,
+		Found:     UTCNow(),
+		Error:     err.Error(),
+	}
+	_, err = z.HealObject(ctx, bucket, object, version.VersionID, madmin.HealOpts{
+		ScanMode: madmin.HealDeepScan, Remove: healDeleteDangling})
+	if err == nil {
+		fi, err = disk.ReadVersion(ctx, bucket, object, versionID, true)
+		if err == nil {
+			err = verifyShard(ctx, disk, bucket, object, fi)
+		}
+	}
+	if err != nil && !isErrObjectNotFound(err) && !isErrVersionNotFound(err) {
+		logger.LogIf(ctx, err)
+	}
+	shard.Repaired = err == nil
+	tracker.addCorrupted(shard)
+}
+
+// scrubDisk verifies every shard on the disk against its bitrot
+// checksum, resuming from the last object of an interrupted cycle.
+func (z *erasureServerPools) scrubDisk(ctx context.Context, disk StorageAPI, tracker *scrubTracker, buckets []BucketInfo, sleeper *dynamicSleeper) error {
+	tracker.startCycle(UTCNow())
+
+	for _, bucket := range buckets {
+		if tracker.isScrubbed(bucket.Name) {
+			continue
+		}
+		var forwardTo string
+		// If we resume to the same bucket, forward to last known item.
+		if tracker.Bucket == bucket.Name {
+			forwardTo = tracker.Object
+		}
+		tracker.Bucket = bucket.Name
+
+		if serverDebugLog {
+			console.Debugf(color.Green("scrubDisk:")+" scrubbing bucket %s on %s\n", bucket.Name, disk)
+		}
+
+		scrubEntry := func(entry metaCacheEntry) {
+			if entry.isDir() {
+				return
+			}
+			fivs, err := entry.fileInfoVersions(bucket.Name)
+			if err != nil {
+				logger.LogIf(ctx, err)
+				return
+			}
+			for _, version := range fivs.Versions {
+				z.scrubVersion(ctx, disk, tracker, bucket.Name, version, sleeper)
+			}
+			tracker.Object = entry.name
+			if time.Since(tracker.LastUpdate) > time.Minute {
+				logger.LogIf(ctx, tracker.save(ctx))
+			}
+		}
+
+		err := listPathRaw(ctx, listPathRawOptions{
+			disks:     []StorageAPI{disk},
+			bucket:    bucket.Name,
+			recursive: true,
+			forwardTo: forwardTo,
+			minDisks:  1,
+			agreed:    scrubEntry,
+		})
+
+		select {
+		// If context is canceled don't mark as done...
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+			logger.LogIf(ctx, err)
+			tracker.bucketDone(bucket.Name)
+			logger.LogIf(ctx, tracker.save(ctx))
+		}
+	}
+
+	tracker.finishCycle(UTCNow())
+	return tracker.save(ctx)
+}
+
+// scrubLocalDisks scrubs the local disks due for a bitrot scrub cycle.
+// Erasure sets are scrubbed in parallel, each throttled by its own
+// sleeper, and the disks of an erasure set one after the other.
+func (z *erasureServerPools) scrubLocalDisks(ctx context.Context) {
+	globalHealConfigMu.Lock()
+	cfg := globalHealConfig
+	globalHealConfigMu.Unlock()
+
+	if cfg.ScrubCycle <= 0 {
+		return
+	}
+
+	buckets, err := z.ListBuckets(ctx)
+	if err != nil {
+		logger.LogIf(ctx, err)
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, pool := range z.serverPools {
+		for _, set := range pool.sets {
+			disks := set.getLocalDisks()
+			if len(disks) == 0 {
+				continue
+			}
+			wg.Add(1)
+			go func(disks []StorageAPI) {
+				defer wg.Done()
+				sleeper := newDynamicSleeper(cfg.ScrubDelay, cfg.Sleep)
+				for _, disk := range disks {
+					if disk.Healing() != nil || !disk.IsOnline() {
+						continue
+					}
+					tracker, err := loadScrubTracker(ctx, disk)
+					if err != nil {
+						tracker = newScrubTracker(disk)
+					}
+					if !tracker.due(cfg.ScrubCycle, UTCNow()) {
+						continue
+					}
+					if err = z.scrubDisk(ctx, disk, tracker, buckets, sleeper); err != nil && !errors.Is(err, context.Canceled) {
+						logger.LogIf(ctx, err)
+					}
+				}
+			}(disks)
+		}
+	}
+	wg.Wait()
+}
+
+// monitorLocalDisksAndScrub - periodically scrubs the local disks
+// due for a bitrot scrub cycle, only the node hosting a disk
+// scrubs it.
+func monitorLocalDisksAndScrub(ctx context.Context, z *erasureServerPools) {
+	scrubTimer := time.NewTimer(defaultMonitorScrubInterval)
+	defer scrubTimer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-scrubTimer.C:
+			z.scrubLocalDisks(ctx)
+			scrubTimer.Reset(defaultMonitorScrubInterval)
+		}
+	}
+}
+
+// getLocalBitrotScrubStatus returns the bitrot scrub report of the local disks.
+func getLocalBitrotScrubStatus(ctx context.Context, o ObjectLayer) madmin.BitrotScrubStatus {
+	globalHealConfigMu.Lock()
+	status := madmin.BitrotScrubStatus{Cycle: globalHealConfig.ScrubCycle}
+	globalHealConfigMu.Unlock()
+
+	z, ok := o.(*erasureServerPools)
+	if !ok {
+		return status
+	}
+	for _, pool := range z.serverPools {
+		for _, set := range pool.sets {
+			for _, disk := range set.getLocalDisks() {
+				tracker, err := loadScrubTracker(ctx, disk)
+				if err != nil {
+					continue
+				}
+				status.Disks = append(status.Disks, tracker.toScrubDisk())
+			}
+		}
+	}
+	return status
+}
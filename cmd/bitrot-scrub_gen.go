@@ -0,0 +1,871 @@
+package cmd
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"github.com/tinylib/msgp/msgp"
+)
+
+// DecodeMsg implements msgp.Decodable
+func (z *scrubShard) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Bucket":
+			z.Bucket, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Bucket")
+				return
+			}
+		case "Object":
+			z.Object, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Object")
+				return
+			}
+		case "VersionID":
+			z.VersionID, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "VersionID")
+				return
+			}
+		case "Found":
+			z.Found, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "Found")
+				return
+			}
+		case "Error":
+			z.Error, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+		case "Repaired":
+			z.Repaired, err = dc.ReadBool()
+			if err != nil {
+				err = msgp.WrapError(err, "Repaired")
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *scrubShard) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 6
+	// write "Bucket"
+	err = en.Append(0x86, 0xa6, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Bucket)
+	if err != nil {
+		err = msgp.WrapError(err, "Bucket")
+		return
+	}
+	// write "Object"
+	err = en.Append(0xa6, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Object)
+	if err != nil {
+		err = msgp.WrapError(err, "Object")
+		return
+	}
+	// write "VersionID"
+	err = en.Append(0xa9, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.VersionID)
+	if err != nil {
+		err = msgp.WrapError(err, "VersionID")
+		return
+	}
+	// write "Found"
+	err = en.Append(0xa5, 0x46, 0x6f, 0x75, 0x6e, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.Found)
+	if err != nil {
+		err = msgp.WrapError(err, "Found")
+		return
+	}
+	// write "Error"
+	err = en.Append(0xa5, 0x45, 0x72, 0x72, 0x6f, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Error)
+	if err != nil {
+		err = msgp.WrapError(err, "Error")
+		return
+	}
+	// write "Repaired"
+	err = en.Append(0xa8, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Repaired)
+	if err != nil {
+		err = msgp.WrapError(err, "Repaired")
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *scrubShard) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 6
+	// string "Bucket"
+	o = append(o, 0x86, 0xa6, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74)
+	o = msgp.AppendString(o, z.Bucket)
+	// string "Object"
+	o = append(o, 0xa6, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74)
+	o = msgp.AppendString(o, z.Object)
+	// string "VersionID"
+	o = append(o, 0xa9, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44)
+	o = msgp.AppendString(o, z.VersionID)
+	// string "Found"
+	o = append(o, 0xa5, 0x46, 0x6f, 0x75, 0x6e, 0x64)
+	o = msgp.AppendTime(o, z.Found)
+	// string "Error"
+	o = append(o, 0xa5, 0x45, 0x72, 0x72, 0x6f, 0x72)
+	o = msgp.AppendString(o, z.Error)
+	// string "Repaired"
+	o = append(o, 0xa8, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64)
+	o = msgp.AppendBool(o, z.Repaired)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *scrubShard) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Bucket":
+			z.Bucket, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Bucket")
+				return
+			}
+		case "Object":
+			z.Object, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Object")
+				return
+			}
+		case "VersionID":
+			z.VersionID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "VersionID")
+				return
+			}
+		case "Found":
+			z.Found, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Found")
+				return
+			}
+		case "Error":
+			z.Error, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Error")
+				return
+			}
+		case "Repaired":
+			z.Repaired, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Repaired")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *scrubShard) Msgsize() (s int) {
+	s = 1 + 7 + msgp.StringPrefixSize + len(z.Bucket) + 7 + msgp.StringPrefixSize + len(z.Object) + 10 + msgp.StringPrefixSize + len(z.VersionID) + 6 + msgp.TimeSize + 6 + msgp.StringPrefixSize + len(z.Error) + 9 + msgp.BoolSize
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *scrubTracker) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ID":
+			z.ID, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "ID")
+				return
+			}
+		case "PoolIndex":
+			z.PoolIndex, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "PoolIndex")
+				return
+			}
+		case "SetIndex":
+			z.SetIndex, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "SetIndex")
+				return
+			}
+		case "DiskIndex":
+			z.DiskIndex, err = dc.ReadInt()
+			if err != nil {
+				err = msgp.WrapError(err, "DiskIndex")
+				return
+			}
+		case "Path":
+			z.Path, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Path")
+				return
+			}
+		case "Endpoint":
+			z.Endpoint, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Endpoint")
+				return
+			}
+		case "Started":
+			z.Started, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "Started")
+				return
+			}
+		case "LastUpdate":
+			z.LastUpdate, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "LastUpdate")
+				return
+			}
+		case "LastCycle":
+			z.LastCycle, err = dc.ReadTime()
+			if err != nil {
+				err = msgp.WrapError(err, "LastCycle")
+				return
+			}
+		case "Cycles":
+			z.Cycles, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "Cycles")
+				return
+			}
+		case "ObjectsScanned":
+			z.ObjectsScanned, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "ObjectsScanned")
+				return
+			}
+		case "BytesScanned":
+			z.BytesScanned, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "BytesScanned")
+				return
+			}
+		case "ShardsCorrupted":
+			z.ShardsCorrupted, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "ShardsCorrupted")
+				return
+			}
+		case "ShardsRepaired":
+			z.ShardsRepaired, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "ShardsRepaired")
+				return
+			}
+		case "ShardsFailed":
+			z.ShardsFailed, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "ShardsFailed")
+				return
+			}
+		case "Bucket":
+			z.Bucket, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Bucket")
+				return
+			}
+		case "Object":
+			z.Object, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Object")
+				return
+			}
+		case "ScrubbedBuckets":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "ScrubbedBuckets")
+				return
+			}
+			if cap(z.ScrubbedBuckets) >= int(zb0002) {
+				z.ScrubbedBuckets = (z.ScrubbedBuckets)[:zb0002]
+			} else {
+				z.ScrubbedBuckets = make([]string, zb0002)
+			}
+			for za0001 := range z.ScrubbedBuckets {
+				z.ScrubbedBuckets[za0001], err = dc.ReadString()
+				if err != nil {
+					err = msgp.WrapError(err, "ScrubbedBuckets", za0001)
+					return
+				}
+			}
+		case "CorruptedShards":
+			var zb0003 uint32
+			zb0003, err = dc.ReadArrayHeader()
+			if err != nil {
+				err = msgp.WrapError(err, "CorruptedShards")
+				return
+			}
+			if cap(z.CorruptedShards) >= int(zb0003) {
+				z.CorruptedShards = (z.CorruptedShards)[:zb0003]
+			} else {
+				z.CorruptedShards = make([]scrubShard, zb0003)
+			}
+			for za0002 := range z.CorruptedShards {
+				err = z.CorruptedShards[za0002].DecodeMsg(dc)
+				if err != nil {
+					err = msgp.WrapError(err, "CorruptedShards", za0002)
+					return
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *scrubTracker) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 19
+	// write "ID"
+	err = en.Append(0xde, 0x0, 0x13, 0xa2, 0x49, 0x44)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.ID)
+	if err != nil {
+		err = msgp.WrapError(err, "ID")
+		return
+	}
+	// write "PoolIndex"
+	err = en.Append(0xa9, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.PoolIndex)
+	if err != nil {
+		err = msgp.WrapError(err, "PoolIndex")
+		return
+	}
+	// write "SetIndex"
+	err = en.Append(0xa8, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.SetIndex)
+	if err != nil {
+		err = msgp.WrapError(err, "SetIndex")
+		return
+	}
+	// write "DiskIndex"
+	err = en.Append(0xa9, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.DiskIndex)
+	if err != nil {
+		err = msgp.WrapError(err, "DiskIndex")
+		return
+	}
+	// write "Path"
+	err = en.Append(0xa4, 0x50, 0x61, 0x74, 0x68)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Path)
+	if err != nil {
+		err = msgp.WrapError(err, "Path")
+		return
+	}
+	// write "Endpoint"
+	err = en.Append(0xa8, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Endpoint)
+	if err != nil {
+		err = msgp.WrapError(err, "Endpoint")
+		return
+	}
+	// write "Started"
+	err = en.Append(0xa7, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.Started)
+	if err != nil {
+		err = msgp.WrapError(err, "Started")
+		return
+	}
+	// write "LastUpdate"
+	err = en.Append(0xaa, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.LastUpdate)
+	if err != nil {
+		err = msgp.WrapError(err, "LastUpdate")
+		return
+	}
+	// write "LastCycle"
+	err = en.Append(0xa9, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x79, 0x63, 0x6c, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteTime(z.LastCycle)
+	if err != nil {
+		err = msgp.WrapError(err, "LastCycle")
+		return
+	}
+	// write "Cycles"
+	err = en.Append(0xa6, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Cycles)
+	if err != nil {
+		err = msgp.WrapError(err, "Cycles")
+		return
+	}
+	// write "ObjectsScanned"
+	err = en.Append(0xae, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.ObjectsScanned)
+	if err != nil {
+		err = msgp.WrapError(err, "ObjectsScanned")
+		return
+	}
+	// write "BytesScanned"
+	err = en.Append(0xac, 0x42, 0x79, 0x74, 0x65, 0x73, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.BytesScanned)
+	if err != nil {
+		err = msgp.WrapError(err, "BytesScanned")
+		return
+	}
+	// write "ShardsCorrupted"
+	err = en.Append(0xaf, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x43, 0x6f, 0x72, 0x72, 0x75, 0x70, 0x74, 0x65, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.ShardsCorrupted)
+	if err != nil {
+		err = msgp.WrapError(err, "ShardsCorrupted")
+		return
+	}
+	// write "ShardsRepaired"
+	err = en.Append(0xae, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.ShardsRepaired)
+	if err != nil {
+		err = msgp.WrapError(err, "ShardsRepaired")
+		return
+	}
+	// write "ShardsFailed"
+	err = en.Append(0xac, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.ShardsFailed)
+	if err != nil {
+		err = msgp.WrapError(err, "ShardsFailed")
+		return
+	}
+	// write "Bucket"
+	err = en.Append(0xa6, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Bucket)
+	if err != nil {
+		err = msgp.WrapError(err, "Bucket")
+		return
+	}
+	// write "Object"
+	err = en.Append(0xa6, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Object)
+	if err != nil {
+		err = msgp.WrapError(err, "Object")
+		return
+	}
+	// write "ScrubbedBuckets"
+	err = en.Append(0xaf, 0x53, 0x63, 0x72, 0x75, 0x62, 0x62, 0x65, 0x64, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.ScrubbedBuckets)))
+	if err != nil {
+		err = msgp.WrapError(err, "ScrubbedBuckets")
+		return
+	}
+	for za0001 := range z.ScrubbedBuckets {
+		err = en.WriteString(z.ScrubbedBuckets[za0001])
+		if err != nil {
+			err = msgp.WrapError(err, "ScrubbedBuckets", za0001)
+			return
+		}
+	}
+	// write "CorruptedShards"
+	err = en.Append(0xaf, 0x43, 0x6f, 0x72, 0x72, 0x75, 0x70, 0x74, 0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.CorruptedShards)))
+	if err != nil {
+		err = msgp.WrapError(err, "CorruptedShards")
+		return
+	}
+	for za0002 := range z.CorruptedShards {
+		err = z.CorruptedShards[za0002].EncodeMsg(en)
+		if err != nil {
+			err = msgp.WrapError(err, "CorruptedShards", za0002)
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *scrubTracker) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 19
+	// string "ID"
+	o = append(o, 0xde, 0x0, 0x13, 0xa2, 0x49, 0x44)
+	o = msgp.AppendString(o, z.ID)
+	// string "PoolIndex"
+	o = append(o, 0xa9, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78)
+	o = msgp.AppendInt(o, z.PoolIndex)
+	// string "SetIndex"
+	o = append(o, 0xa8, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78)
+	o = msgp.AppendInt(o, z.SetIndex)
+	// string "DiskIndex"
+	o = append(o, 0xa9, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78)
+	o = msgp.AppendInt(o, z.DiskIndex)
+	// string "Path"
+	o = append(o, 0xa4, 0x50, 0x61, 0x74, 0x68)
+	o = msgp.AppendString(o, z.Path)
+	// string "Endpoint"
+	o = append(o, 0xa8, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74)
+	o = msgp.AppendString(o, z.Endpoint)
+	// string "Started"
+	o = append(o, 0xa7, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64)
+	o = msgp.AppendTime(o, z.Started)
+	// string "LastUpdate"
+	o = append(o, 0xaa, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65)
+	o = msgp.AppendTime(o, z.LastUpdate)
+	// string "LastCycle"
+	o = append(o, 0xa9, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x79, 0x63, 0x6c, 0x65)
+	o = msgp.AppendTime(o, z.LastCycle)
+	// string "Cycles"
+	o = append(o, 0xa6, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x73)
+	o = msgp.AppendUint64(o, z.Cycles)
+	// string "ObjectsScanned"
+	o = append(o, 0xae, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64)
+	o = msgp.AppendUint64(o, z.ObjectsScanned)
+	// string "BytesScanned"
+	o = append(o, 0xac, 0x42, 0x79, 0x74, 0x65, 0x73, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64)
+	o = msgp.AppendUint64(o, z.BytesScanned)
+	// string "ShardsCorrupted"
+	o = append(o, 0xaf, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x43, 0x6f, 0x72, 0x72, 0x75, 0x70, 0x74, 0x65, 0x64)
+	o = msgp.AppendUint64(o, z.ShardsCorrupted)
+	// string "ShardsRepaired"
+	o = append(o, 0xae, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64)
+	o = msgp.AppendUint64(o, z.ShardsRepaired)
+	// string "ShardsFailed"
+	o = append(o, 0xac, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64)
+	o = msgp.AppendUint64(o, z.ShardsFailed)
+	// string "Bucket"
+	o = append(o, 0xa6, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74)
+	o = msgp.AppendString(o, z.Bucket)
+	// string "Object"
+	o = append(o, 0xa6, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74)
+	o = msgp.AppendString(o, z.Object)
+	// string "ScrubbedBuckets"
+	o = append(o, 0xaf, 0x53, 0x63, 0x72, 0x75, 0x62, 0x62, 0x65, 0x64, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.ScrubbedBuckets)))
+	for za0001 := range z.ScrubbedBuckets {
+		o = msgp.AppendString(o, z.ScrubbedBuckets[za0001])
+	}
+	// string "CorruptedShards"
+	o = append(o, 0xaf, 0x43, 0x6f, 0x72, 0x72, 0x75, 0x70, 0x74, 0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.CorruptedShards)))
+	for za0002 := range z.CorruptedShards {
+		o, err = z.CorruptedShards[za0002].MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "CorruptedShards", za0002)
+			return
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *scrubTracker) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ID":
+			z.ID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ID")
+				return
+			}
+		case "PoolIndex":
+			z.PoolIndex, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PoolIndex")
+				return
+			}
+		case "SetIndex":
+			z.SetIndex, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SetIndex")
+				return
+			}
+		case "DiskIndex":
+			z.DiskIndex, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "DiskIndex")
+				return
+			}
+		case "Path":
+			z.Path, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Path")
+				return
+			}
+		case "Endpoint":
+			z.Endpoint, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Endpoint")
+				return
+			}
+		case "Started":
+			z.Started, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Started")
+				return
+			}
+		case "LastUpdate":
+			z.LastUpdate, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "LastUpdate")
+				return
+			}
+		case "LastCycle":
+			z.LastCycle, bts, err = msgp.ReadTimeBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "LastCycle")
+				return
+			}
+		case "Cycles":
+			z.Cycles, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Cycles")
+				return
+			}
+		case "ObjectsScanned":
+			z.ObjectsScanned, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ObjectsScanned")
+				return
+			}
+		case "BytesScanned":
+			z.BytesScanned, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BytesScanned")
+				return
+			}
+		case "ShardsCorrupted":
+			z.ShardsCorrupted, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ShardsCorrupted")
+				return
+			}
+		case "ShardsRepaired":
+			z.ShardsRepaired, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ShardsRepaired")
+				return
+			}
+		case "ShardsFailed":
+			z.ShardsFailed, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ShardsFailed")
+				return
+			}
+		case "Bucket":
+			z.Bucket, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Bucket")
+				return
+			}
+		case "Object":
+			z.Object, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Object")
+				return
+			}
+		case "ScrubbedBuckets":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ScrubbedBuckets")
+				return
+			}
+			if cap(z.ScrubbedBuckets) >= int(zb0002) {
+				z.ScrubbedBuckets = (z.ScrubbedBuckets)[:zb0002]
+			} else {
+				z.ScrubbedBuckets = make([]string, zb0002)
+			}
+			for za0001 := range z.ScrubbedBuckets {
+				z.ScrubbedBuckets[za0001], bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ScrubbedBuckets", za0001)
+					return
+				}
+			}
+		case "CorruptedShards":
+			var zb0003 uint32
+			zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CorruptedShards")
+				return
+			}
+			if cap(z.CorruptedShards) >= int(zb0003) {
+				z.CorruptedShards = (z.CorruptedShards)[:zb0003]
+			} else {
+				z.CorruptedShards = make([]scrubShard, zb0003)
+			}
+			for za0002 := range z.CorruptedShards {
+				bts, err = z.CorruptedShards[za0002].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "CorruptedShards", za0002)
+					return
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *scrubTracker) Msgsize() (s int) {
+	s = 3 + 3 + msgp.StringPrefixSize + len(z.ID) + 10 + msgp.IntSize + 9 + msgp.IntSize + 10 + msgp.IntSize + 5 + msgp.StringPrefixSize + len(z.Path) + 9 + msgp.StringPrefixSize + len(z.Endpoint) + 8 + msgp.TimeSize + 11 + msgp.TimeSize + 10 + msgp.TimeSize + 7 + msgp.Uint64Size + 15 + msgp.Uint64Size + 13 + msgp.Uint64Size + 16 + msgp.Uint64Size + 15 + msgp.Uint64Size + 13 + msgp.Uint64Size + 7 + msgp.StringPrefixSize + len(z.Bucket) + 7 + msgp.StringPrefixSize + len(z.Object) + 16 + msgp.ArrayHeaderSize
+	for za0001 := range z.ScrubbedBuckets {
+		s += msgp.StringPrefixSize + len(z.ScrubbedBuckets[za0001])
+	}
+	s += 16 + msgp.ArrayHeaderSize
+	for za0002 := range z.CorruptedShards {
+		s += z.CorruptedShards[za0002].Msgsize()
+	}
+	return
+}
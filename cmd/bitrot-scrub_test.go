@@ -0,0 +1,166 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"minio/cmd/config/storageclass"
+)
+
+func TestScrubTrackerCycle(t *testing.T) {
+	now := time.Now()
+	tracker := &scrubTracker{}
+	if !tracker.due(time.Hour, now) {
+		t.Fatal("Expected a first cycle to be due")
+	}
+
+	tracker.startCycle(now)
+	tracker.ObjectsScanned = 10
+	tracker.addCorrupted(scrubShard{Repaired: true})
+	tracker.addCorrupted(scrubShard{})
+	tracker.Bucket, tracker.Object = "bucket", "object"
+	if !tracker.inProgress() || !tracker.due(time.Hour, now) {
+		t.Fatal("Expected an interrupted cycle to be due")
+	}
+
+	// Resuming keeps the progress.
+	tracker.startCycle(now.Add(time.Minute))
+	if tracker.ObjectsScanned != 10 || tracker.Object != "object" {
+		t.Fatalf("Expected progress to be kept on resume, got %d objects at %q", tracker.ObjectsScanned, tracker.Object)
+	}
+	if tracker.ShardsCorrupted != 2 || tracker.ShardsRepaired != 1 || tracker.ShardsFailed != 1 || len(tracker.CorruptedShards) != 2 {
+		t.Fatalf("Unexpected corrupted shards %d repaired %d failed %d", tracker.ShardsCorrupted, tracker.ShardsRepaired, tracker.ShardsFailed)
+	}
+
+	tracker.finishCycle(now.Add(2 * time.Minute))
+	if tracker.inProgress() || tracker.Cycles != 1 || tracker.Bucket != "" {
+		t.Fatal("Expected the cycle to be complete")
+	}
+	if tracker.due(time.Hour, now.Add(30*time.Minute)) {
+		t.Fatal("Expected no cycle to be due")
+	}
+	if !tracker.due(time.Hour, now.Add(2*time.Hour)) {
+		t.Fatal("Expected the next cycle to be due")
+	}
+
+	// A new cycle resets the report.
+	tracker.startCycle(now.Add(2 * time.Hour))
+	if tracker.ObjectsScanned != 0 || tracker.ShardsCorrupted != 0 || len(tracker.CorruptedShards) != 0 {
+		t.Fatal("Expected the report to be reset on a new cycle")
+	}
+}
+
+func TestScrubDisk(t *testing.T) {
+	restoreGlobalStorageClass := globalStorageClass
+	defer func() {
+		globalStorageClass = restoreGlobalStorageClass
+	}()
+	// Store the data in part files.
+	globalStorageClass = storageclass.Config{
+		InlineThreshold: map[string]int64{storageclass.STANDARD: 0},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	z := obj.(*erasureServerPools)
+	xl := z.serverPools[0].sets[0]
+
+	bucket := "bucket"
+	if err = obj.MakeBucketWithLocation(ctx, bucket, BucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	data := bytes.Repeat([]byte("a"), 64*1024)
+	for _, object := range []string{"object1", "object2"} {
+		_, err = obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	disk := xl.getDisks()[0]
+	fi, err := disk.ReadVersion(ctx, bucket, "object2", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	partPath := pathJoin(disk.Endpoint().Path, bucket, "object2", fi.DataDir, "part.1")
+	part, err := ioutil.ReadFile(partPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	corrupted := append([]byte{}, part...)
+	corrupted[len(corrupted)-1] ^= 0xff
+	if err = ioutil.WriteFile(partPath, corrupted, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	buckets, err := obj.ListBuckets(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tracker := newScrubTracker(disk)
+	if err = z.scrubDisk(ctx, disk, tracker, buckets, newDynamicSleeper(0, 0)); err != nil {
+		t.Fatal(err)
+	}
+
+	if tracker.ObjectsScanned != 2 || tracker.BytesScanned != uint64(2*len(data)) {
+		t.Fatalf("Expected 2 objects scanned, got %d with %d bytes", tracker.ObjectsScanned, tracker.BytesScanned)
+	}
+	if tracker.ShardsCorrupted != 1 || tracker.ShardsRepaired != 1 || len(tracker.CorruptedShards) != 1 {
+		t.Fatalf("Expected 1 repaired shard, got %d corrupted, %d repaired", tracker.ShardsCorrupted, tracker.ShardsRepaired)
+	}
+	if shard := tracker.CorruptedShards[0]; shard.Bucket != bucket || shard.Object != "object2" || !shard.Repaired {
+		t.Fatalf("Unexpected corrupted shard %#v", shard)
+	}
+	if tracker.inProgress() || tracker.Cycles != 1 {
+		t.Fatal("Expected the cycle to be complete")
+	}
+
+	// The shard is repaired.
+	fi, err = disk.ReadVersion(ctx, bucket, "object2", "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = disk.VerifyFile(ctx, bucket, "object2", fi); err != nil {
+		t.Fatal(err)
+	}
+
+	// The report is persisted on the disk.
+	loaded, err := loadScrubTracker(ctx, disk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Cycles != 1 || loaded.ShardsRepaired != 1 {
+		t.Fatalf("Unexpected persisted scrub tracker %#v", loaded.toScrubDisk())
+	}
+	status := getLocalBitrotScrubStatus(ctx, obj)
+	if len(status.Disks) != 1 || status.Disks[0].ShardsRepaired != 1 {
+		t.Fatalf("Unexpected scrub status %#v", status)
+	}
+}
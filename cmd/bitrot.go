@@ -26,8 +26,11 @@ import (
 	"io"
 
 	"github.com/minio/highwayhash"
+	"github.com/zeebo/blake3"
+	"github.com/zeebo/xxh3"
 	"golang.org/x/crypto/blake2b"
 
+	"minio/cmd/config/storageclass"
 	"minio/cmd/logger"
 )
 
@@ -39,6 +42,20 @@ var bitrotAlgorithms = map[BitrotAlgorithm]string{
 	BLAKE2b512:      "blake2b",
 	HighwayHash256:  "highwayhash256",
 	HighwayHash256S: "highwayhash256S",
+	BLAKE3:          "blake3",
+	XXH3:            "xxh3",
+}
+
+// xxh3Hash128 is the 128 bit XXH3 hash.Hash.
+type xxh3Hash128 struct {
+	*xxh3.Hasher
+}
+
+func (h xxh3Hash128) Size() int { return 16 }
+
+func (h xxh3Hash128) Sum(b []byte) []byte {
+	sum := h.Sum128().Bytes()
+	return append(b, sum[:]...)
 }
 
 // New returns a new hash.Hash calculating the given bitrot algorithm.
@@ -55,12 +72,22 @@ func (a BitrotAlgorithm) New() hash.Hash {
 	case HighwayHash256S:
 		hh, _ := highwayhash.New(magicHighwayHash256Key) // New will never return error since key is 256 bit
 		return hh
+	case BLAKE3:
+		return blake3.New()
+	case XXH3:
+		return xxh3Hash128{xxh3.New()}
 	default:
 		logger.CriticalIf(GlobalContext, errors.New("Unsupported bitrot algorithm"))
 		return nil
 	}
 }
 
+// Streaming reports whether the given algorithm checksums every shard
+// of a part file, the checksums are stored in the part file.
+func (a BitrotAlgorithm) Streaming() bool {
+	return a == HighwayHash256S || a == BLAKE3 || a == XXH3
+}
+
 // Available reports whether the given algorithm is available.
 func (a BitrotAlgorithm) Available() bool {
 	_, ok := bitrotAlgorithms[a]
@@ -88,6 +115,22 @@ type BitrotVerifier struct {
 	sum       []byte
 }
 
+// bitrotAlgorithmKey records the bitrot algorithm of a multipart
+// upload, all parts of an object use the same bitrot algorithm.
+const bitrotAlgorithmKey = ReservedMetadataPrefixLower + "bitrot-algorithm"
+
+// bitrotAlgorithmForSC returns the bitrot algorithm of new objects of
+// the storage class.
+func bitrotAlgorithmForSC(sc string) BitrotAlgorithm {
+	switch globalStorageClass.GetBitrot(sc) {
+	case storageclass.BitrotBLAKE3:
+		return BLAKE3
+	case storageclass.BitrotXXH3:
+		return XXH3
+	}
+	return DefaultBitrotAlgorithm
+}
+
 // BitrotAlgorithmFromString returns a bitrot algorithm from the given string representation.
 // It returns 0 if the string representation does not match any supported algorithm.
 // The zero value of a bitrot algorithm is never supported.
@@ -101,14 +144,14 @@ func BitrotAlgorithmFromString(s string) (a BitrotAlgorithm) {
 }
 
 func newBitrotWriter(disk StorageAPI, volume, filePath string, length int64, algo BitrotAlgorithm, shardSize int64, heal bool) io.Writer {
-	if algo == HighwayHash256S {
+	if algo.Streaming() {
 		return newStreamingBitrotWriter(disk, volume, filePath, length, algo, shardSize, heal)
 	}
 	return newWholeBitrotWriter(disk, volume, filePath, algo, shardSize)
 }
 
 func newBitrotReader(disk StorageAPI, data []byte, bucket string, filePath string, tillOffset int64, algo BitrotAlgorithm, sum []byte, shardSize int64) io.ReaderAt {
-	if algo == HighwayHash256S {
+	if algo.Streaming() {
 		return newStreamingBitrotReader(disk, data, bucket, filePath, tillOffset, algo, shardSize)
 	}
 	return newWholeBitrotReader(disk, bucket, filePath, algo, tillOffset, sum)
@@ -142,7 +185,7 @@ func bitrotWriterSum(w io.Writer) []byte {
 
 // Returns the size of the file with bitrot protection
 func bitrotShardFileSize(size int64, shardSize int64, algo BitrotAlgorithm) int64 {
-	if algo != HighwayHash256S {
+	if !algo.Streaming() {
 		return size
 	}
 	return ceilFrac(size, shardSize)*int64(algo.New().Size()) + size
@@ -150,7 +193,7 @@ func bitrotShardFileSize(size int64, shardSize int64, algo BitrotAlgorithm) int6
 
 // bitrotVerify a single stream of data.
 func bitrotVerify(r io.Reader, wantSize, partSize int64, algo BitrotAlgorithm, want []byte, shardSize int64) error {
-	if algo != HighwayHash256S {
+	if !algo.Streaming() {
 		h := algo.New()
 		if n, err := io.Copy(h, r); err != nil || n != wantSize {
 			// Premature failure in reading the object, file is corrupt.
@@ -211,6 +254,8 @@ func bitrotSelfTest() {
 		BLAKE2b512:      "e519b7d84b1c3c917985f544773a35cf265dcab10948be3550320d156bab612124a5ae2ae5a8c73c0eea360f68b0e28136f26e858756dbfe7375a7389f26c669",
 		HighwayHash256:  "39c0407ed3f01b18d22c85db4aeff11e060ca5f43131b0126731ca197cd42313",
 		HighwayHash256S: "39c0407ed3f01b18d22c85db4aeff11e060ca5f43131b0126731ca197cd42313",
+		BLAKE3:          "59d11fa729e3b687a5352c7e65dc8e60a7e28100c95da6b0a3b9100e1ac6bd52",
+		XXH3:            "52d9456278037ba35372670d4de7a37b",
 	}
 	for algorithm := range bitrotAlgorithms {
 		if !algorithm.Available() {
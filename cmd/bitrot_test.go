@@ -17,11 +17,19 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
 	"io"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
+
+	humanize "github.com/dustin/go-humanize"
+	"minio/cmd/config/storageclass"
+	xhttp "minio/cmd/http"
+	"minio/pkg/madmin"
 )
 
 func testBitrotReaderWriterAlgo(t *testing.T, bitrotAlgo BitrotAlgorithm) {
@@ -82,3 +90,124 @@ func TestAllBitrotAlgorithms(t *testing.T) {
 		testBitrotReaderWriterAlgo(t, bitrotAlgo)
 	}
 }
+
+func TestBitrotAlgorithmStorageClass(t *testing.T) {
+	restoreGlobalStorageClass := globalStorageClass
+	defer func() {
+		globalStorageClass = restoreGlobalStorageClass
+	}()
+	globalStorageClass = storageclass.Config{
+		InlineThreshold: map[string]int64{storageclass.STANDARD: 0},
+		Bitrot: map[string]string{
+			storageclass.STANDARD: storageclass.BitrotBLAKE3,
+			storageclass.RRS:      storageclass.BitrotXXH3,
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	xl := obj.(*erasureServerPools).serverPools[0].sets[0]
+
+	bucket := "bucket"
+	if err = obj.MakeBucketWithLocation(ctx, bucket, BucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	data := make([]byte, 5*humanize.MiByte)
+	if _, err = io.ReadFull(rand.Reader, data); err != nil {
+		t.Fatal(err)
+	}
+	objects := map[string]BitrotAlgorithm{
+		"standard":           BLAKE3,
+		"rrs":                XXH3,
+		"standard-multipart": BLAKE3,
+		"rrs-multipart":      XXH3,
+	}
+	for object, algo := range objects {
+		sc := ""
+		if algo == XXH3 {
+			sc = storageclass.RRS
+		}
+		opts := ObjectOptions{UserDefined: map[string]string{xhttp.AmzStorageClass: sc}}
+		if !strings.HasSuffix(object, "-multipart") {
+			_, err = obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		uploadID, err := obj.NewMultipartUpload(ctx, bucket, object, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var parts []CompletePart
+		for i, part := range [][]byte{data, data[:humanize.KiByte]} {
+			pi, err := obj.PutObjectPart(ctx, bucket, object, uploadID, i+1, mustGetPutObjReader(t, bytes.NewReader(part), int64(len(part)), "", ""), ObjectOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			parts = append(parts, CompletePart{PartNumber: pi.PartNumber, ETag: pi.ETag})
+		}
+		if _, err = obj.CompleteMultipartUpload(ctx, bucket, object, uploadID, parts, ObjectOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for object, algo := range objects {
+		fi, _, _, err := xl.getObjectFileInfo(ctx, bucket, object, ObjectOptions{}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, part := range fi.Parts {
+			if got := fi.Erasure.GetChecksumInfo(part.Number).Algorithm; got != algo {
+				t.Fatalf("%s: expected bitrot algorithm %v for part %d, got %v", object, algo, part.Number, got)
+			}
+		}
+		if _, ok := fi.Metadata[bitrotAlgorithmKey]; ok {
+			t.Fatalf("%s: unexpected %s metadata", object, bitrotAlgorithmKey)
+		}
+
+		// Corrupt the first part of a drive.
+		disks := xl.getDisks()
+		partPath := pathJoin(disks[0].Endpoint().Path, bucket, object, fi.DataDir, "part.1")
+		b, err := ioutil.ReadFile(partPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b[len(b)-1] ^= 0xff
+		if err = ioutil.WriteFile(partPath, b, 0644); err != nil {
+			t.Fatal(err)
+		}
+		dfi, err := disks[0].ReadVersion(ctx, bucket, object, "", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = disks[0].VerifyFile(ctx, bucket, object, dfi); err != errFileCorrupt {
+			t.Fatalf("%s: expected %v verifying the corrupted drive, got %v", object, errFileCorrupt, err)
+		}
+
+		if _, err = obj.HealObject(ctx, bucket, object, "", madmin.HealOpts{ScanMode: madmin.HealDeepScan}); err != nil {
+			t.Fatal(err)
+		}
+		for _, disk := range disks {
+			dfi, err := disk.ReadVersion(ctx, bucket, object, "", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := dfi.Erasure.GetChecksumInfo(1).Algorithm; got != algo {
+				t.Fatalf("%s: expected healed bitrot algorithm %v, got %v", object, algo, got)
+			}
+			if err = disk.VerifyFile(ctx, bucket, object, dfi); err != nil {
+				t.Fatalf("%s: healed shard on %s: %v", object, disk, err)
+			}
+		}
+	}
+}
@@ -17,12 +17,24 @@
 package cmd
 
 import (
+	"context"
 	"errors"
 	"io"
+	"net/http"
 
+	"minio/cmd/crypto"
+	xhttp "minio/cmd/http"
 	bucketsse "minio/pkg/bucket/encryption"
 )
 
+// errKMSKeyInUse is returned when deleting the default KMS master key or
+// a master key used by the default encryption of a bucket.
+var errKMSKeyInUse = AdminError{
+	Code:       "XMinioKMSKeyInUse",
+	Message:    "The master key is the default key or used by the default encryption of a bucket",
+	StatusCode: http.StatusConflict,
+}
+
 // BucketSSEConfigSys - in-memory cache of bucket encryption config
 type BucketSSEConfigSys struct{}
 
@@ -52,9 +64,63 @@ func validateBucketSSEConfig(r io.Reader) (*bucketsse.BucketSSEConfig, error) {
 		return nil, err
 	}
 
-	if len(encConfig.Rules) == 1 && encConfig.Rules[0].DefaultEncryptionAction.Algorithm == bucketsse.AES256 {
-		return encConfig, nil
+	if len(encConfig.Rules) == 1 {
+		switch encConfig.Algo() {
+		case bucketsse.AES256:
+			return encConfig, nil
+		case bucketsse.AWSKms:
+			// SSE-KMS requires a KMS with named master keys.
+			if GlobalKMS != nil {
+				return encConfig, nil
+			}
+		}
 	}
 
 	return nil, errors.New("Unsupported bucket encryption configuration")
 }
+
+// setBucketEncryptionHeaders - sets the SSE headers of the default
+// encryption of bucket if the request does not ask for SSE-C or SSE-KMS
+// itself. These headers need to be set prior to setting ObjectOptions.
+func setBucketEncryptionHeaders(r *http.Request, bucket string) {
+	if crypto.SSEC.IsRequested(r.Header) || crypto.S3KMS.IsRequested(r.Header) {
+		return
+	}
+	config, err := globalBucketSSEConfigSys.Get(bucket)
+	if err == nil && config.Algo() == bucketsse.AWSKms && !crypto.S3.IsRequested(r.Header) {
+		r.Header.Set(xhttp.AmzServerSideEncryption, xhttp.AmzEncryptionKMS)
+		r.Header.Set(xhttp.AmzServerSideEncryptionKmsID, config.KeyID())
+		return
+	}
+	if globalAutoEncryption || err == nil {
+		r.Header.Set(xhttp.AmzServerSideEncryption, xhttp.AmzEncryptionAES)
+	}
+}
+
+// bucketsUsingKMSKey - returns the buckets whose default encryption uses
+// the KMS master key keyID. SSE-S3 and SSE-KMS configurations without a
+// key ID use the default key of the KMS.
+func bucketsUsingKMSKey(ctx context.Context, objAPI ObjectLayer, keyID, defaultKey string) ([]string, error) {
+	buckets, err := objAPI.ListBuckets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, bucket := range buckets {
+		config, err := globalBucketSSEConfigSys.Get(bucket.Name)
+		if err != nil {
+			if globalAutoEncryption && keyID == defaultKey {
+				names = append(names, bucket.Name)
+			}
+			continue
+		}
+		bucketKeyID := config.KeyID()
+		if config.Algo() == bucketsse.AES256 || bucketKeyID == "" {
+			bucketKeyID = defaultKey
+		}
+		if bucketKeyID == keyID {
+			names = append(names, bucket.Name)
+		}
+	}
+	return names, nil
+}
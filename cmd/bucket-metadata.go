@@ -31,6 +31,7 @@ import (
 	"github.com/minio/minio-go/v7/pkg/tags"
 	"github.com/minio/sio"
 
+	"minio/cmd/config"
 	"minio/cmd/crypto"
 	"minio/cmd/logger"
 	bucketsse "minio/pkg/bucket/encryption"
@@ -81,6 +82,7 @@ type BucketMetadata struct {
 	ReplicationConfigXML        []byte
 	BucketTargetsConfigJSON     []byte
 	BucketTargetsConfigMetaJSON []byte
+	StorageClassConfigJSON      []byte
 
 	// Unexported fields. Must be updated atomically.
 	policyConfig           *policy.Policy
@@ -94,6 +96,7 @@ type BucketMetadata struct {
 	replicationConfig      *replication.Config
 	bucketTargetConfig     *madmin.BucketTargets
 	bucketTargetConfigMeta map[string]string
+	storageClassConfig     *madmin.BucketStorageClass
 }
 
 // newBucketMetadata creates BucketMetadata with the supplied name and Created to Now.
@@ -104,7 +107,8 @@ func newBucketMetadata(name string) BucketMetadata {
 		notificationConfig: &event.Config{
 			XMLNS: "http://s3.amazonaws.com/doc/2006-03-01/",
 		},
-		quotaConfig: &madmin.BucketQuota{},
+		quotaConfig:        &madmin.BucketQuota{},
+		storageClassConfig: &madmin.BucketStorageClass{},
 		versioningConfig: &versioning.Versioning{
 			XMLNS: "http://s3.amazonaws.com/doc/2006-03-01/",
 		},
@@ -125,6 +129,11 @@ func (b *BucketMetadata) Load(ctx context.Context, api ObjectLayer, name string)
 	if err != nil {
 		return err
 	}
+	if config.IsEncrypted(data) {
+		if data, err = decryptConfig(configFile, data); err != nil {
+			return err
+		}
+	}
 	if len(data) <= 4 {
 		return fmt.Errorf("loadBucketMetadata: no data")
 	}
@@ -233,6 +242,15 @@ func (b *BucketMetadata) parseAllConfigs(ctx context.Context, objectAPI ObjectLa
 		}
 	}
 
+	if len(b.StorageClassConfigJSON) != 0 {
+		b.storageClassConfig, err = parseBucketStorageClass(b.Name, b.StorageClassConfigJSON)
+		if err != nil {
+			return err
+		}
+	} else {
+		b.storageClassConfig = &madmin.BucketStorageClass{}
+	}
+
 	if len(b.ReplicationConfigXML) != 0 {
 		b.replicationConfig, err = replication.ParseConfig(bytes.NewReader(b.ReplicationConfigXML))
 		if err != nil {
@@ -366,6 +384,9 @@ func (b *BucketMetadata) Save(ctx context.Context, api ObjectLayer) error {
 	}
 
 	configFile := path.Join(bucketConfigPrefix, b.Name, bucketMetadataFile)
+	if data, err = encryptConfig(configFile, data); err != nil {
+		return err
+	}
 	return saveConfig(ctx, api, configFile, data)
 }
 
@@ -118,6 +118,10 @@ func mustReplicater(ctx context.Context, bucket, object string, meta map[string]
 	if replication.StatusType(replStatus) == replication.Replica {
 		return replicate, sync
 	}
+	// Objects excluded from versioning are not replicated.
+	if !globalBucketVersioningSys.PrefixEnabled(bucket, object) {
+		return replicate, sync
+	}
 	cfg, err := getReplicationConfig(ctx, bucket)
 	if err != nil {
 		return replicate, sync
@@ -177,6 +181,10 @@ func isStandardHeader(matchHeaderKey string) bool {
 
 // returns whether object version is a deletemarker and if object qualifies for replication
 func checkReplicateDelete(ctx context.Context, bucket string, dobj ObjectToDelete, oi ObjectInfo, gerr error) (replicate, sync bool) {
+	// Objects excluded from versioning are not replicated.
+	if !globalBucketVersioningSys.PrefixEnabled(bucket, dobj.ObjectName) {
+		return false, sync
+	}
 	rcfg, err := getReplicationConfig(ctx, bucket)
 	if err != nil || rcfg == nil {
 		return false, sync
@@ -311,8 +319,8 @@ func replicateDelete(ctx context.Context, dobj DeletedObjectVersionInfo, objectA
 		VersionID:                     versionID,
 		DeleteMarkerReplicationStatus: replicationStatus,
 		VersionPurgeStatus:            versionPurgeStatus,
-		Versioned:                     globalBucketVersioningSys.Enabled(bucket),
-		VersionSuspended:              globalBucketVersioningSys.Suspended(bucket),
+		Versioned:                     globalBucketVersioningSys.PrefixEnabled(bucket, dobj.ObjectName),
+		VersionSuspended:              globalBucketVersioningSys.PrefixSuspended(bucket, dobj.ObjectName),
 	})
 	if err != nil && !isErrVersionNotFound(err) { // VersionNotFound would be reported by pool that object version is missing on.
 		logger.LogIf(ctx, fmt.Errorf("Unable to update replication metadata for %s/%s(%s): %s", bucket, dobj.ObjectName, versionID, err))
@@ -0,0 +1,79 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	xhttp "minio/cmd/http"
+	"minio/cmd/logger"
+	"minio/pkg/madmin"
+)
+
+const bucketStorageClassConfigFile = "storage-class.json"
+
+// parseBucketStorageClass parses the default storage classes of a bucket from json
+func parseBucketStorageClass(bucket string, data []byte) (scCfg *madmin.BucketStorageClass, err error) {
+	scCfg = &madmin.BucketStorageClass{}
+	if err = json.Unmarshal(data, scCfg); err != nil {
+		return scCfg, err
+	}
+	if !scCfg.IsValid() {
+		return scCfg, fmt.Errorf("Invalid storage class config %#v", scCfg)
+	}
+	return
+}
+
+// isValidBucketStorageClass returns false if a storage class
+// of the bucket is not configured on this deployment.
+func isValidBucketStorageClass(scCfg *madmin.BucketStorageClass) bool {
+	if scCfg.StorageClass != "" && !globalStorageClass.IsValid(scCfg.StorageClass) {
+		return false
+	}
+	for _, p := range scCfg.Prefixes {
+		if !globalStorageClass.IsValid(p.StorageClass) {
+			return false
+		}
+	}
+	return true
+}
+
+// setDefaultStorageClass sets the default storage class of the bucket
+// or of the longest matching prefix on uploads which set none. Storage
+// classes removed from the configuration since are ignored.
+func setDefaultStorageClass(ctx context.Context, bucket, object string, metadata map[string]string) {
+	if !globalIsErasure || metadata[xhttp.AmzStorageClass] != "" {
+		return
+	}
+
+	scCfg, err := globalBucketMetadataSys.GetStorageClassConfig(bucket)
+	if err != nil {
+		return
+	}
+
+	sc := scCfg.For(object)
+	if sc == "" {
+		return
+	}
+	if !globalStorageClass.IsValid(sc) {
+		logger.LogIf(ctx, fmt.Errorf("storage class %s of bucket %s is not configured, using the standard storage class", sc, bucket))
+		return
+	}
+	metadata[xhttp.AmzStorageClass] = sc
+}
@@ -0,0 +1,93 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"testing"
+
+	"minio/cmd/config/storageclass"
+)
+
+func TestParseBucketStorageClass(t *testing.T) {
+	restoreGlobalStorageClass := globalStorageClass
+	defer func() {
+		globalStorageClass = restoreGlobalStorageClass
+	}()
+	globalStorageClass = storageclass.Config{
+		Custom: map[string]storageclass.StorageClass{
+			"CRITICAL": {Parity: 6},
+		},
+	}
+
+	testCases := []struct {
+		data    string
+		valid   bool
+		objects map[string]string
+	}{
+		{
+			data:  `{}`,
+			valid: true,
+			objects: map[string]string{
+				"object": "",
+			},
+		},
+		{
+			data:  `{"storageClass":"REDUCED_REDUNDANCY","prefixes":[{"prefix":"ledger/","storageClass":"CRITICAL"},{"prefix":"ledger/2021/","storageClass":"STANDARD"}]}`,
+			valid: true,
+			objects: map[string]string{
+				"object":             "REDUCED_REDUNDANCY",
+				"ledger/object":      "CRITICAL",
+				"ledger/2021/object": "STANDARD",
+				"ledger":             "REDUCED_REDUNDANCY",
+			},
+		},
+		// Storage class not configured.
+		{
+			data:  `{"prefixes":[{"prefix":"scratch/","storageClass":"SCRATCH"}]}`,
+			valid: false,
+			objects: map[string]string{
+				"scratch/object": "SCRATCH",
+			},
+		},
+	}
+
+	for i, testCase := range testCases {
+		scCfg, err := parseBucketStorageClass("bucket", []byte(testCase.data))
+		if err != nil {
+			t.Fatalf("Test %d: unexpected error %v", i+1, err)
+		}
+		if valid := isValidBucketStorageClass(scCfg); valid != testCase.valid {
+			t.Errorf("Test %d: expected valid %v, got %v", i+1, testCase.valid, valid)
+		}
+		for object, sc := range testCase.objects {
+			if got := scCfg.For(object); got != sc {
+				t.Errorf("Test %d: expected storage class %q for %s, got %q", i+1, sc, object, got)
+			}
+		}
+	}
+
+	// Empty and duplicate prefixes are rejected.
+	for _, data := range []string{
+		`{"prefixes":[{"prefix":"","storageClass":"STANDARD"}]}`,
+		`{"prefixes":[{"prefix":"a/","storageClass":"STANDARD"},{"prefix":"a/","storageClass":"CRITICAL"}]}`,
+		`{"prefixes":[{"prefix":"a/"}]}`,
+	} {
+		if _, err := parseBucketStorageClass("bucket", []byte(data)); err == nil {
+			t.Errorf("Expected %s to be rejected", data)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+/*
+ * MinIO Cloud Storage, (C) 2020 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"minio/pkg/auth"
+)
+
+func TestPutBucketVersioningObjectLock(t *testing.T) {
+	ExecObjectLayerAPITest(t, testPutBucketVersioningObjectLock, []string{"PutBucketVersioning"})
+}
+
+// Tests that the versioning configuration of a bucket with object
+// locking enabled cannot be suspended or exclude objects.
+func testPutBucketVersioningObjectLock(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	creds auth.Credentials, t *testing.T) {
+
+	// Object locking and versioning are only supported in erasure mode.
+	if instanceType == FSTestStr {
+		return
+	}
+	globalIsErasure = true
+	defer func() { globalIsErasure = false }()
+
+	lockConfig := []byte(`<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled></ObjectLockConfiguration>`)
+	if err := globalBucketMetadataSys.Update(bucketName, objectLockConfig, lockConfig); err != nil {
+		t.Fatalf("%s: Failed to enable object locking: <ERROR> %v", instanceType, err)
+	}
+
+	testCases := []struct {
+		body               []byte
+		expectedRespStatus int
+		errorCode          string
+	}{
+		{
+			body:               []byte(`<VersioningConfiguration><Status>Enabled</Status></VersioningConfiguration>`),
+			expectedRespStatus: http.StatusOK,
+		},
+		{
+			body:               []byte(`<VersioningConfiguration><Status>Suspended</Status></VersioningConfiguration>`),
+			expectedRespStatus: http.StatusConflict,
+			errorCode:          "InvalidBucketState",
+		},
+		{
+			body:               []byte(`<VersioningConfiguration><Status>Enabled</Status><ExcludedPrefixes><Prefix>tmp/</Prefix></ExcludedPrefixes></VersioningConfiguration>`),
+			expectedRespStatus: http.StatusConflict,
+			errorCode:          "InvalidBucketState",
+		},
+		{
+			body:               []byte(`<VersioningConfiguration><Status>Enabled</Status><ExcludeFolders>true</ExcludeFolders></VersioningConfiguration>`),
+			expectedRespStatus: http.StatusConflict,
+			errorCode:          "InvalidBucketState",
+		},
+	}
+
+	for i, testCase := range testCases {
+		rec := httptest.NewRecorder()
+		req, err := newTestSignedRequestV4(http.MethodPut, getBucketVersioningURL("", bucketName),
+			int64(len(testCase.body)), bytes.NewReader(testCase.body), creds.AccessKey, creds.SecretKey, nil)
+		if err != nil {
+			t.Fatalf("Test %d: %s: Failed to create HTTP request for PutBucketVersioningHandler: <ERROR> %v", i+1, instanceType, err)
+		}
+		apiRouter.ServeHTTP(rec, req)
+		if rec.Code != testCase.expectedRespStatus {
+			t.Errorf("Test %d: %s: Expected the response status to be `%d`, but instead found `%d`", i+1, instanceType, testCase.expectedRespStatus, rec.Code)
+		}
+		if testCase.errorCode == "" {
+			continue
+		}
+		errorResponse := APIErrorResponse{}
+		if err = xml.Unmarshal(rec.Body.Bytes(), &errorResponse); err != nil {
+			t.Fatalf("Test %d: %s: Unable to unmarshal response body %s", i+1, instanceType, rec.Body.String())
+		}
+		if errorResponse.Code != testCase.errorCode {
+			t.Errorf("Test %d: %s: Expected the error code to be `%s`, but instead found `%s`", i+1, instanceType, testCase.errorCode, errorResponse.Code)
+		}
+	}
+}
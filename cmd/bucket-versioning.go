@@ -39,6 +39,26 @@ func (sys *BucketVersioningSys) Suspended(bucket string) bool {
 	return vc.Suspended()
 }
 
+// PrefixEnabled returns true if versioning is enabled for the object,
+// i.e. versioning is enabled on the bucket and the object is not excluded.
+func (sys *BucketVersioningSys) PrefixEnabled(bucket, object string) bool {
+	vc, err := globalBucketMetadataSys.GetVersioningConfig(bucket)
+	if err != nil {
+		return false
+	}
+	return vc.PrefixEnabled(object)
+}
+
+// PrefixSuspended returns true if versioning is suspended for the object,
+// i.e. versioning is suspended on the bucket or the object is excluded.
+func (sys *BucketVersioningSys) PrefixSuspended(bucket, object string) bool {
+	vc, err := globalBucketMetadataSys.GetVersioningConfig(bucket)
+	if err != nil {
+		return false
+	}
+	return vc.PrefixSuspended(object)
+}
+
 // Get returns stored bucket policy
 func (sys *BucketVersioningSys) Get(bucket string) (*versioning.Versioning, error) {
 	if GlobalIsGateway {
@@ -40,6 +40,7 @@ import (
 	"github.com/minio/minio-go/v7/pkg/set"
 
 	"minio/cmd/config"
+	xtls "minio/cmd/config/identity/tls"
 	"minio/cmd/crypto"
 	xhttp "minio/cmd/http"
 	"minio/cmd/logger"
@@ -49,6 +50,7 @@ import (
 	"minio/pkg/env"
 	"minio/pkg/handlers"
 	"minio/pkg/kms"
+	xnet "minio/pkg/net"
 )
 
 // serverDebugLog will enable debug printing
@@ -332,14 +334,18 @@ func HandleCommonEnvVars() {
 		globalActiveCred = cred
 	}
 
-	if env.IsSet(config.EnvKMSSecretKey) && env.IsSet(config.EnvKESEndpoint) {
-		logger.Fatal(errors.New("ambigious KMS configuration"), fmt.Sprintf("The environment contains %q as well as %q", config.EnvKMSSecretKey, config.EnvKESEndpoint))
+	var kmsEnvs []string
+	for _, envKMS := range []string{config.EnvKMSSecretKey, config.EnvKMSMasterKey, config.EnvKESEndpoint, config.EnvKMSVaultEndpoint, config.EnvKMSKeyStoreFile} {
+		// The legacy master key is ignored if a secret key is set.
+		if envKMS == config.EnvKMSMasterKey && env.IsSet(config.EnvKMSSecretKey) {
+			continue
+		}
+		if env.IsSet(envKMS) {
+			kmsEnvs = append(kmsEnvs, envKMS)
+		}
 	}
-	switch {
-	case env.IsSet(config.EnvKMSSecretKey) && env.IsSet(config.EnvKESEndpoint):
-		logger.Fatal(errors.New("ambigious KMS configuration"), fmt.Sprintf("The environment contains %q as well as %q", config.EnvKMSSecretKey, config.EnvKESEndpoint))
-	case env.IsSet(config.EnvKMSMasterKey) && env.IsSet(config.EnvKESEndpoint):
-		logger.Fatal(errors.New("ambigious KMS configuration"), fmt.Sprintf("The environment contains %q as well as %q", config.EnvKMSMasterKey, config.EnvKESEndpoint))
+	if len(kmsEnvs) > 1 {
+		logger.Fatal(errors.New("ambigious KMS configuration"), fmt.Sprintf("The environment contains %q as well as %q", kmsEnvs[0], kmsEnvs[1]))
 	}
 	if env.IsSet(config.EnvKMSSecretKey) {
 		KMS, err := kms.Parse(env.Get(config.EnvKMSSecretKey, ""))
@@ -383,6 +389,37 @@ func HandleCommonEnvVars() {
 		}
 		GlobalKMS = KMS
 	}
+	if env.IsSet(config.EnvKMSVaultEndpoint) {
+		vaultEndpoint, err := xnet.ParseHTTPURL(env.Get(config.EnvKMSVaultEndpoint, ""))
+		if err != nil {
+			logger.Fatal(err, "Unable to parse the Vault endpoint inherited from the shell environment")
+		}
+		KMS, err := crypto.NewVault(crypto.VaultConfig{
+			Enabled:   true,
+			Endpoint:  vaultEndpoint.String(),
+			Namespace: env.Get(config.EnvKMSVaultNamespace, ""),
+			Engine:    env.Get(config.EnvKMSVaultEngine, "transit"),
+			Auth: crypto.VaultAuth{
+				Token:         env.Get(config.EnvKMSVaultToken, ""),
+				AppRoleID:     env.Get(config.EnvKMSVaultAppRoleID, ""),
+				AppRoleSecret: env.Get(config.EnvKMSVaultAppRoleSecret, ""),
+			},
+			DefaultKeyID: env.Get(config.EnvKMSVaultKeyName, ""),
+			CAPath:       env.Get(config.EnvKMSVaultCAPath, globalCertsCADir.Get()),
+			Transport:    newCustomHTTPTransport(&tls.Config{RootCAs: globalRootCAs}, defaultDialTimeout)(),
+		})
+		if err != nil {
+			logger.Fatal(err, "Unable to initialize a connection to Vault as specified by the shell environment")
+		}
+		GlobalKMS = KMS
+	}
+	if env.IsSet(config.EnvKMSKeyStoreFile) {
+		KMS, err := kms.NewKeyStore(env.Get(config.EnvKMSKeyStoreFile, ""), env.Get(config.EnvKMSKeyStoreKeyName, ""))
+		if err != nil {
+			logger.Fatal(err, "Unable to open the KMS keystore as specified by the shell environment")
+		}
+		GlobalKMS = KMS
+	}
 }
 
 func logStartupMessage(msg string) {
@@ -470,6 +507,17 @@ func getTLSConfig() (x509Certs []*x509.Certificate, manager *certs.Manager, secu
 	return x509Certs, manager, secureConn, nil
 }
 
+// requestClientCerts - makes the server request TLS client certificates
+// during the handshake when AssumeRoleWithCertificate is enabled, the
+// certificates are verified by the STS handler and not by the handshake.
+func requestClientCerts(httpServer *xhttp.Server) {
+	enabled, err := xtls.Enabled()
+	logger.FatalIf(err, "Invalid value for %s", xtls.EnvIdentityTLSEnabled)
+	if enabled && httpServer.TLSConfig != nil {
+		httpServer.TLSConfig.ClientAuth = tls.RequestClientCert
+	}
+}
+
 // contextCanceled returns whether a context is canceled.
 func contextCanceled(ctx context.Context) bool {
 	select {
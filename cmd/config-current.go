@@ -30,6 +30,8 @@ import (
 	"minio/cmd/config/heal"
 	xldap "minio/cmd/config/identity/ldap"
 	"minio/cmd/config/identity/openid"
+	idplugin "minio/cmd/config/identity/plugin"
+	xtls "minio/cmd/config/identity/tls"
 	"minio/cmd/config/notify"
 	"minio/cmd/config/policy/opa"
 	"minio/cmd/config/scanner"
@@ -49,6 +51,8 @@ func initHelp() {
 		config.CompressionSubSys:    compress.DefaultKVS,
 		config.IdentityLDAPSubSys:   xldap.DefaultKVS,
 		config.IdentityOpenIDSubSys: openid.DefaultKVS,
+		config.IdentityTLSSubSys:    xtls.DefaultKVS,
+		config.IdentityPluginSubSys: idplugin.DefaultKVS,
 		config.PolicyOPASubSys:      opa.DefaultKVS,
 		config.RegionSubSys:         config.DefaultRegionKVS,
 		config.APISubSys:            api.DefaultKVS,
@@ -88,6 +92,14 @@ func initHelp() {
 			Key:         config.IdentityLDAPSubSys,
 			Description: "enable LDAP SSO support",
 		},
+		config.HelpKV{
+			Key:         config.IdentityTLSSubSys,
+			Description: "enable X.509 TLS client certificate SSO support",
+		},
+		config.HelpKV{
+			Key:         config.IdentityPluginSubSys,
+			Description: "enable identity plugin for custom token SSO support",
+		},
 		config.HelpKV{
 			Key:         config.PolicyOPASubSys,
 			Description: "[DEPRECATED] enable external OPA for policy enforcement",
@@ -172,6 +184,21 @@ func initHelp() {
 			Description:     "publish bucket notifications to Redis datastores",
 			MultipleTargets: true,
 		},
+		config.HelpKV{
+			Key:             config.NotifySQSSubSys,
+			Description:     "publish bucket notifications to SQS compatible queues",
+			MultipleTargets: true,
+		},
+		config.HelpKV{
+			Key:             config.NotifySNSSubSys,
+			Description:     "publish bucket notifications to SNS compatible topics",
+			MultipleTargets: true,
+		},
+		config.HelpKV{
+			Key:             config.NotifyPulsarSubSys,
+			Description:     "publish bucket notifications to Pulsar topics",
+			MultipleTargets: true,
+		},
 	}
 
 	if globalIsErasure {
@@ -194,6 +221,8 @@ func initHelp() {
 		config.ScannerSubSys:        scanner.Help,
 		config.IdentityOpenIDSubSys: openid.Help,
 		config.IdentityLDAPSubSys:   xldap.Help,
+		config.IdentityTLSSubSys:    xtls.Help,
+		config.IdentityPluginSubSys: idplugin.Help,
 		config.PolicyOPASubSys:      opa.Help,
 		config.LoggerWebhookSubSys:  logger.Help,
 		config.AuditWebhookSubSys:   logger.HelpAudit,
@@ -207,6 +236,9 @@ func initHelp() {
 		config.NotifyRedisSubSys:    notify.HelpRedis,
 		config.NotifyWebhookSubSys:  notify.HelpWebhook,
 		config.NotifyESSubSys:       notify.HelpES,
+		config.NotifySQSSubSys:      notify.HelpSQS,
+		config.NotifySNSSubSys:      notify.HelpSNS,
+		config.NotifyPulsarSubSys:   notify.HelpPulsar,
 	}
 
 	config.RegisterHelpSubSys(helpMap)
@@ -271,7 +303,7 @@ func validateConfig(s config.Config, setDriveCounts []int) error {
 		return err
 	}
 
-	if _, err := openid.LookupConfig(s[config.IdentityOpenIDSubSys][config.Default],
+	if _, err := openid.LookupProviders(s[config.IdentityOpenIDSubSys],
 		NewGatewayHTTPTransport(), xhttp.DrainBody); err != nil {
 		return err
 	}
@@ -291,6 +323,15 @@ func validateConfig(s config.Config, setDriveCounts []int) error {
 		}
 	}
 
+	if _, err := xtls.Lookup(s[config.IdentityTLSSubSys][config.Default]); err != nil {
+		return err
+	}
+
+	if _, err := idplugin.LookupConfig(s[config.IdentityPluginSubSys][config.Default],
+		NewGatewayHTTPTransport(), xhttp.DrainBody); err != nil {
+		return err
+	}
+
 	if _, err := opa.LookupConfig(s[config.PolicyOPASubSys][config.Default],
 		NewGatewayHTTPTransport(), xhttp.DrainBody); err != nil {
 		return err
@@ -370,11 +411,12 @@ func lookupConfigs(s config.Config, setDriveCounts []int) {
 		logger.Fatal(errors.New("no KMS configured"), "MINIO_KMS_AUTO_ENCRYPTION requires a valid KMS configuration")
 	}
 
-	globalOpenIDConfig, err = openid.LookupConfig(s[config.IdentityOpenIDSubSys][config.Default],
+	globalOpenIDProviders, err = openid.LookupProviders(s[config.IdentityOpenIDSubSys],
 		NewGatewayHTTPTransport(), xhttp.DrainBody)
 	if err != nil {
 		logger.LogIf(ctx, fmt.Errorf("Unable to initialize OpenID: %w", err))
 	}
+	globalOpenIDConfig = globalOpenIDProviders.DefaultConfig()
 
 	opaCfg, err := opa.LookupConfig(s[config.PolicyOPASubSys][config.Default],
 		NewGatewayHTTPTransport(), xhttp.DrainBody)
@@ -382,7 +424,6 @@ func lookupConfigs(s config.Config, setDriveCounts []int) {
 		logger.LogIf(ctx, fmt.Errorf("Unable to initialize OPA: %w", err))
 	}
 
-	globalOpenIDValidators = getOpenIDValidators(globalOpenIDConfig)
 	GlobalPolicyOPA = opa.New(opaCfg)
 
 	globalLDAPConfig, err = xldap.Lookup(s[config.IdentityLDAPSubSys][config.Default],
@@ -391,6 +432,18 @@ func lookupConfigs(s config.Config, setDriveCounts []int) {
 		logger.LogIf(ctx, fmt.Errorf("Unable to parse LDAP configuration: %w", err))
 	}
 
+	globalTLSIdentityConfig, err = xtls.Lookup(s[config.IdentityTLSSubSys][config.Default])
+	if err != nil {
+		logger.LogIf(ctx, fmt.Errorf("Unable to parse TLS identity configuration: %w", err))
+	}
+
+	pluginCfg, err := idplugin.LookupConfig(s[config.IdentityPluginSubSys][config.Default],
+		NewGatewayHTTPTransport(), xhttp.DrainBody)
+	if err != nil {
+		logger.LogIf(ctx, fmt.Errorf("Unable to initialize identity plugin: %w", err))
+	}
+	globalIdentityPlugin = idplugin.New(pluginCfg)
+
 	// Load logger targets based on user's configuration
 	loggerUserAgent := getUserAgent(getMinioMode())
 
@@ -630,17 +683,3 @@ func loadConfig(objAPI ObjectLayer) error {
 
 	return nil
 }
-
-// getOpenIDValidators - returns ValidatorList which contains
-// enabled providers in server config.
-// A new authentication provider is added like below
-// * Add a new provider in pkg/iam/openid package.
-func getOpenIDValidators(cfg openid.Config) *openid.Validators {
-	validators := openid.NewValidators()
-
-	if cfg.JWKS.URL != nil {
-		validators.Add(openid.NewJWT(cfg))
-	}
-
-	return validators
-}
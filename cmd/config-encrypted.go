@@ -18,6 +18,8 @@ package cmd
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"path"
 	"unicode/utf8"
@@ -69,64 +71,144 @@ func decryptData(edata []byte, creds ...auth.Credentials) ([]byte, error) {
 	return data, err
 }
 
-func migrateConfigPrefixToEncrypted(objAPI ObjectLayer, encrypted bool) error {
-	if !encrypted {
-		return nil
+// encryptConfig - encrypts the data of the config object at objPath
+// with a DEK generated by the KMS. The data is returned unchanged if
+// no KMS is configured.
+func encryptConfig(objPath string, data []byte) ([]byte, error) {
+	if GlobalKMS == nil {
+		return data, nil
 	}
-	if encrypted {
-		if GlobalKMS != nil {
-			stat, err := GlobalKMS.Stat()
-			if err != nil {
-				return err
-			}
-			logger.Info("Attempting to re-encrypt config, IAM users and policies on MinIO with %q (%s)", stat.DefaultKey, stat.Name)
-		} else {
-			logger.Info("Attempting to migrate encrypted config, IAM users and policies on MinIO to a plaintext format. To encrypt all MinIO config data a KMS is needed")
+	return config.EncryptBytes(GlobalKMS, data, kms.Context{
+		minioMetaBucket: path.Join(minioMetaBucket, objPath),
+	})
+}
+
+// decryptConfig - decrypts the data of the config object at objPath
+// which is either encrypted with the KMS, encrypted with the root
+// credentials or stored in plaintext.
+func decryptConfig(objPath string, data []byte) ([]byte, error) {
+	switch {
+	case config.IsEncrypted(data):
+		if GlobalKMS == nil {
+			return nil, errKMSNotConfigured
 		}
+		return config.DecryptBytes(GlobalKMS, data, kms.Context{
+			minioMetaBucket: path.Join(minioMetaBucket, objPath),
+		})
+	case utf8.Valid(data):
+		return data, nil
+	default:
+		return decryptData(data, globalActiveCred)
+	}
+}
+
+// migrateConfigEncryption - re-encrypts the config object at objPath
+// with the KMS, or stores it in plaintext if no KMS is configured.
+// Objects already in the target format are skipped. If binary is true
+// the plaintext is not valid UTF-8 and the object is only decrypted
+// if it is encrypted with the KMS.
+func migrateConfigEncryption(ctx context.Context, objAPI ObjectLayer, objPath string, binary bool) (bool, error) {
+	oi, err := objAPI.GetObjectInfo(ctx, minioMetaBucket, objPath, ObjectOptions{})
+	if err != nil {
+		if isErrObjectNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	data, err := readConfig(ctx, objAPI, objPath)
+	if err != nil {
+		if errors.Is(err, errConfigNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	encrypted := config.IsEncrypted(data)
+	switch {
+	case GlobalKMS != nil && encrypted:
+		return false, nil
+	case GlobalKMS == nil && !encrypted && (binary || utf8.Valid(data)):
+		return false, nil
+	}
+	if encrypted || !binary {
+		if data, err = decryptConfig(objPath, data); err != nil {
+			return false, fmt.Errorf("Decrypting config %s failed %w, possibly credentials are incorrect", objPath, err)
+		}
+	}
+	if data, err = encryptConfig(objPath, data); err != nil {
+		return false, err
+	}
+
+	// The object has been overwritten in the meantime by a server,
+	// which always writes config in the target format.
+	if current, err := objAPI.GetObjectInfo(ctx, minioMetaBucket, objPath, ObjectOptions{}); err != nil || !current.ModTime.Equal(oi.ModTime) {
+		return false, nil
+	}
+	if err = saveConfig(ctx, objAPI, objPath, data); err != nil {
+		return false, err
 	}
+	return true, nil
+}
 
+// listConfigToMigrate - sends the paths of all server config, IAM and
+// bucket metadata objects which are encrypted at rest to fn. binary
+// is true for objects whose plaintext is not valid UTF-8.
+func listConfigToMigrate(ctx context.Context, objAPI ObjectLayer, fn func(objPath string, binary bool) error) error {
 	var marker string
 	for {
-		res, err := objAPI.ListObjects(GlobalContext, minioMetaBucket, minioConfigPrefix, marker, "", maxObjectList)
+		res, err := objAPI.ListObjects(ctx, minioMetaBucket, minioConfigPrefix, marker, "", maxObjectList)
 		if err != nil {
 			return err
 		}
 		for _, obj := range res.Objects {
-			data, err := readConfig(GlobalContext, objAPI, obj.Name)
-			if err != nil {
-				return err
-			}
-
-			if !utf8.Valid(data) {
-				data, err = decryptData(data, globalActiveCred)
-				if err != nil {
-					return fmt.Errorf("Decrypting config failed %w, possibly credentials are incorrect", err)
-				}
-			}
-			if GlobalKMS != nil {
-				data, err = config.EncryptBytes(GlobalKMS, data, kms.Context{
-					obj.Bucket: path.Join(obj.Bucket, obj.Name),
-				})
-				if err != nil {
-					return err
-				}
-			}
-			if err = saveConfig(GlobalContext, objAPI, obj.Name, data); err != nil {
+			if err = fn(obj.Name, false); err != nil {
 				return err
 			}
 		}
-
 		if !res.IsTruncated {
 			break
 		}
 		marker = res.NextMarker
 	}
-	if encrypted {
-		if GlobalKMS != nil {
-			logger.Info("Migration of encrypted config data completed. All config data is now encrypted with the KMS")
-		} else {
-			logger.Info("Migration of encrypted config data completed. All config data is now stored in plaintext")
+
+	buckets, err := objAPI.ListBuckets(ctx)
+	if err != nil {
+		return err
+	}
+	for _, bucket := range buckets {
+		if err = fn(path.Join(bucketConfigPrefix, bucket.Name, bucketMetadataFile), true); err != nil {
+			return err
 		}
 	}
-	return deleteConfig(GlobalContext, globalObjectAPI, backendEncryptedFile)
+	return nil
+}
+
+func migrateConfigPrefixToEncrypted(objAPI ObjectLayer, encrypted bool) error {
+	if !encrypted {
+		return nil
+	}
+	if GlobalKMS != nil {
+		stat, err := GlobalKMS.Stat()
+		if err != nil {
+			return err
+		}
+		logger.Info("Attempting to re-encrypt config, IAM users and policies on MinIO with %q (%s)", stat.DefaultKey, stat.Name)
+	} else {
+		logger.Info("Attempting to migrate encrypted config, IAM users and policies on MinIO to a plaintext format. To encrypt all MinIO config data a KMS is needed")
+	}
+
+	err := listConfigToMigrate(GlobalContext, objAPI, func(objPath string, binary bool) error {
+		_, err := migrateConfigEncryption(GlobalContext, objAPI, objPath, binary)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if GlobalKMS != nil {
+		logger.Info("Migration of encrypted config data completed. All config data is now encrypted with the KMS")
+	} else {
+		logger.Info("Migration of encrypted config data completed. All config data is now stored in plaintext")
+	}
+	return deleteConfig(GlobalContext, objAPI, backendEncryptedFile)
 }
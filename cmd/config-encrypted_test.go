@@ -18,9 +18,13 @@ package cmd
 
 import (
 	"bytes"
+	"context"
+	"path"
 	"testing"
 
+	"minio/cmd/config"
 	"minio/pkg/auth"
+	"minio/pkg/kms"
 	"minio/pkg/madmin"
 )
 
@@ -73,3 +77,88 @@ func TestDecryptData(t *testing.T) {
 		})
 	}
 }
+
+func TestMigrateConfigEncryption(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, disks, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatalf("Prepare Erasure backend failed - %v", err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(disks)
+
+	defer func(k kms.KMS, cred auth.Credentials) {
+		GlobalKMS, globalActiveCred = k, cred
+	}(GlobalKMS, globalActiveCred)
+	GlobalKMS = nil
+	globalActiveCred = auth.Credentials{AccessKey: "minio", SecretKey: "minio123"}
+
+	if err = obj.MakeBucketWithLocation(ctx, "bucket", BucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	meta := newBucketMetadata("bucket")
+	if err = meta.Save(ctx, obj); err != nil {
+		t.Fatal(err)
+	}
+
+	plainPath := path.Join(minioConfigPrefix, "plain.json")
+	credPath := path.Join(iamConfigPrefix, "users", "user", "identity.json")
+	data := []byte(`{"version":"1"}`)
+	edata, err := madmin.EncryptData(globalActiveCred.String(), data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = saveConfig(ctx, obj, plainPath, data); err != nil {
+		t.Fatal(err)
+	}
+	if err = saveConfig(ctx, obj, credPath, edata); err != nil {
+		t.Fatal(err)
+	}
+
+	GlobalKMS, err = kms.New("my-key", make([]byte, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	migrate := func() (migrated int) {
+		err := listConfigToMigrate(ctx, obj, func(objPath string, binary bool) error {
+			ok, err := migrateConfigEncryption(ctx, obj, objPath, binary)
+			if ok {
+				migrated++
+			}
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return migrated
+	}
+	if n := migrate(); n != 3 {
+		t.Fatalf("Expected 3 migrated objects but got %d", n)
+	}
+	if n := migrate(); n != 0 {
+		t.Fatalf("Expected no migrated objects on second run but got %d", n)
+	}
+
+	for _, objPath := range []string{plainPath, credPath} {
+		edata, err := readConfig(ctx, obj, objPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !config.IsEncrypted(edata) {
+			t.Fatalf("%s is not encrypted with the KMS", objPath)
+		}
+		ddata, err := decryptConfig(objPath, edata)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(ddata, data) {
+			t.Fatalf("%s: expected %s, got %s", objPath, data, ddata)
+		}
+	}
+	meta = BucketMetadata{}
+	if err = meta.Load(ctx, obj, "bucket"); err != nil {
+		t.Fatalf("Failed to load encrypted bucket metadata: %v", err)
+	}
+}
@@ -22,12 +22,10 @@ import (
 	"path"
 	"sort"
 	"strings"
-	"unicode/utf8"
 
 	jsoniter "github.com/json-iterator/go"
 
 	"minio/cmd/config"
-	"minio/pkg/kms"
 	"minio/pkg/madmin"
 )
 
@@ -65,13 +63,9 @@ func listServerConfigHistory(ctx context.Context, objAPI ObjectLayer, withData b
 				if err != nil {
 					return nil, err
 				}
-				if GlobalKMS != nil {
-					data, err = config.DecryptBytes(GlobalKMS, data, kms.Context{
-						obj.Bucket: path.Join(obj.Bucket, obj.Name),
-					})
-					if err != nil {
-						return nil, err
-					}
+				data, err = decryptConfig(obj.Name, data)
+				if err != nil {
+					return nil, err
 				}
 				cfgEntry.Data = string(data)
 			}
@@ -106,26 +100,16 @@ func readServerConfigHistory(ctx context.Context, objAPI ObjectLayer, uuidKV str
 		return nil, err
 	}
 
-	if GlobalKMS != nil {
-		data, err = config.DecryptBytes(GlobalKMS, data, kms.Context{
-			minioMetaBucket: path.Join(minioMetaBucket, historyFile),
-		})
-	}
-	return data, err
+	return decryptConfig(historyFile, data)
 }
 
 func saveServerConfigHistory(ctx context.Context, objAPI ObjectLayer, kv []byte) error {
 	uuidKV := mustGetUUID() + kvPrefix
 	historyFile := pathJoin(minioConfigHistoryPrefix, uuidKV)
 
-	if GlobalKMS != nil {
-		var err error
-		kv, err = config.EncryptBytes(GlobalKMS, kv, kms.Context{
-			minioMetaBucket: path.Join(minioMetaBucket, historyFile),
-		})
-		if err != nil {
-			return err
-		}
+	kv, err := encryptConfig(historyFile, kv)
+	if err != nil {
+		return err
 	}
 	return saveConfig(ctx, objAPI, historyFile, kv)
 }
@@ -137,13 +121,9 @@ func saveServerConfig(ctx context.Context, objAPI ObjectLayer, cfg interface{})
 	}
 
 	var configFile = path.Join(minioConfigPrefix, minioConfigFile)
-	if GlobalKMS != nil {
-		data, err = config.EncryptBytes(GlobalKMS, data, kms.Context{
-			minioMetaBucket: path.Join(minioMetaBucket, configFile),
-		})
-		if err != nil {
-			return err
-		}
+	data, err = encryptConfig(configFile, data)
+	if err != nil {
+		return err
 	}
 	return saveConfig(ctx, objAPI, configFile, data)
 }
@@ -160,13 +140,9 @@ func readServerConfig(ctx context.Context, objAPI ObjectLayer) (config.Config, e
 		return nil, err
 	}
 
-	if GlobalKMS != nil && !utf8.Valid(data) {
-		data, err = config.DecryptBytes(GlobalKMS, data, kms.Context{
-			minioMetaBucket: path.Join(minioMetaBucket, configFile),
-		})
-		if err != nil {
-			return nil, err
-		}
+	data, err = decryptConfig(configFile, data)
+	if err != nil {
+		return nil, err
 	}
 
 	var srvCfg = config.New()
@@ -67,6 +67,8 @@ const (
 	PolicyOPASubSys      = "policy_opa"
 	IdentityOpenIDSubSys = "identity_openid"
 	IdentityLDAPSubSys   = "identity_ldap"
+	IdentityTLSSubSys    = "identity_tls"
+	IdentityPluginSubSys = "identity_plugin"
 	CacheSubSys          = "cache"
 	RegionSubSys         = "region"
 	EtcdSubSys           = "etcd"
@@ -96,6 +98,9 @@ const (
 	NotifyPostgresSubSys = "notify_postgres"
 	NotifyRedisSubSys    = "notify_redis"
 	NotifyWebhookSubSys  = "notify_webhook"
+	NotifySQSSubSys      = "notify_sqs"
+	NotifySNSSubSys      = "notify_sns"
+	NotifyPulsarSubSys   = "notify_pulsar"
 
 	// Add new constants here if you add new fields to config.
 )
@@ -116,6 +121,8 @@ var SubSystems = set.CreateStringSet(
 	PolicyOPASubSys,
 	IdentityLDAPSubSys,
 	IdentityOpenIDSubSys,
+	IdentityTLSSubSys,
+	IdentityPluginSubSys,
 	ScannerSubSys,
 	HealSubSys,
 	NotifyAMQPSubSys,
@@ -128,6 +135,9 @@ var SubSystems = set.CreateStringSet(
 	NotifyPostgresSubSys,
 	NotifyRedisSubSys,
 	NotifyWebhookSubSys,
+	NotifySQSSubSys,
+	NotifySNSSubSys,
+	NotifyPulsarSubSys,
 )
 
 // SubSystemsDynamic - all sub-systems that have dynamic config.
@@ -151,7 +161,8 @@ var SubSystemsSingleTargets = set.CreateStringSet([]string{
 	KmsKesSubSys,
 	PolicyOPASubSys,
 	IdentityLDAPSubSys,
-	IdentityOpenIDSubSys,
+	IdentityTLSSubSys,
+	IdentityPluginSubSys,
 	HealSubSys,
 	ScannerSubSys,
 }...)
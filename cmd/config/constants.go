@@ -46,6 +46,18 @@ const (
 	EnvKESClientCert = "MINIO_KMS_KES_CERT_FILE"
 	EnvKESServerCA   = "MINIO_KMS_KES_CAPATH"
 
+	EnvKMSVaultEndpoint      = "MINIO_KMS_VAULT_ENDPOINT"
+	EnvKMSVaultNamespace     = "MINIO_KMS_VAULT_NAMESPACE"
+	EnvKMSVaultEngine        = "MINIO_KMS_VAULT_ENGINE"
+	EnvKMSVaultKeyName       = "MINIO_KMS_VAULT_KEY_NAME"
+	EnvKMSVaultToken         = "MINIO_KMS_VAULT_TOKEN"
+	EnvKMSVaultAppRoleID     = "MINIO_KMS_VAULT_APPROLE_ID"
+	EnvKMSVaultAppRoleSecret = "MINIO_KMS_VAULT_APPROLE_SECRET"
+	EnvKMSVaultCAPath        = "MINIO_KMS_VAULT_CAPATH"
+
+	EnvKMSKeyStoreFile    = "MINIO_KMS_KEYSTORE_FILE"
+	EnvKMSKeyStoreKeyName = "MINIO_KMS_KEYSTORE_KEY_NAME"
+
 	EnvEndpoints = "MINIO_ENDPOINTS" // legacy
 	EnvWorm      = "MINIO_WORM"      // legacy
 	EnvRegion    = "MINIO_REGION"    // legacy
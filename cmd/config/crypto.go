@@ -154,6 +154,27 @@ func Decrypt(KMS kms.KMS, ciphertext io.Reader, context kms.Context) (io.Reader,
 	return stream.DecryptReader(ciphertext, metadata.Nonce, nil), nil
 }
 
+// IsEncrypted reports whether data has been encrypted
+// with Encrypt or EncryptBytes.
+func IsEncrypted(data []byte) bool {
+	const (
+		MaxMetadataSize = 1 << 20 // max. size of the metadata
+		Version         = 1
+	)
+	if len(data) < 5 || data[0] != Version {
+		return false
+	}
+	size := binary.LittleEndian.Uint32(data[1:5])
+	if size > MaxMetadataSize || uint64(len(data)) < 5+uint64(size) {
+		return false
+	}
+	var metadata encryptedObject
+	if err := json.Unmarshal(data[5:5+size], &metadata); err != nil {
+		return false
+	}
+	return len(metadata.KMSKey) > 0 && len(metadata.Nonce) > 0
+}
+
 type encryptedObject struct {
 	KeyID  string `json:"keyid"`
 	KMSKey []byte `json:"kmskey"`
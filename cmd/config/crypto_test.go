@@ -65,6 +65,9 @@ func TestEncryptDecrypt(t *testing.T) {
 		if err != nil {
 			t.Fatalf("Test %d: failed to encrypt stream: %v", i, err)
 		}
+		if !IsEncrypted(data) {
+			t.Fatalf("Test %d: ciphertext is not detected as encrypted", i)
+		}
 
 		plaintext, err := Decrypt(KMS, bytes.NewReader(data), test.Context)
 		if err != nil {
@@ -81,6 +84,23 @@ func TestEncryptDecrypt(t *testing.T) {
 	}
 }
 
+var isEncryptedTests = [][]byte{
+	nil,
+	[]byte(`{"version":"33"}`),
+	{1, 0, 1, 0, 0x80},              // bucket metadata header
+	{1, 5, 0, 0, 0, '{', '}', 0},    // empty metadata
+	{1, 255, 255, 255, 255, '{'},    // metadata size too large
+	{1, 10, 0, 0, 0, 'n', 'u', 'l'}, // truncated
+}
+
+func TestIsEncrypted(t *testing.T) {
+	for i, data := range isEncryptedTests {
+		if IsEncrypted(data) {
+			t.Fatalf("Test %d: data is detected as encrypted", i)
+		}
+	}
+}
+
 func BenchmarkEncrypt(b *testing.B) {
 	key, err := hex.DecodeString("ddedadb867afa3f73bd33c25499a723ed7f9f51172ee7b1b679e08dc795debcc")
 	if err != nil {
@@ -27,13 +27,39 @@ import (
 
 // Compression environment variables
 const (
-	Bitrot  = "bitrotscan"
-	Sleep   = "max_sleep"
-	IOCount = "max_io"
+	Bitrot     = "bitrotscan"
+	Sleep      = "max_sleep"
+	IOCount    = "max_io"
+	ScrubCycle = "bitrot_scrub_cycle"
+	ScrubDelay = "bitrot_scrub_delay"
+	DriveOrder = "drive_heal_order"
 
-	EnvBitrot  = "MINIO_HEAL_BITROTSCAN"
-	EnvSleep   = "MINIO_HEAL_MAX_SLEEP"
-	EnvIOCount = "MINIO_HEAL_MAX_IO"
+	SmartInterval              = "smart_interval"
+	SmartMaxTemperature        = "smart_max_temperature"
+	SmartMaxReallocatedSectors = "smart_max_reallocated_sectors"
+	SmartMaxMediaErrors        = "smart_max_media_errors"
+	SmartMaxWearLevel          = "smart_max_wear_level"
+
+	EnvBitrot     = "MINIO_HEAL_BITROTSCAN"
+	EnvSleep      = "MINIO_HEAL_MAX_SLEEP"
+	EnvIOCount    = "MINIO_HEAL_MAX_IO"
+	EnvScrubCycle = "MINIO_HEAL_BITROT_SCRUB_CYCLE"
+	EnvScrubDelay = "MINIO_HEAL_BITROT_SCRUB_DELAY"
+	EnvDriveOrder = "MINIO_HEAL_DRIVE_HEAL_ORDER"
+
+	EnvSmartInterval              = "MINIO_HEAL_SMART_INTERVAL"
+	EnvSmartMaxTemperature        = "MINIO_HEAL_SMART_MAX_TEMPERATURE"
+	EnvSmartMaxReallocatedSectors = "MINIO_HEAL_SMART_MAX_REALLOCATED_SECTORS"
+	EnvSmartMaxMediaErrors        = "MINIO_HEAL_SMART_MAX_MEDIA_ERRORS"
+	EnvSmartMaxWearLevel          = "MINIO_HEAL_SMART_MAX_WEAR_LEVEL"
+)
+
+// Orders in which the buckets of a replaced drive are healed.
+const (
+	DriveOrderNewest   = "newest"
+	DriveOrderOldest   = "oldest"
+	DriveOrderSmallest = "smallest"
+	DriveOrderLargest  = "largest"
 )
 
 // Config represents the heal settings.
@@ -43,6 +69,22 @@ type Config struct {
 	// maximum sleep duration between objects to slow down heal operation.
 	Sleep   time.Duration `json:"sleep"`
 	IOCount int           `json:"iocount"`
+	// ScrubCycle is the time between full bitrot scrubs verifying
+	// every shard on local disks, 0 disables scrubbing.
+	ScrubCycle time.Duration `json:"scrubcycle"`
+	// ScrubDelay is the sleep multiplier of scrubbing per erasure set.
+	ScrubDelay float64 `json:"scrubdelay"`
+	// DriveOrder is the order in which the buckets of a
+	// replaced drive are healed.
+	DriveOrder string `json:"driveorder"`
+	// SmartInterval is the time between polls of the SMART
+	// attributes of local drives, 0 disables polling.
+	SmartInterval time.Duration `json:"smartinterval"`
+	// Thresholds of the SMART attributes above which a drive alert is raised.
+	SmartMaxTemperature        int64  `json:"smartmaxtemperature"`
+	SmartMaxReallocatedSectors uint64 `json:"smartmaxreallocatedsectors"`
+	SmartMaxMediaErrors        uint64 `json:"smartmaxmediaerrors"`
+	SmartMaxWearLevel          uint64 `json:"smartmaxwearlevel"`
 }
 
 var (
@@ -60,6 +102,38 @@ var (
 			Key:   IOCount,
 			Value: "10",
 		},
+		config.KV{
+			Key:   ScrubCycle,
+			Value: "0s",
+		},
+		config.KV{
+			Key:   ScrubDelay,
+			Value: "10",
+		},
+		config.KV{
+			Key:   DriveOrder,
+			Value: DriveOrderNewest,
+		},
+		config.KV{
+			Key:   SmartInterval,
+			Value: "10m",
+		},
+		config.KV{
+			Key:   SmartMaxTemperature,
+			Value: "70",
+		},
+		config.KV{
+			Key:   SmartMaxReallocatedSectors,
+			Value: "50",
+		},
+		config.KV{
+			Key:   SmartMaxMediaErrors,
+			Value: "0",
+		},
+		config.KV{
+			Key:   SmartMaxWearLevel,
+			Value: "90",
+		},
 	}
 
 	// Help provides help for config values
@@ -82,6 +156,54 @@ var (
 			Optional:    true,
 			Type:        "int",
 		},
+		config.HelpKV{
+			Key:         ScrubCycle,
+			Description: `time between full bitrot scrubs of every shard on local disks, '0s' disables scrubbing. eg. "720h"`,
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         ScrubDelay,
+			Description: `bitrot scrub delay multiplier per erasure set, defaults to '10.0'`,
+			Optional:    true,
+			Type:        "float",
+		},
+		config.HelpKV{
+			Key:         DriveOrder,
+			Description: `order in which the buckets of a replaced drive are healed, defaults to 'newest'`,
+			Optional:    true,
+			Type:        "newest|oldest|smallest|largest",
+		},
+		config.HelpKV{
+			Key:         SmartInterval,
+			Description: `time between polls of the SMART attributes of local drives, '0s' disables polling, defaults to '10m'`,
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         SmartMaxTemperature,
+			Description: `drive temperature in Celsius above which an alert is raised, defaults to '70'`,
+			Optional:    true,
+			Type:        "int",
+		},
+		config.HelpKV{
+			Key:         SmartMaxReallocatedSectors,
+			Description: `number of reallocated sectors of a drive above which an alert is raised, defaults to '50'`,
+			Optional:    true,
+			Type:        "int",
+		},
+		config.HelpKV{
+			Key:         SmartMaxMediaErrors,
+			Description: `number of media errors of a drive above which an alert is raised, defaults to '0'`,
+			Optional:    true,
+			Type:        "int",
+		},
+		config.HelpKV{
+			Key:         SmartMaxWearLevel,
+			Description: `percentage of the rated endurance used of a drive above which an alert is raised, defaults to '90'`,
+			Optional:    true,
+			Type:        "int",
+		},
 	}
 )
 
@@ -102,5 +224,41 @@ func LookupConfig(kvs config.KVS) (cfg Config, err error) {
 	if err != nil {
 		return cfg, fmt.Errorf("'heal:max_io' value invalid: %w", err)
 	}
+	cfg.ScrubCycle, err = time.ParseDuration(env.Get(EnvScrubCycle, kvs.Get(ScrubCycle)))
+	if err != nil {
+		return cfg, fmt.Errorf("'heal:bitrot_scrub_cycle' value invalid: %w", err)
+	}
+	cfg.ScrubDelay, err = strconv.ParseFloat(env.Get(EnvScrubDelay, kvs.Get(ScrubDelay)), 64)
+	if err != nil {
+		return cfg, fmt.Errorf("'heal:bitrot_scrub_delay' value invalid: %w", err)
+	}
+	cfg.DriveOrder = env.Get(EnvDriveOrder, kvs.Get(DriveOrder))
+	switch cfg.DriveOrder {
+	case DriveOrderNewest, DriveOrderOldest, DriveOrderSmallest, DriveOrderLargest:
+	case "":
+		cfg.DriveOrder = DriveOrderNewest
+	default:
+		return cfg, fmt.Errorf("'heal:drive_heal_order' value invalid: %s", cfg.DriveOrder)
+	}
+	cfg.SmartInterval, err = time.ParseDuration(env.Get(EnvSmartInterval, kvs.Get(SmartInterval)))
+	if err != nil {
+		return cfg, fmt.Errorf("'heal:smart_interval' value invalid: %w", err)
+	}
+	cfg.SmartMaxTemperature, err = strconv.ParseInt(env.Get(EnvSmartMaxTemperature, kvs.Get(SmartMaxTemperature)), 10, 64)
+	if err != nil {
+		return cfg, fmt.Errorf("'heal:smart_max_temperature' value invalid: %w", err)
+	}
+	cfg.SmartMaxReallocatedSectors, err = strconv.ParseUint(env.Get(EnvSmartMaxReallocatedSectors, kvs.Get(SmartMaxReallocatedSectors)), 10, 64)
+	if err != nil {
+		return cfg, fmt.Errorf("'heal:smart_max_reallocated_sectors' value invalid: %w", err)
+	}
+	cfg.SmartMaxMediaErrors, err = strconv.ParseUint(env.Get(EnvSmartMaxMediaErrors, kvs.Get(SmartMaxMediaErrors)), 10, 64)
+	if err != nil {
+		return cfg, fmt.Errorf("'heal:smart_max_media_errors' value invalid: %w", err)
+	}
+	cfg.SmartMaxWearLevel, err = strconv.ParseUint(env.Get(EnvSmartMaxWearLevel, kvs.Get(SmartMaxWearLevel)), 10, 64)
+	if err != nil {
+		return cfg, fmt.Errorf("'heal:smart_max_wear_level' value invalid: %w", err)
+	}
 	return cfg, nil
 }
@@ -0,0 +1,99 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ldap
+
+import (
+	"sync"
+	"time"
+)
+
+// Maximum number of cached lookups, expired entries are
+// purged once the cache is full.
+const lookupCacheMaxEntries = 10000
+
+// lookupCache - caches the results of user DN and group lookups
+// for a fixed duration. A nil cache caches nothing.
+type lookupCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]lookupCacheEntry
+}
+
+type lookupCacheEntry struct {
+	values []string
+	expiry time.Time
+}
+
+func newLookupCache(ttl time.Duration) *lookupCache {
+	return &lookupCache{
+		ttl:     ttl,
+		entries: make(map[string]lookupCacheEntry),
+	}
+}
+
+func (c *lookupCache) get(key string) ([]string, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(e.expiry) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return e.values, true
+}
+
+func (c *lookupCache) set(key string, values []string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if len(c.entries) >= lookupCacheMaxEntries {
+		for k, e := range c.entries {
+			if now.After(e.expiry) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= lookupCacheMaxEntries {
+			c.entries = make(map[string]lookupCacheEntry)
+		}
+	}
+	c.entries[key] = lookupCacheEntry{
+		values: values,
+		expiry: now.Add(c.ttl),
+	}
+}
+
+// purge - removes all cached lookups.
+func (c *lookupCache) purge() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	c.entries = make(map[string]lookupCacheEntry)
+	c.mu.Unlock()
+}
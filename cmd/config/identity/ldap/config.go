@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"math/rand"
 	"net"
+	"regexp"
 	"strings"
 	"time"
 
@@ -36,8 +37,25 @@ const (
 	defaultLDAPExpiry = time.Hour * 1
 
 	dnDelimiter = ";"
+
+	// Nested groups are resolved up to this depth in iterative mode.
+	maxNestedGroupDepth = 16
+
+	// OID of the LDAP_MATCHING_RULE_IN_CHAIN matching rule of Active
+	// Directory, which matches group memberships recursively.
+	matchingRuleInChain = "1.2.840.113556.1.4.1941"
+)
+
+// Nested group resolution modes.
+const (
+	NestedGroupsOff       = "off"
+	NestedGroupsInChain   = "in_chain"
+	NestedGroupsIterative = "iterative"
 )
 
+// dnFilterTerm matches the "(attribute=%d)" terms of a group search filter.
+var dnFilterTerm = regexp.MustCompile(`\(([a-zA-Z][a-zA-Z0-9-]*)=%d\)`)
+
 // Config contains AD/LDAP server connectivity information.
 type Config struct {
 	Enabled bool `json:"enabled"`
@@ -60,6 +78,7 @@ type Config struct {
 	GroupSearchBaseDistName  string   `json:"groupSearchBaseDN"`
 	GroupSearchBaseDistNames []string `json:"-"`
 	GroupSearchFilter        string   `json:"groupSearchFilter"`
+	GroupSearchNested        string   `json:"groupSearchNested"`
 
 	// Lookup bind LDAP service account
 	LookupBindDN       string `json:"lookupBindDN"`
@@ -71,6 +90,8 @@ type Config struct {
 	serverStartTLS    bool          // allows using StartTLS connection to LDAP server
 	isUsingLookupBind bool
 	rootCAs           *x509.CertPool
+	lookupCache       *lookupCache  // nil if lookups are not cached
+	syncInterval      time.Duration // zero if policy mappings are not synced
 }
 
 // LDAP keys and envs.
@@ -84,6 +105,9 @@ const (
 	UsernameFormat     = "username_format"
 	GroupSearchFilter  = "group_search_filter"
 	GroupSearchBaseDN  = "group_search_base_dn"
+	GroupSearchNested  = "group_search_nested"
+	LookupCacheTTL     = "lookup_cache_ttl"
+	SyncInterval       = "sync_interval"
 	TLSSkipVerify      = "tls_skip_verify"
 	ServerInsecure     = "server_insecure"
 	ServerStartTLS     = "server_starttls"
@@ -98,6 +122,9 @@ const (
 	EnvUserDNSearchFilter = "MINIO_IDENTITY_LDAP_USER_DN_SEARCH_FILTER"
 	EnvGroupSearchFilter  = "MINIO_IDENTITY_LDAP_GROUP_SEARCH_FILTER"
 	EnvGroupSearchBaseDN  = "MINIO_IDENTITY_LDAP_GROUP_SEARCH_BASE_DN"
+	EnvGroupSearchNested  = "MINIO_IDENTITY_LDAP_GROUP_SEARCH_NESTED"
+	EnvLookupCacheTTL     = "MINIO_IDENTITY_LDAP_LOOKUP_CACHE_TTL"
+	EnvSyncInterval       = "MINIO_IDENTITY_LDAP_SYNC_INTERVAL"
 	EnvLookupBindDN       = "MINIO_IDENTITY_LDAP_LOOKUP_BIND_DN"
 	EnvLookupBindPassword = "MINIO_IDENTITY_LDAP_LOOKUP_BIND_PASSWORD"
 )
@@ -135,6 +162,18 @@ var (
 			Key:   GroupSearchBaseDN,
 			Value: "",
 		},
+		config.KV{
+			Key:   GroupSearchNested,
+			Value: NestedGroupsOff,
+		},
+		config.KV{
+			Key:   LookupCacheTTL,
+			Value: "",
+		},
+		config.KV{
+			Key:   SyncInterval,
+			Value: "",
+		},
 		config.KV{
 			Key:   STSExpiry,
 			Value: "1h",
@@ -239,6 +278,11 @@ func (l *Config) usernameFormatsBind(conn *ldap.Conn, username, password string)
 // assumed to be using the lookup bind service account. It is required that the
 // search result in at most one result.
 func (l *Config) lookupUserDN(conn *ldap.Conn, username string) (string, error) {
+	cacheKey := "dn\x00" + username
+	if dn, ok := l.lookupCache.get(cacheKey); ok {
+		return dn[0], nil
+	}
+
 	filter := strings.Replace(l.UserDNSearchFilter, "%s", ldap.EscapeFilter(username), -1)
 	searchRequest := ldap.NewSearchRequest(
 		l.UserDNSearchBaseDN,
@@ -258,38 +302,102 @@ func (l *Config) lookupUserDN(conn *ldap.Conn, username string) (string, error)
 	if len(searchResult.Entries) != 1 {
 		return "", fmt.Errorf("Multiple DNs for %s found - please fix the search filter", username)
 	}
+	l.lookupCache.set(cacheKey, []string{searchResult.Entries[0].DN})
 	return searchResult.Entries[0].DN, nil
 }
 
 func (l *Config) searchForUserGroups(conn *ldap.Conn, username, bindDN string) ([]string, error) {
 	// User groups lookup.
-	var groups []string
-	if l.GroupSearchFilter != "" {
-		for _, groupSearchBase := range l.GroupSearchBaseDistNames {
-			filter := strings.Replace(l.GroupSearchFilter, "%s", ldap.EscapeFilter(username), -1)
-			filter = strings.Replace(filter, "%d", ldap.EscapeFilter(bindDN), -1)
-			searchRequest := ldap.NewSearchRequest(
-				groupSearchBase,
-				ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false,
-				filter,
-				nil,
-				nil,
-			)
-
-			var newGroups []string
-			newGroups, err := getGroups(conn, searchRequest)
-			if err != nil {
-				errRet := fmt.Errorf("Error finding groups of %s: %v", bindDN, err)
-				return nil, errRet
-			}
+	if l.GroupSearchFilter == "" {
+		return nil, nil
+	}
+
+	cacheKey := "groups\x00" + username + "\x00" + bindDN
+	if groups, ok := l.lookupCache.get(cacheKey); ok {
+		return groups, nil
+	}
 
-			groups = append(groups, newGroups...)
+	filter := l.GroupSearchFilter
+	if l.GroupSearchNested == NestedGroupsInChain {
+		// Let the directory resolve the nested groups.
+		filter = dnFilterTerm.ReplaceAllString(filter, "(${1}:"+matchingRuleInChain+":=%d)")
+	}
+	groups, err := l.searchGroups(conn, filter, username, bindDN)
+	if err != nil {
+		return nil, fmt.Errorf("Error finding groups of %s: %v", bindDN, err)
+	}
+
+	if l.GroupSearchNested == NestedGroupsIterative {
+		groups, err = l.searchNestedGroups(conn, groups)
+		if err != nil {
+			return nil, fmt.Errorf("Error finding nested groups of %s: %v", bindDN, err)
 		}
 	}
 
+	l.lookupCache.set(cacheKey, groups)
 	return groups, nil
 }
 
+// searchGroups - returns the groups found by filter in all group search
+// base DNs, after substituting %s with username and %d with dn.
+func (l *Config) searchGroups(conn *ldap.Conn, filter, username, dn string) ([]string, error) {
+	var groups []string
+	for _, groupSearchBase := range l.GroupSearchBaseDistNames {
+		f := strings.Replace(filter, "%s", ldap.EscapeFilter(username), -1)
+		f = strings.Replace(f, "%d", ldap.EscapeFilter(dn), -1)
+		searchRequest := ldap.NewSearchRequest(
+			groupSearchBase,
+			ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false,
+			f,
+			nil,
+			nil,
+		)
+
+		newGroups, err := getGroups(conn, searchRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, newGroups...)
+	}
+	return groups, nil
+}
+
+// searchNestedGroups - adds the groups which the given groups are members
+// of, recursively up to maxNestedGroupDepth levels. For nested groups both
+// %s and %d of the group search filter are substituted with the group DN.
+// Every group is searched only once, so cyclic memberships terminate.
+func (l *Config) searchNestedGroups(conn *ldap.Conn, groups []string) ([]string, error) {
+	seen := make(map[string]bool, len(groups))
+	var result []string
+	for _, group := range groups {
+		if k := strings.ToLower(group); !seen[k] {
+			seen[k] = true
+			result = append(result, group)
+		}
+	}
+
+	level := result
+	for depth := 0; depth < maxNestedGroupDepth && len(level) > 0; depth++ {
+		var next []string
+		for _, group := range level {
+			parents, err := l.searchGroups(conn, l.GroupSearchFilter, group, group)
+			if err != nil {
+				return nil, err
+			}
+			for _, parent := range parents {
+				if k := strings.ToLower(parent); !seen[k] {
+					seen[k] = true
+					next = append(next, parent)
+				}
+			}
+		}
+		result = append(result, next...)
+		level = next
+	}
+	return result, nil
+}
+
 // LookupUserDN searches for the full DN ang groups of a given username
 func (l *Config) LookupUserDN(username string) (string, []string, error) {
 	if !l.isUsingLookupBind {
@@ -322,6 +430,53 @@ func (l *Config) LookupUserDN(username string) (string, []string, error) {
 	return bindDN, groups, nil
 }
 
+// GetNonExistentDNs - returns the DNs of the given list which do not exist
+// in the directory, e.g. of users and groups that have been deleted.
+func (l *Config) GetNonExistentDNs(dns []string) ([]string, error) {
+	if !l.isUsingLookupBind {
+		return nil, errors.New("current lookup mode does not support searching for DNs")
+	}
+
+	conn, err := l.Connect()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	// Bind to the lookup user account
+	if err = l.lookupBind(conn); err != nil {
+		return nil, err
+	}
+
+	var nonExistent []string
+	for _, dn := range dns {
+		if _, err = ldap.ParseDN(dn); err != nil {
+			// Not a DN, e.g. a policy mapping of an access key.
+			continue
+		}
+		searchRequest := ldap.NewSearchRequest(
+			dn,
+			ldap.ScopeBaseObject, ldap.NeverDerefAliases, 0, 0, false,
+			"(objectClass=*)",
+			[]string{}, // only need to know whether the DN exists
+			nil,
+		)
+		if _, err = conn.Search(searchRequest); err != nil {
+			if ldap.IsErrorWithCode(err, ldap.LDAPResultNoSuchObject) {
+				nonExistent = append(nonExistent, dn)
+				continue
+			}
+			return nil, fmt.Errorf("Error looking up DN %s: %v", dn, err)
+		}
+	}
+
+	// Do not serve deleted users or groups from the cache.
+	if len(nonExistent) > 0 {
+		l.lookupCache.purge()
+	}
+	return nonExistent, nil
+}
+
 // Bind - binds to ldap, searches LDAP and returns the distinguished name of the
 // user and the list of groups.
 func (l *Config) Bind(username, password string) (string, []string, error) {
@@ -418,6 +573,12 @@ func (l Config) GetExpiryDuration() time.Duration {
 	return l.stsExpiryDuration
 }
 
+// GetSyncInterval - returns the interval at which policy mappings of
+// deleted users and groups are removed, zero if disabled.
+func (l Config) GetSyncInterval() time.Duration {
+	return l.syncInterval
+}
+
 func (l Config) testConnection() error {
 	conn, err := l.Connect()
 	if err != nil {
@@ -563,6 +724,49 @@ func Lookup(kvs config.KVS, rootCAs *x509.CertPool) (l Config, err error) {
 		l.GroupSearchBaseDistNames = strings.Split(l.GroupSearchBaseDistName, dnDelimiter)
 	}
 
+	// Nested group resolution configuration
+	l.GroupSearchNested = NestedGroupsOff
+	if v := env.Get(EnvGroupSearchNested, kvs.Get(GroupSearchNested)); v != "" {
+		switch v {
+		case NestedGroupsOff:
+		case NestedGroupsInChain, NestedGroupsIterative:
+			if !dnFilterTerm.MatchString(l.GroupSearchFilter) {
+				return l, errors.New("Nested group search requires a group search filter with an '(attribute=%d)' term")
+			}
+		default:
+			return l, fmt.Errorf("Invalid nested group search mode '%s', expected one of %s, %s or %s",
+				v, NestedGroupsOff, NestedGroupsInChain, NestedGroupsIterative)
+		}
+		l.GroupSearchNested = v
+	}
+
+	if v := env.Get(EnvLookupCacheTTL, kvs.Get(LookupCacheTTL)); v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil {
+			return l, errors.New("LDAP lookup cache TTL err:" + err.Error())
+		}
+		if ttl < 0 {
+			return l, errors.New("LDAP lookup cache TTL must not be negative")
+		}
+		if ttl > 0 {
+			l.lookupCache = newLookupCache(ttl)
+		}
+	}
+
+	if v := env.Get(EnvSyncInterval, kvs.Get(SyncInterval)); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil {
+			return l, errors.New("LDAP sync interval err:" + err.Error())
+		}
+		if interval < 0 {
+			return l, errors.New("LDAP sync interval must not be negative")
+		}
+		if interval > 0 && !l.isUsingLookupBind {
+			return l, errors.New("LDAP sync requires Lookup Bind mode")
+		}
+		l.syncInterval = interval
+	}
+
 	l.rootCAs = rootCAs
 	return l, nil
 }
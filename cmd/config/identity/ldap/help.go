@@ -74,6 +74,24 @@ var (
 			Optional:    true,
 			Type:        "list",
 		},
+		config.HelpKV{
+			Key:         GroupSearchNested,
+			Description: `resolve nested groups with the Active Directory "in_chain" matching rule or "iterative" searches, defaults to "off"`,
+			Optional:    true,
+			Type:        "off|in_chain|iterative",
+		},
+		config.HelpKV{
+			Key:         LookupCacheTTL,
+			Description: `cache user DN and group lookups for this duration in s,m,h e.g. "5m", not cached by default`,
+			Optional:    true,
+			Type:        "duration",
+		},
+		config.HelpKV{
+			Key:         SyncInterval,
+			Description: `interval in s,m,h to remove policy mappings of users and groups deleted from the directory e.g. "1h", requires lookup bind`,
+			Optional:    true,
+			Type:        "duration",
+		},
 		config.HelpKV{
 			Key:         TLSSkipVerify,
 			Description: `trust server TLS without verification, defaults to "off" (verify)`,
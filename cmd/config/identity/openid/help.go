@@ -50,6 +50,12 @@ var (
 			Optional:    true,
 			Type:        "csv",
 		},
+		config.HelpKV{
+			Key:         RolePolicy,
+			Description: `comma separated list of canned policies applied to all credentials issued by this provider, overrides the policy claim e.g. "readonly"`,
+			Optional:    true,
+			Type:        "csv",
+		},
 		config.HelpKV{
 			Key:         config.Comment,
 			Description: config.DefaultComment,
@@ -46,14 +46,40 @@ type Config struct {
 	URL          *xnet.URL `json:"url,omitempty"`
 	ClaimPrefix  string    `json:"claimPrefix,omitempty"`
 	ClaimName    string    `json:"claimName,omitempty"`
+	RolePolicy   string    `json:"rolePolicy,omitempty"`
 	DiscoveryDoc DiscoveryDoc
 	ClientID     string
+	name         string
 	publicKeys   map[string]crypto.PublicKey
 	transport    *http.Transport
 	closeRespFn  func(io.ReadCloser)
 	mutex        *sync.Mutex
 }
 
+// Name - returns the config target name of this provider,
+// config.Default for the default provider.
+func (r Config) Name() string {
+	if r.name == "" {
+		return config.Default
+	}
+	return r.name
+}
+
+// RoleArn - returns the role ARN which selects this provider
+// in AssumeRoleWithWebIdentity and AssumeRoleWithClientGrants.
+func (r Config) RoleArn() string {
+	if r.Name() == config.Default {
+		return roleArnPrefix + defaultRoleName
+	}
+	return roleArnPrefix + r.name
+}
+
+// PolicyClaimName - returns the JWT claim carrying the
+// canned policies for this provider.
+func (r Config) PolicyClaimName() string {
+	return r.ClaimPrefix + r.ClaimName
+}
+
 // PopulatePublicKey - populates a new publickey from the JWKS URL.
 func (r *Config) PopulatePublicKey() error {
 	r.mutex.Lock()
@@ -213,9 +239,13 @@ func (p *JWT) Validate(token, dsecs string) (map[string]interface{}, error) {
 	return claims, nil
 }
 
-// ID returns the provider name and authentication type.
+// ID returns the provider name and authentication type, "jwt" for
+// the default provider and "jwt:<name>" for named providers.
 func (p *JWT) ID() ID {
-	return "jwt"
+	if p.Name() == config.Default {
+		return "jwt"
+	}
+	return ID("jwt" + config.SubSystemSeparator + p.name)
 }
 
 // OpenID keys and envs.
@@ -226,6 +256,7 @@ const (
 	ClaimPrefix = "claim_prefix"
 	ClientID    = "client_id"
 	Scopes      = "scopes"
+	RolePolicy  = "role_policy"
 
 	EnvIdentityOpenIDClientID    = "MINIO_IDENTITY_OPENID_CLIENT_ID"
 	EnvIdentityOpenIDJWKSURL     = "MINIO_IDENTITY_OPENID_JWKS_URL"
@@ -233,6 +264,7 @@ const (
 	EnvIdentityOpenIDClaimName   = "MINIO_IDENTITY_OPENID_CLAIM_NAME"
 	EnvIdentityOpenIDClaimPrefix = "MINIO_IDENTITY_OPENID_CLAIM_PREFIX"
 	EnvIdentityOpenIDScopes      = "MINIO_IDENTITY_OPENID_SCOPES"
+	EnvIdentityOpenIDRolePolicy  = "MINIO_IDENTITY_OPENID_ROLE_POLICY"
 )
 
 // DiscoveryDoc - parses the output from openid-configuration
@@ -305,6 +337,10 @@ var (
 			Key:   JwksURL,
 			Value: "",
 		},
+		config.KV{
+			Key:   RolePolicy,
+			Value: "",
+		},
 	}
 )
 
@@ -315,26 +351,50 @@ func Enabled(kvs config.KVS) bool {
 
 // LookupConfig lookup jwks from config, override with any ENVs.
 func LookupConfig(kvs config.KVS, transport *http.Transport, closeRespFn func(io.ReadCloser)) (c Config, err error) {
-	if err = config.CheckValidKeys(config.IdentityOpenIDSubSys, kvs, DefaultKVS); err != nil {
+	return lookupConfig(config.Default, kvs, transport, closeRespFn)
+}
+
+// targetEnv - returns the ENV name for the given provider, named
+// providers use the ENV suffixed with "_<name>".
+func targetEnv(envName, name string) string {
+	if name == config.Default {
+		return envName
+	}
+	return envName + config.Default + name
+}
+
+func lookupConfig(name string, kvs config.KVS, transport *http.Transport, closeRespFn func(io.ReadCloser)) (c Config, err error) {
+	subSys := config.IdentityOpenIDSubSys
+	if name != config.Default {
+		subSys += config.SubSystemSeparator + name
+	}
+	if err = config.CheckValidKeys(subSys, kvs, DefaultKVS); err != nil {
 		return c, err
 	}
 
-	jwksURL := env.Get(EnvIamJwksURL, "") // Legacy
+	var jwksURL string
+	if name == config.Default {
+		jwksURL = env.Get(EnvIamJwksURL, "") // Legacy
+	}
 	if jwksURL == "" {
-		jwksURL = env.Get(EnvIdentityOpenIDJWKSURL, kvs.Get(JwksURL))
+		jwksURL = env.Get(targetEnv(EnvIdentityOpenIDJWKSURL, name), kvs.Get(JwksURL))
 	}
 
 	c = Config{
-		ClaimName:   env.Get(EnvIdentityOpenIDClaimName, kvs.Get(ClaimName)),
-		ClaimPrefix: env.Get(EnvIdentityOpenIDClaimPrefix, kvs.Get(ClaimPrefix)),
+		ClaimName:   env.Get(targetEnv(EnvIdentityOpenIDClaimName, name), kvs.Get(ClaimName)),
+		ClaimPrefix: env.Get(targetEnv(EnvIdentityOpenIDClaimPrefix, name), kvs.Get(ClaimPrefix)),
+		RolePolicy:  env.Get(targetEnv(EnvIdentityOpenIDRolePolicy, name), kvs.Get(RolePolicy)),
 		publicKeys:  make(map[string]crypto.PublicKey),
-		ClientID:    env.Get(EnvIdentityOpenIDClientID, kvs.Get(ClientID)),
+		ClientID:    env.Get(targetEnv(EnvIdentityOpenIDClientID, name), kvs.Get(ClientID)),
 		transport:   transport,
 		closeRespFn: closeRespFn,
 		mutex:       &sync.Mutex{}, // allocate for copying
 	}
+	if name != config.Default {
+		c.name = name
+	}
 
-	configURL := env.Get(EnvIdentityOpenIDURL, kvs.Get(ConfigURL))
+	configURL := env.Get(targetEnv(EnvIdentityOpenIDURL, name), kvs.Get(ConfigURL))
 	if configURL != "" {
 		c.URL, err = xnet.ParseHTTPURL(configURL)
 		if err != nil {
@@ -346,7 +406,7 @@ func LookupConfig(kvs config.KVS, transport *http.Transport, closeRespFn func(io
 		}
 	}
 
-	if scopeList := env.Get(EnvIdentityOpenIDScopes, kvs.Get(Scopes)); scopeList != "" {
+	if scopeList := env.Get(targetEnv(EnvIdentityOpenIDScopes, name), kvs.Get(Scopes)); scopeList != "" {
 		var scopes []string
 		for _, scope := range strings.Split(scopeList, ",") {
 			scope = strings.TrimSpace(scope)
@@ -0,0 +1,189 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openid
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	jwtgo "github.com/dgrijalva/jwt-go"
+
+	"minio/cmd/config"
+	"minio/pkg/env"
+)
+
+const (
+	// roleArnPrefix - prefix of the role ARN of every OpenID provider,
+	// e.g. "arn:minio:iam:::role/keycloak".
+	roleArnPrefix = "arn:minio:iam:::role/"
+
+	// defaultRoleName - role name of the default provider.
+	defaultRoleName = "default"
+)
+
+// Errors returned while selecting an OpenID provider.
+var (
+	ErrNoProvider        = errors.New("no OpenID provider is configured for this token")
+	ErrAmbiguousProvider = errors.New("more than one OpenID provider matches this token, please specify the RoleArn")
+)
+
+// Providers - holds all enabled OpenID providers indexed by their
+// config target name, the default provider is indexed by config.Default.
+type Providers map[string]*JWT
+
+// LookupProviders - looks up the default and all the named
+// `identity_openid:<name>` providers from config and ENVs, only
+// providers with a JWKS URL are returned. Providers which fail to
+// initialize are skipped and the last such error is returned
+// along with the remaining providers.
+func LookupProviders(targets map[string]config.KVS, transport *http.Transport, closeRespFn func(io.ReadCloser)) (Providers, error) {
+	names := make(map[string]config.KVS)
+	for _, envName := range []string{EnvIdentityOpenIDURL, EnvIdentityOpenIDJWKSURL} {
+		for _, e := range env.List(envName + config.Default) {
+			names[strings.TrimPrefix(e, envName+config.Default)] = DefaultKVS
+		}
+	}
+	for name, kvs := range targets {
+		names[name] = kvs
+	}
+	if _, ok := names[config.Default]; !ok {
+		names[config.Default] = DefaultKVS
+	}
+
+	var lerr error
+	providers := make(Providers)
+	for name, kvs := range names {
+		if name == defaultRoleName {
+			lerr = config.Errorf("'%s' is a reserved OpenID provider name", name)
+			continue
+		}
+		c, err := lookupConfig(name, kvs, transport, closeRespFn)
+		if err != nil {
+			if name != config.Default {
+				err = fmt.Errorf("%s%s%s: %w", config.IdentityOpenIDSubSys, config.SubSystemSeparator, name, err)
+			}
+			lerr = err
+			continue
+		}
+		if c.JWKS.URL == nil {
+			continue
+		}
+		providers[name] = NewJWT(c)
+	}
+	return providers, lerr
+}
+
+// DefaultConfig - returns the config of the default provider,
+// when not configured returns a config with the default claim name.
+func (p Providers) DefaultConfig() Config {
+	if jwt, ok := p[config.Default]; ok {
+		return jwt.Config
+	}
+	return Config{ClaimName: DefaultKVS.Get(ClaimName)}
+}
+
+// RoleArns - returns the sorted role ARNs of all providers.
+func (p Providers) RoleArns() []string {
+	arns := make([]string, 0, len(p))
+	for _, jwt := range p {
+		arns = append(arns, jwt.RoleArn())
+	}
+	sort.Strings(arns)
+	return arns
+}
+
+// ForRoleArn - returns the provider selected by the role ARN.
+func (p Providers) ForRoleArn(arn string) (*JWT, error) {
+	for _, jwt := range p {
+		if jwt.RoleArn() == arn {
+			return jwt, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown RoleArn '%s', valid values are %s", arn, strings.Join(p.RoleArns(), ","))
+}
+
+// ForToken - returns the provider whose discovery document issuer
+// matches the `iss` claim of the token, providers sharing an issuer
+// are told apart by their client ID in the `aud` or `azp` claims.
+// Tokens matching no issuer are validated by the default provider,
+// a single configured provider validates all tokens.
+func (p Providers) ForToken(token string) (*JWT, error) {
+	if len(p) == 1 {
+		for _, jwt := range p {
+			return jwt, nil
+		}
+	}
+
+	var claims jwtgo.MapClaims
+	if _, _, err := new(jwtgo.Parser).ParseUnverified(token, &claims); err != nil {
+		return nil, err
+	}
+
+	var matched []*JWT
+	for _, jwt := range p {
+		if jwt.DiscoveryDoc.Issuer != "" && claims["iss"] == jwt.DiscoveryDoc.Issuer {
+			matched = append(matched, jwt)
+		}
+	}
+	if len(matched) > 1 {
+		var byClient []*JWT
+		for _, jwt := range matched {
+			if jwt.ClientID == "" {
+				continue
+			}
+			if hasAudience(claims, jwt.ClientID) {
+				byClient = append(byClient, jwt)
+			}
+		}
+		if len(byClient) != 1 {
+			return nil, ErrAmbiguousProvider
+		}
+		matched = byClient
+	}
+
+	if len(matched) == 1 {
+		return matched[0], nil
+	}
+
+	if jwt, ok := p[config.Default]; ok {
+		return jwt, nil
+	}
+	return nil, ErrNoProvider
+}
+
+// hasAudience - returns true if the client ID is the authorized party
+// or one of the audiences of the claims, `aud` may be a string or a list.
+func hasAudience(claims jwtgo.MapClaims, clientID string) bool {
+	if claims["azp"] == clientID {
+		return true
+	}
+	switch aud := claims["aud"].(type) {
+	case string:
+		return aud == clientID
+	case []interface{}:
+		for _, a := range aud {
+			if a == clientID {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,296 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package openid
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	jwtgo "github.com/dgrijalva/jwt-go"
+
+	"minio/cmd/config"
+)
+
+const testKeyID = "test-key"
+
+// newTestIdP - returns a server serving a JWKS with the given key at
+// "/jwks" and a discovery document for the issuer "<url>/<name>" at
+// "/<name>/.well-known/openid-configuration".
+func newTestIdP(t *testing.T, key *rsa.PrivateKey) *httptest.Server {
+	t.Helper()
+
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		if r.URL.Path == "/jwks" {
+			json.NewEncoder(w).Encode(JWKS{Keys: []*JWKS{{
+				Kty: "RSA",
+				Kid: testKeyID,
+				Alg: "RS256",
+				N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}}})
+			return
+		}
+		name := strings.TrimSuffix(r.URL.Path, "/.well-known/openid-configuration")
+		json.NewEncoder(w).Encode(DiscoveryDoc{
+			Issuer:  ts.URL + name,
+			JwksURI: ts.URL + "/jwks",
+		})
+	}))
+	return ts
+}
+
+func newTestToken(t *testing.T, key *rsa.PrivateKey, claims jwtgo.MapClaims) string {
+	t.Helper()
+
+	claims["exp"] = time.Now().Add(time.Hour).Unix()
+	token := jwtgo.NewWithClaims(jwtgo.SigningMethodRS256, claims)
+	token.Header["kid"] = testKeyID
+	s, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestLookupProviders(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := newTestIdP(t, key)
+	defer ts.Close()
+
+	transport := http.DefaultTransport.(*http.Transport)
+	closeRespFn := func(rc io.ReadCloser) {
+		io.Copy(ioutil.Discard, rc)
+		rc.Close()
+	}
+
+	providers, err := LookupProviders(nil, transport, closeRespFn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(providers) != 0 {
+		t.Fatalf("Expected no providers, got %v", providers.RoleArns())
+	}
+	if claim := providers.DefaultConfig().PolicyClaimName(); claim != "policy" {
+		t.Fatalf("Expected default policy claim 'policy', got %s", claim)
+	}
+
+	os.Setenv(EnvIdentityOpenIDURL+"_b1", ts.URL+"/b/.well-known/openid-configuration")
+	os.Setenv(EnvIdentityOpenIDClientID+"_b1", "client-b1")
+	defer os.Unsetenv(EnvIdentityOpenIDURL + "_b1")
+	defer os.Unsetenv(EnvIdentityOpenIDClientID + "_b1")
+
+	targets := map[string]config.KVS{
+		config.Default: {
+			config.KV{Key: JwksURL, Value: ts.URL + "/jwks"},
+			config.KV{Key: ClaimPrefix, Value: "minio/"},
+		},
+		"a": {
+			config.KV{Key: ConfigURL, Value: ts.URL + "/a/.well-known/openid-configuration"},
+			config.KV{Key: RolePolicy, Value: "readonly"},
+		},
+		"b2": {
+			config.KV{Key: ConfigURL, Value: ts.URL + "/b/.well-known/openid-configuration"},
+			config.KV{Key: ClientID, Value: "client-b2"},
+			config.KV{Key: ClaimName, Value: "groups"},
+		},
+		"off": {
+			config.KV{Key: ClientID, Value: "client-off"},
+		},
+	}
+
+	providers, err = LookupProviders(targets, transport, closeRespFn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedArns := []string{
+		"arn:minio:iam:::role/a",
+		"arn:minio:iam:::role/b1",
+		"arn:minio:iam:::role/b2",
+		"arn:minio:iam:::role/default",
+	}
+	if arns := providers.RoleArns(); strings.Join(arns, ",") != strings.Join(expectedArns, ",") {
+		t.Fatalf("Expected role ARNs %v, got %v", expectedArns, arns)
+	}
+
+	testCases := []struct {
+		id          ID
+		name        string
+		claim       string
+		rolePolicy  string
+		clientID    string
+		discoveryID string
+	}{
+		{"jwt", config.Default, "minio/policy", "", "", ""},
+		{"jwt:a", "a", "policy", "readonly", "", ts.URL + "/a"},
+		{"jwt:b1", "b1", "policy", "", "client-b1", ts.URL + "/b"},
+		{"jwt:b2", "b2", "groups", "", "client-b2", ts.URL + "/b"},
+	}
+	for i, testCase := range testCases {
+		p, ok := providers[testCase.name]
+		if !ok {
+			t.Fatalf("Test %d: provider %s not found", i+1, testCase.name)
+		}
+		if p.ID() != testCase.id {
+			t.Errorf("Test %d: expected ID %s, got %s", i+1, testCase.id, p.ID())
+		}
+		if p.PolicyClaimName() != testCase.claim {
+			t.Errorf("Test %d: expected policy claim %s, got %s", i+1, testCase.claim, p.PolicyClaimName())
+		}
+		if p.RolePolicy != testCase.rolePolicy {
+			t.Errorf("Test %d: expected role policy %s, got %s", i+1, testCase.rolePolicy, p.RolePolicy)
+		}
+		if p.ClientID != testCase.clientID {
+			t.Errorf("Test %d: expected client ID %s, got %s", i+1, testCase.clientID, p.ClientID)
+		}
+		if p.DiscoveryDoc.Issuer != testCase.discoveryID {
+			t.Errorf("Test %d: expected issuer %s, got %s", i+1, testCase.discoveryID, p.DiscoveryDoc.Issuer)
+		}
+	}
+
+	if _, err = LookupProviders(map[string]config.KVS{
+		"default": {config.KV{Key: JwksURL, Value: ts.URL + "/jwks"}},
+	}, transport, closeRespFn); err == nil {
+		t.Fatal("Expected reserved provider name to fail")
+	}
+
+	if _, err = LookupProviders(map[string]config.KVS{
+		"a": {config.KV{Key: "unknown", Value: "value"}},
+	}, transport, closeRespFn); err == nil {
+		t.Fatal("Expected unknown key to fail")
+	}
+}
+
+func TestProvidersSelection(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := newTestIdP(t, key)
+	defer ts.Close()
+
+	transport := http.DefaultTransport.(*http.Transport)
+	closeRespFn := func(rc io.ReadCloser) {
+		io.Copy(ioutil.Discard, rc)
+		rc.Close()
+	}
+
+	providers, err := LookupProviders(map[string]config.KVS{
+		config.Default: {
+			config.KV{Key: JwksURL, Value: ts.URL + "/jwks"},
+		},
+		"a": {
+			config.KV{Key: ConfigURL, Value: ts.URL + "/a/.well-known/openid-configuration"},
+		},
+		"b1": {
+			config.KV{Key: ConfigURL, Value: ts.URL + "/b/.well-known/openid-configuration"},
+			config.KV{Key: ClientID, Value: "client-b1"},
+		},
+		"b2": {
+			config.KV{Key: ConfigURL, Value: ts.URL + "/b/.well-known/openid-configuration"},
+			config.KV{Key: ClientID, Value: "client-b2"},
+		},
+	}, transport, closeRespFn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokenCases := []struct {
+		claims   jwtgo.MapClaims
+		provider string
+		err      error
+	}{
+		{jwtgo.MapClaims{"iss": ts.URL + "/a"}, "a", nil},
+		{jwtgo.MapClaims{"iss": ts.URL + "/b", "aud": "client-b2"}, "b2", nil},
+		{jwtgo.MapClaims{"iss": ts.URL + "/b", "aud": []string{"other", "client-b1"}}, "b1", nil},
+		{jwtgo.MapClaims{"iss": ts.URL + "/b", "aud": "other", "azp": "client-b2"}, "b2", nil},
+		{jwtgo.MapClaims{"iss": ts.URL + "/b", "aud": "other"}, "", ErrAmbiguousProvider},
+		{jwtgo.MapClaims{"iss": "https://unknown"}, config.Default, nil},
+		{jwtgo.MapClaims{}, config.Default, nil},
+	}
+	for i, testCase := range tokenCases {
+		token := newTestToken(t, key, testCase.claims)
+		p, err := providers.ForToken(token)
+		if err != testCase.err {
+			t.Fatalf("Test %d: expected error %v, got %v", i+1, testCase.err, err)
+		}
+		if err != nil {
+			continue
+		}
+		if p.Name() != testCase.provider {
+			t.Fatalf("Test %d: expected provider %s, got %s", i+1, testCase.provider, p.Name())
+		}
+		if _, err = p.Validate(token, ""); err != nil {
+			t.Fatalf("Test %d: unexpected validation error %v", i+1, err)
+		}
+	}
+
+	if _, err = providers.ForToken("invalid"); err == nil {
+		t.Fatal("Expected malformed token to fail")
+	}
+
+	delete(providers, config.Default)
+	if _, err = providers.ForToken(newTestToken(t, key, jwtgo.MapClaims{"iss": "https://unknown"})); err != ErrNoProvider {
+		t.Fatalf("Expected error %v, got %v", ErrNoProvider, err)
+	}
+
+	single := Providers{"a": providers["a"]}
+	if p, err := single.ForToken(newTestToken(t, key, jwtgo.MapClaims{"iss": "https://unknown"})); err != nil || p.Name() != "a" {
+		t.Fatalf("Expected the only provider to be selected, got %v", err)
+	}
+
+	arnCases := []struct {
+		arn      string
+		provider string
+	}{
+		{"arn:minio:iam:::role/a", "a"},
+		{"arn:minio:iam:::role/b2", "b2"},
+		{"arn:minio:iam:::role/default", ""},
+		{"arn:minio:iam:::role/unknown", ""},
+	}
+	for i, testCase := range arnCases {
+		p, err := providers.ForRoleArn(testCase.arn)
+		if testCase.provider == "" {
+			if err == nil {
+				t.Fatalf("Test %d: expected role ARN %s to fail", i+1, testCase.arn)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Test %d: unexpected error %v", i+1, err)
+		}
+		if p.Name() != testCase.provider {
+			t.Fatalf("Test %d: expected provider %s, got %s", i+1, testCase.provider, p.Name())
+		}
+	}
+}
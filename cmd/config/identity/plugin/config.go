@@ -0,0 +1,203 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"minio/cmd/config"
+	"minio/pkg/env"
+	xnet "minio/pkg/net"
+)
+
+// Identity plugin keys and envs.
+const (
+	URL       = "url"
+	AuthToken = "auth_token"
+
+	EnvIdentityPluginURL       = "MINIO_IDENTITY_PLUGIN_URL"
+	EnvIdentityPluginAuthToken = "MINIO_IDENTITY_PLUGIN_AUTH_TOKEN"
+)
+
+// DefaultKVS - default config for identity plugin config
+var (
+	DefaultKVS = config.KVS{
+		config.KV{
+			Key:   URL,
+			Value: "",
+		},
+		config.KV{
+			Key:   AuthToken,
+			Value: "",
+		},
+	}
+)
+
+// requestTimeout - maximum time to wait for the response of the identity
+// plugin, including its headers, so that a hung plugin does not block
+// the STS requests.
+var requestTimeout = 10 * time.Second
+
+// ErrInvalidToken - the token was rejected by the identity plugin.
+var ErrInvalidToken = errors.New("token rejected by the identity plugin")
+
+// Args identity plugin configuration.
+type Args struct {
+	URL         *xnet.URL             `json:"url"`
+	AuthToken   string                `json:"authToken"`
+	Transport   http.RoundTripper     `json:"-"`
+	CloseRespFn func(r io.ReadCloser) `json:"-"`
+}
+
+// Enabled returns if the identity plugin is enabled.
+func Enabled(kvs config.KVS) bool {
+	return kvs.Get(URL) != ""
+}
+
+// LookupConfig lookup identity plugin from config, override with any ENVs.
+func LookupConfig(kv config.KVS, transport *http.Transport, closeRespFn func(io.ReadCloser)) (Args, error) {
+	args := Args{}
+
+	if err := config.CheckValidKeys(config.IdentityPluginSubSys, kv, DefaultKVS); err != nil {
+		return args, err
+	}
+
+	pluginURL := env.Get(EnvIdentityPluginURL, kv.Get(URL))
+	if pluginURL == "" {
+		return args, nil
+	}
+
+	u, err := xnet.ParseHTTPURL(pluginURL)
+	if err != nil {
+		return args, err
+	}
+	args = Args{
+		URL:         u,
+		AuthToken:   env.Get(EnvIdentityPluginAuthToken, kv.Get(AuthToken)),
+		Transport:   transport,
+		CloseRespFn: closeRespFn,
+	}
+	if transport != nil {
+		tr := transport.Clone()
+		tr.ResponseHeaderTimeout = requestTimeout
+		args.Transport = tr
+	}
+	return args, nil
+}
+
+// Plugin - implements identity plugin calls, the plugin validates
+// opaque tokens issued by an external authentication service.
+type Plugin struct {
+	args   Args
+	client *http.Client
+}
+
+// New - initializes identity plugin connector.
+func New(args Args) *Plugin {
+	// No plugin args.
+	if args.URL == nil || args.URL.Scheme == "" {
+		return nil
+	}
+	return &Plugin{
+		args:   args,
+		client: &http.Client{Transport: args.Transport, Timeout: requestTimeout},
+	}
+}
+
+// Response - identity of a valid token returned by the plugin.
+type Response struct {
+	// User - name of the authenticated user, mandatory.
+	User string `json:"user"`
+
+	// Policies - canned policies of the temporary credentials.
+	Policies []string `json:"policies"`
+
+	// DurationSeconds - maximum validity of the temporary
+	// credentials, unlimited when zero.
+	DurationSeconds int64 `json:"durationSeconds,omitempty"`
+}
+
+// MaxDuration - returns the maximum validity of the temporary
+// credentials, zero when unlimited.
+func (r Response) MaxDuration() time.Duration {
+	return time.Duration(r.DurationSeconds) * time.Second
+}
+
+// errorResponse - body of a plugin response rejecting the token.
+type errorResponse struct {
+	Reason string `json:"reason"`
+}
+
+// Authenticate - validates the token with the identity plugin. The token
+// is sent as `{"token": "<token>"}`, a 200 OK response carries the user,
+// policies and maximum duration of the session while 401 and 403
+// responses reject the token with an optional `{"reason": "<reason>"}`.
+func (p *Plugin) Authenticate(token string) (r Response, err error) {
+	if p == nil {
+		return r, errors.New("identity plugin is not configured")
+	}
+
+	body, err := json.Marshal(map[string]string{"token": token})
+	if err != nil {
+		return r, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, p.args.URL.String(), bytes.NewReader(body))
+	if err != nil {
+		return r, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if p.args.AuthToken != "" {
+		req.Header.Set("Authorization", p.args.AuthToken)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return r, err
+	}
+	defer p.args.CloseRespFn(resp.Body)
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized, http.StatusForbidden:
+		var errResp errorResponse
+		if json.NewDecoder(resp.Body).Decode(&errResp) == nil && errResp.Reason != "" {
+			return r, fmt.Errorf("%w: %s", ErrInvalidToken, errResp.Reason)
+		}
+		return r, ErrInvalidToken
+	default:
+		return r, fmt.Errorf("identity plugin returned %s", resp.Status)
+	}
+
+	if err = json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return r, fmt.Errorf("invalid identity plugin response: %w", err)
+	}
+	if r.User == "" {
+		return r, errors.New("invalid identity plugin response: user is missing")
+	}
+	if r.DurationSeconds < 0 {
+		return r, fmt.Errorf("invalid identity plugin response: negative duration %d", r.DurationSeconds)
+	}
+	return r, nil
+}
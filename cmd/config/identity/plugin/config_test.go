@@ -0,0 +1,184 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"minio/cmd/config"
+)
+
+func closeResp(rc io.ReadCloser) {
+	io.Copy(ioutil.Discard, rc)
+	rc.Close()
+}
+
+func TestAuthenticate(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var req struct {
+			Token string `json:"token"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch req.Token {
+		case "valid":
+			w.Write([]byte(`{"user":"alice","policies":["readwrite","diagnostics"],"durationSeconds":1800}`))
+		case "unlimited":
+			w.Write([]byte(`{"user":"bob","policies":["readonly"]}`))
+		case "revoked":
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"reason":"token revoked"}`))
+		case "no-user":
+			w.Write([]byte(`{"policies":["readonly"]}`))
+		case "negative":
+			w.Write([]byte(`{"user":"alice","durationSeconds":-1}`))
+		case "malformed":
+			w.Write([]byte(`{"user":`))
+		case "error":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer ts.Close()
+
+	args, err := LookupConfig(config.KVS{
+		config.KV{Key: URL, Value: ts.URL},
+		config.KV{Key: AuthToken, Value: "Bearer secret"},
+	}, http.DefaultTransport.(*http.Transport), closeResp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := New(args)
+
+	testCases := []struct {
+		token       string
+		user        string
+		policies    string
+		maxDuration time.Duration
+		invalid     bool
+		expectErr   bool
+	}{
+		{"valid", "alice", "readwrite,diagnostics", 30 * time.Minute, false, false},
+		{"unlimited", "bob", "readonly", 0, false, false},
+		{"revoked", "", "", 0, true, true},
+		{"unknown", "", "", 0, true, true},
+		{"no-user", "", "", 0, false, true},
+		{"negative", "", "", 0, false, true},
+		{"malformed", "", "", 0, false, true},
+		{"error", "", "", 0, false, true},
+	}
+
+	for i, testCase := range testCases {
+		resp, err := p.Authenticate(testCase.token)
+		if testCase.expectErr != (err != nil) {
+			t.Fatalf("Test %d: expected error %v, got %v", i+1, testCase.expectErr, err)
+		}
+		if testCase.invalid != errors.Is(err, ErrInvalidToken) {
+			t.Fatalf("Test %d: expected invalid token %v, got %v", i+1, testCase.invalid, err)
+		}
+		if err != nil {
+			continue
+		}
+		if resp.User != testCase.user {
+			t.Errorf("Test %d: expected user %s, got %s", i+1, testCase.user, resp.User)
+		}
+		if policies := strings.Join(resp.Policies, ","); policies != testCase.policies {
+			t.Errorf("Test %d: expected policies %s, got %s", i+1, testCase.policies, policies)
+		}
+		if resp.MaxDuration() != testCase.maxDuration {
+			t.Errorf("Test %d: expected duration %s, got %s", i+1, testCase.maxDuration, resp.MaxDuration())
+		}
+	}
+
+	if _, err = p.Authenticate("revoked"); err == nil || !strings.Contains(err.Error(), "token revoked") {
+		t.Fatalf("Expected the rejection reason in the error, got %v", err)
+	}
+
+	// The plugin rejects requests without the configured auth token.
+	args.AuthToken = ""
+	if _, err = New(args).Authenticate("valid"); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("Expected error %v, got %v", ErrInvalidToken, err)
+	}
+}
+
+func TestAuthenticateTimeout(t *testing.T) {
+	defer func(timeout time.Duration) { requestTimeout = timeout }(requestTimeout)
+	requestTimeout = 100 * time.Millisecond
+
+	done := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer ts.Close()
+	defer close(done)
+
+	args, err := LookupConfig(config.KVS{config.KV{Key: URL, Value: ts.URL}},
+		http.DefaultTransport.(*http.Transport), closeResp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = New(args).Authenticate("valid"); err == nil {
+		t.Fatal("Expected a hung identity plugin to time out")
+	}
+}
+
+func TestLookupConfig(t *testing.T) {
+	args, err := LookupConfig(config.KVS{}, http.DefaultTransport.(*http.Transport), closeResp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if New(args) != nil {
+		t.Fatal("Expected no identity plugin without URL")
+	}
+
+	if _, err = LookupConfig(config.KVS{config.KV{Key: URL, Value: "ftp://localhost"}}, nil, closeResp); err == nil {
+		t.Fatal("Expected invalid URL to fail")
+	}
+
+	if _, err = LookupConfig(config.KVS{config.KV{Key: "unknown", Value: "value"}}, nil, closeResp); err == nil {
+		t.Fatal("Expected unknown key to fail")
+	}
+
+	os.Setenv(EnvIdentityPluginURL, "http://localhost:8181/auth")
+	defer os.Unsetenv(EnvIdentityPluginURL)
+	args, err = LookupConfig(config.KVS{config.KV{Key: URL, Value: "http://example.com"}}, http.DefaultTransport.(*http.Transport), closeResp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if args.URL.String() != "http://localhost:8181/auth" {
+		t.Fatalf("Expected the ENV to override the config, got %s", args.URL)
+	}
+	if New(args) == nil {
+		t.Fatal("Expected identity plugin to be configured")
+	}
+}
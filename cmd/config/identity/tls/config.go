@@ -0,0 +1,134 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tls
+
+import (
+	"crypto/x509"
+	"errors"
+	"strings"
+	"time"
+
+	"minio/cmd/config"
+	"minio/pkg/certs"
+	"minio/pkg/env"
+)
+
+// TLS identity keys and envs.
+const (
+	TrustedCADir = "trusted_ca_dir"
+
+	EnvIdentityTLSEnabled      = "MINIO_IDENTITY_TLS_ENABLE"
+	EnvIdentityTLSTrustedCADir = "MINIO_IDENTITY_TLS_TRUSTED_CA_DIR"
+)
+
+// Errors returned while verifying client certificates.
+var (
+	ErrNoCertificate = errors.New("no TLS client certificate presented, AssumeRoleWithCertificate requires mutual TLS")
+	ErrNoCommonName  = errors.New("TLS client certificate has no subject common name")
+	ErrNoTrustedCAs  = errors.New("no trusted CAs for TLS client certificates")
+
+	errMultiplePolicies = errors.New("TLS client certificate common name must name exactly one policy")
+)
+
+// DefaultKVS - default config for TLS identity config
+var (
+	DefaultKVS = config.KVS{
+		config.KV{
+			Key:   TrustedCADir,
+			Value: "",
+		},
+	}
+)
+
+// Config - TLS client certificate identity config, client
+// certificates issued by a trusted CA are exchanged for
+// temporary credentials with the policy named by their
+// subject common name.
+type Config struct {
+	Enabled bool `json:"enabled"`
+
+	// rootCAs - trusted CAs for client certificates, never
+	// the CAs of the server which include the system CAs.
+	rootCAs *x509.CertPool
+}
+
+// Enabled returns if TLS client certificate identity is enabled, it is
+// only configurable with ENV since client certificates are requested
+// by the server during the TLS handshake.
+func Enabled() (bool, error) {
+	return config.ParseBool(env.Get(EnvIdentityTLSEnabled, config.EnableOff))
+}
+
+// Lookup - initializes TLS identity config, overrides config, if any ENV values are set.
+func Lookup(kvs config.KVS) (c Config, err error) {
+	if err = config.CheckValidKeys(config.IdentityTLSSubSys, kvs, DefaultKVS); err != nil {
+		return c, err
+	}
+	c.Enabled, err = Enabled()
+	if err != nil {
+		return c, err
+	}
+	if !c.Enabled {
+		return c, nil
+	}
+	// Public CAs must not issue certificates for policies,
+	// so only the CAs of a dedicated directory are trusted.
+	dir := env.Get(EnvIdentityTLSTrustedCADir, kvs.Get(TrustedCADir))
+	if dir == "" {
+		return Config{}, config.Errorf("%s must be set to a directory of CAs trusted to issue client certificates", EnvIdentityTLSTrustedCADir)
+	}
+	c.rootCAs, err = certs.GetCAs(dir)
+	if err != nil {
+		return Config{}, config.Errorf("unable to load trusted CAs for client certificates: %v", err)
+	}
+	return c, nil
+}
+
+// Verify - verifies the TLS client certificate chain presented by the peer
+// against the trusted CAs and returns the verified client certificate.
+func (c Config) Verify(chain []*x509.Certificate, now time.Time) (*x509.Certificate, error) {
+	if len(chain) == 0 {
+		return nil, ErrNoCertificate
+	}
+	roots := c.rootCAs
+	if roots == nil {
+		return nil, ErrNoTrustedCAs
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	cert := chain[0]
+	if _, err := cert.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return nil, err
+	}
+	if cert.Subject.CommonName == "" {
+		return nil, ErrNoCommonName
+	}
+	// Policy names are comma separated, a common name
+	// listing several of them would be granted all.
+	if strings.Contains(cert.Subject.CommonName, ",") {
+		return nil, errMultiplePolicies
+	}
+	return cert, nil
+}
@@ -0,0 +1,194 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"minio/cmd/config"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCert(t *testing.T, cn string, parent *testCert, isCA bool, usage x509.ExtKeyUsage, notAfter time.Time) *testCert {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              notAfter,
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	if isCA {
+		template.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		template.KeyUsage = x509.KeyUsageDigitalSignature
+		template.ExtKeyUsage = []x509.ExtKeyUsage{usage}
+	}
+
+	signer, signerKey := template, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCert{cert: cert, key: key}
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Now()
+	expiry := now.Add(24 * time.Hour)
+
+	rootCA := newTestCert(t, "root", nil, true, 0, expiry)
+	intermediateCA := newTestCert(t, "intermediate", rootCA, true, 0, expiry)
+	otherCA := newTestCert(t, "other", nil, true, 0, expiry)
+
+	client := newTestCert(t, "readwrite", rootCA, false, x509.ExtKeyUsageClientAuth, expiry)
+	chained := newTestCert(t, "readonly", intermediateCA, false, x509.ExtKeyUsageClientAuth, expiry)
+	noCN := newTestCert(t, "", rootCA, false, x509.ExtKeyUsageClientAuth, expiry)
+	multiple := newTestCert(t, "readwrite,diagnostics", rootCA, false, x509.ExtKeyUsageClientAuth, expiry)
+	serverOnly := newTestCert(t, "readwrite", rootCA, false, x509.ExtKeyUsageServerAuth, expiry)
+	expired := newTestCert(t, "readwrite", rootCA, false, x509.ExtKeyUsageClientAuth, now.Add(-time.Minute))
+	untrusted := newTestCert(t, "readwrite", otherCA, false, x509.ExtKeyUsageClientAuth, expiry)
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(rootCA.cert)
+	otherCAs := x509.NewCertPool()
+	otherCAs.AddCert(otherCA.cert)
+
+	trusted := Config{Enabled: true, rootCAs: rootCAs}
+	testCases := []struct {
+		cfg        Config
+		chain      []*x509.Certificate
+		commonName string
+		expectErr  bool
+	}{
+		// Client certificates issued by the trusted CAs.
+		{trusted, []*x509.Certificate{client.cert}, "readwrite", false},
+		{trusted, []*x509.Certificate{chained.cert, intermediateCA.cert}, "readonly", false},
+		{Config{Enabled: true, rootCAs: otherCAs}, []*x509.Certificate{untrusted.cert}, "readwrite", false},
+		// Rejected client certificates.
+		{Config{Enabled: true, rootCAs: otherCAs}, []*x509.Certificate{client.cert}, "", true},
+		{Config{Enabled: true}, []*x509.Certificate{client.cert}, "", true},
+		{trusted, nil, "", true},
+		{trusted, []*x509.Certificate{chained.cert}, "", true},
+		{trusted, []*x509.Certificate{noCN.cert}, "", true},
+		{trusted, []*x509.Certificate{multiple.cert}, "", true},
+		{trusted, []*x509.Certificate{serverOnly.cert}, "", true},
+		{trusted, []*x509.Certificate{expired.cert}, "", true},
+		{trusted, []*x509.Certificate{untrusted.cert}, "", true},
+	}
+
+	for i, testCase := range testCases {
+		cert, err := testCase.cfg.Verify(testCase.chain, now)
+		if testCase.expectErr {
+			if err == nil {
+				t.Errorf("Test %d: expected verification to fail", i+1)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Test %d: unexpected error %v", i+1, err)
+			continue
+		}
+		if cert.Subject.CommonName != testCase.commonName {
+			t.Errorf("Test %d: expected common name %s, got %s", i+1, testCase.commonName, cert.Subject.CommonName)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	defer os.Unsetenv(EnvIdentityTLSEnabled)
+
+	cfg, err := Lookup(config.KVS{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Enabled {
+		t.Fatal("Expected TLS identity to be disabled by default")
+	}
+
+	os.Setenv(EnvIdentityTLSEnabled, "invalid")
+	if _, err = Lookup(config.KVS{}); err == nil {
+		t.Fatal("Expected invalid enable value to fail")
+	}
+
+	// The server CAs, which include the system
+	// CAs, are never trusted for client certificates.
+	os.Setenv(EnvIdentityTLSEnabled, config.EnableOn)
+	if cfg, err = Lookup(config.KVS{}); err == nil || cfg.Enabled {
+		t.Fatal("Expected TLS identity without trusted CAs to fail")
+	}
+
+	dir, err := ioutil.TempDir("", "identity-tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	kvs := config.KVS{config.KV{Key: TrustedCADir, Value: dir}}
+	if cfg, err = Lookup(kvs); err == nil || cfg.Enabled {
+		t.Fatal("Expected trusted CA dir without certificates to fail")
+	}
+
+	ca := newTestCert(t, "root", nil, true, 0, time.Now().Add(time.Hour))
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw})
+	if err = ioutil.WriteFile(filepath.Join(dir, "ca.crt"), caPEM, 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = Lookup(kvs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.Enabled || cfg.rootCAs == nil || len(cfg.rootCAs.Subjects()) != 1 {
+		t.Fatal("Expected only the CAs of the trusted CA dir")
+	}
+
+	if _, err = Lookup(config.KVS{config.KV{Key: "unknown", Value: "value"}}); err == nil {
+		t.Fatal("Expected unknown key to fail")
+	}
+}
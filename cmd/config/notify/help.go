@@ -604,8 +604,8 @@ var (
 		},
 		config.HelpKV{
 			Key:         target.RedisFormat,
-			Description: formatComment,
-			Type:        "namespace*|access",
+			Description: formatComment + `, 'stream' appends events to a Redis stream`,
+			Type:        "namespace*|access|stream",
 		},
 		config.HelpKV{
 			Key:         target.RedisPassword,
@@ -625,6 +625,160 @@ var (
 			Optional:    true,
 			Type:        "number",
 		},
+		config.HelpKV{
+			Key:         target.RedisStreamMaxLen,
+			Description: "approximate maximum number of entries kept in the stream, defaults to '0' (no trimming)",
+			Optional:    true,
+			Type:        "number",
+		},
+		config.HelpKV{
+			Key:         config.Comment,
+			Description: config.DefaultComment,
+			Optional:    true,
+			Type:        "sentence",
+		},
+	}
+
+	HelpSQS = config.HelpKVS{
+		config.HelpKV{
+			Key:         target.SQSQueueURL,
+			Description: "SQS queue URL e.g. https://sqs.us-east-1.amazonaws.com/123456789012/events",
+			Type:        "url",
+		},
+		config.HelpKV{
+			Key:         target.SQSRegion,
+			Description: "region used to sign requests, defaults to 'us-east-1'",
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         target.SQSAccessKey,
+			Description: "access key used to sign requests, requests are sent unsigned if empty",
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         target.SQSSecretKey,
+			Description: "secret key used to sign requests",
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         target.SQSQueueDir,
+			Description: queueDirComment,
+			Optional:    true,
+			Type:        "path",
+		},
+		config.HelpKV{
+			Key:         target.SQSQueueLimit,
+			Description: queueLimitComment,
+			Optional:    true,
+			Type:        "number",
+		},
+		config.HelpKV{
+			Key:         config.Comment,
+			Description: config.DefaultComment,
+			Optional:    true,
+			Type:        "sentence",
+		},
+	}
+
+	HelpSNS = config.HelpKVS{
+		config.HelpKV{
+			Key:         target.SNSEndpoint,
+			Description: "SNS endpoint e.g. https://sns.us-east-1.amazonaws.com",
+			Type:        "url",
+		},
+		config.HelpKV{
+			Key:         target.SNSTopicARN,
+			Description: "ARN of the topic to publish to e.g. arn:aws:sns:us-east-1:123456789012:events",
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         target.SNSRegion,
+			Description: "region used to sign requests, defaults to 'us-east-1'",
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         target.SNSAccessKey,
+			Description: "access key used to sign requests, requests are sent unsigned if empty",
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         target.SNSSecretKey,
+			Description: "secret key used to sign requests",
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         target.SNSQueueDir,
+			Description: queueDirComment,
+			Optional:    true,
+			Type:        "path",
+		},
+		config.HelpKV{
+			Key:         target.SNSQueueLimit,
+			Description: queueLimitComment,
+			Optional:    true,
+			Type:        "number",
+		},
+		config.HelpKV{
+			Key:         config.Comment,
+			Description: config.DefaultComment,
+			Optional:    true,
+			Type:        "sentence",
+		},
+	}
+
+	HelpPulsar = config.HelpKVS{
+		config.HelpKV{
+			Key:         target.PulsarEndpoint,
+			Description: "Pulsar WebSocket service URL e.g. `ws://localhost:8080`",
+			Type:        "url",
+		},
+		config.HelpKV{
+			Key:         target.PulsarTopic,
+			Description: "Pulsar topic e.g. `persistent://public/default/minio-events`",
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         target.PulsarToken,
+			Description: "JWT token for Pulsar token authentication",
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         target.PulsarTLSSkipVerify,
+			Description: `trust server TLS without verification, defaults to "off" (verify)`,
+			Optional:    true,
+			Type:        "on|off",
+		},
+		config.HelpKV{
+			Key:         target.PulsarClientCert,
+			Description: "client cert for Pulsar mTLS auth",
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         target.PulsarClientKey,
+			Description: "client cert key for Pulsar mTLS auth",
+			Optional:    true,
+			Type:        "string",
+		},
+		config.HelpKV{
+			Key:         target.PulsarQueueDir,
+			Description: queueDirComment,
+			Optional:    true,
+			Type:        "path",
+		},
+		config.HelpKV{
+			Key:         target.PulsarQueueLimit,
+			Description: queueLimitComment,
+			Optional:    true,
+			Type:        "number",
+		},
 		config.HelpKV{
 			Key:         config.Comment,
 			Description: config.DefaultComment,
@@ -162,6 +162,21 @@ func FetchRegisteredTargets(ctx context.Context, cfg config.Config, transport *h
 		return nil, err
 	}
 
+	sqsTargets, err := GetNotifySQS(cfg[config.NotifySQSSubSys], transport)
+	if err != nil {
+		return nil, err
+	}
+
+	snsTargets, err := GetNotifySNS(cfg[config.NotifySNSSubSys], transport)
+	if err != nil {
+		return nil, err
+	}
+
+	pulsarTargets, err := GetNotifyPulsar(cfg[config.NotifyPulsarSubSys], transport.TLSClientConfig.RootCAs)
+	if err != nil {
+		return nil, err
+	}
+
 	for id, args := range amqpTargets {
 		if !args.Enable {
 			continue
@@ -365,6 +380,66 @@ func FetchRegisteredTargets(ctx context.Context, cfg config.Config, transport *h
 		}
 	}
 
+	for id, args := range sqsTargets {
+		if !args.Enable {
+			continue
+		}
+		newTarget, err := target.NewSQSTarget(ctx, id, args, logger.LogOnceIf, transport, test)
+		if err != nil {
+			targetsOffline = true
+			if returnOnTargetError {
+				return nil, err
+			}
+			_ = newTarget.Close()
+		}
+		if err = targetList.Add(newTarget); err != nil {
+			logger.LogIf(context.Background(), err)
+			if returnOnTargetError {
+				return nil, err
+			}
+		}
+	}
+
+	for id, args := range snsTargets {
+		if !args.Enable {
+			continue
+		}
+		newTarget, err := target.NewSNSTarget(ctx, id, args, logger.LogOnceIf, transport, test)
+		if err != nil {
+			targetsOffline = true
+			if returnOnTargetError {
+				return nil, err
+			}
+			_ = newTarget.Close()
+		}
+		if err = targetList.Add(newTarget); err != nil {
+			logger.LogIf(context.Background(), err)
+			if returnOnTargetError {
+				return nil, err
+			}
+		}
+	}
+
+	for id, args := range pulsarTargets {
+		if !args.Enable {
+			continue
+		}
+		newTarget, err := target.NewPulsarTarget(id, args, ctx.Done(), logger.LogOnceIf, test)
+		if err != nil {
+			targetsOffline = true
+			if returnOnTargetError {
+				return nil, err
+			}
+			_ = newTarget.Close()
+		}
+		if err = targetList.Add(newTarget); err != nil {
+			logger.LogIf(context.Background(), err)
+			if returnOnTargetError {
+				return nil, err
+			}
+		}
+	}
+
 	if targetsOffline {
 		return targetList, ErrTargetsOffline
 	}
@@ -385,6 +460,9 @@ var (
 		config.NotifyRedisSubSys:    DefaultRedisKVS,
 		config.NotifyWebhookSubSys:  DefaultWebhookKVS,
 		config.NotifyESSubSys:       DefaultESKVS,
+		config.NotifySQSSubSys:      DefaultSQSKVS,
+		config.NotifySNSSubSys:      DefaultSNSKVS,
+		config.NotifyPulsarSubSys:   DefaultPulsarKVS,
 	}
 )
 
@@ -1364,6 +1442,10 @@ var (
 			Key:   target.RedisQueueLimit,
 			Value: "0",
 		},
+		config.KV{
+			Key:   target.RedisStreamMaxLen,
+			Value: "0",
+		},
 	}
 )
 
@@ -1416,14 +1498,23 @@ func GetNotifyRedis(redisKVS map[string]config.KVS) (map[string]target.RedisArgs
 		if k != config.Default {
 			queueDirEnv = queueDirEnv + config.Default + k
 		}
+		streamMaxLenEnv := target.EnvRedisStreamMaxLen
+		if k != config.Default {
+			streamMaxLenEnv = streamMaxLenEnv + config.Default + k
+		}
+		streamMaxLen, err := strconv.ParseUint(env.Get(streamMaxLenEnv, kv.Get(target.RedisStreamMaxLen)), 10, 64)
+		if err != nil {
+			return nil, err
+		}
 		redisArgs := target.RedisArgs{
-			Enable:     enabled,
-			Format:     env.Get(formatEnv, kv.Get(target.RedisFormat)),
-			Addr:       *addr,
-			Password:   env.Get(passwordEnv, kv.Get(target.RedisPassword)),
-			Key:        env.Get(keyEnv, kv.Get(target.RedisKey)),
-			QueueDir:   env.Get(queueDirEnv, kv.Get(target.RedisQueueDir)),
-			QueueLimit: uint64(queueLimit),
+			Enable:       enabled,
+			Format:       env.Get(formatEnv, kv.Get(target.RedisFormat)),
+			Addr:         *addr,
+			Password:     env.Get(passwordEnv, kv.Get(target.RedisPassword)),
+			Key:          env.Get(keyEnv, kv.Get(target.RedisKey)),
+			QueueDir:     env.Get(queueDirEnv, kv.Get(target.RedisQueueDir)),
+			QueueLimit:   uint64(queueLimit),
+			StreamMaxLen: streamMaxLen,
 		}
 		if err = redisArgs.Validate(); err != nil {
 			return nil, err
@@ -1813,3 +1904,330 @@ func GetNotifyAMQP(amqpKVS map[string]config.KVS) (map[string]target.AMQPArgs, e
 	}
 	return amqpTargets, nil
 }
+
+// DefaultSQSKVS - default KV for SQS config
+var (
+	DefaultSQSKVS = config.KVS{
+		config.KV{
+			Key:   config.Enable,
+			Value: config.EnableOff,
+		},
+		config.KV{
+			Key:   target.SQSQueueURL,
+			Value: "",
+		},
+		config.KV{
+			Key:   target.SQSRegion,
+			Value: "us-east-1",
+		},
+		config.KV{
+			Key:   target.SQSAccessKey,
+			Value: "",
+		},
+		config.KV{
+			Key:   target.SQSSecretKey,
+			Value: "",
+		},
+		config.KV{
+			Key:   target.SQSQueueDir,
+			Value: "",
+		},
+		config.KV{
+			Key:   target.SQSQueueLimit,
+			Value: "0",
+		},
+	}
+)
+
+// GetNotifySQS - returns a map of registered notification 'sqs' targets
+func GetNotifySQS(sqsKVS map[string]config.KVS, transport *http.Transport) (map[string]target.SQSArgs, error) {
+	sqsTargets := make(map[string]target.SQSArgs)
+	for k, kv := range mergeTargets(sqsKVS, target.EnvSQSEnable, DefaultSQSKVS) {
+		enableEnv := target.EnvSQSEnable
+		if k != config.Default {
+			enableEnv = enableEnv + config.Default + k
+		}
+		enabled, err := config.ParseBool(env.Get(enableEnv, kv.Get(config.Enable)))
+		if err != nil {
+			return nil, err
+		}
+		if !enabled {
+			continue
+		}
+		queueURLEnv := target.EnvSQSQueueURL
+		if k != config.Default {
+			queueURLEnv = queueURLEnv + config.Default + k
+		}
+		queueURL, err := xnet.ParseHTTPURL(env.Get(queueURLEnv, kv.Get(target.SQSQueueURL)))
+		if err != nil {
+			return nil, err
+		}
+		regionEnv := target.EnvSQSRegion
+		if k != config.Default {
+			regionEnv = regionEnv + config.Default + k
+		}
+		accessKeyEnv := target.EnvSQSAccessKey
+		if k != config.Default {
+			accessKeyEnv = accessKeyEnv + config.Default + k
+		}
+		secretKeyEnv := target.EnvSQSSecretKey
+		if k != config.Default {
+			secretKeyEnv = secretKeyEnv + config.Default + k
+		}
+		queueDirEnv := target.EnvSQSQueueDir
+		if k != config.Default {
+			queueDirEnv = queueDirEnv + config.Default + k
+		}
+		queueLimitEnv := target.EnvSQSQueueLimit
+		if k != config.Default {
+			queueLimitEnv = queueLimitEnv + config.Default + k
+		}
+		queueLimit, err := strconv.ParseUint(env.Get(queueLimitEnv, kv.Get(target.SQSQueueLimit)), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		sqsArgs := target.SQSArgs{
+			Enable:     enabled,
+			QueueURL:   *queueURL,
+			Region:     env.Get(regionEnv, kv.Get(target.SQSRegion)),
+			AccessKey:  env.Get(accessKeyEnv, kv.Get(target.SQSAccessKey)),
+			SecretKey:  env.Get(secretKeyEnv, kv.Get(target.SQSSecretKey)),
+			Transport:  transport,
+			QueueDir:   env.Get(queueDirEnv, kv.Get(target.SQSQueueDir)),
+			QueueLimit: queueLimit,
+		}
+		if err = sqsArgs.Validate(); err != nil {
+			return nil, err
+		}
+		sqsTargets[k] = sqsArgs
+	}
+	return sqsTargets, nil
+}
+
+// DefaultSNSKVS - default KV for SNS config
+var (
+	DefaultSNSKVS = config.KVS{
+		config.KV{
+			Key:   config.Enable,
+			Value: config.EnableOff,
+		},
+		config.KV{
+			Key:   target.SNSEndpoint,
+			Value: "",
+		},
+		config.KV{
+			Key:   target.SNSTopicARN,
+			Value: "",
+		},
+		config.KV{
+			Key:   target.SNSRegion,
+			Value: "us-east-1",
+		},
+		config.KV{
+			Key:   target.SNSAccessKey,
+			Value: "",
+		},
+		config.KV{
+			Key:   target.SNSSecretKey,
+			Value: "",
+		},
+		config.KV{
+			Key:   target.SNSQueueDir,
+			Value: "",
+		},
+		config.KV{
+			Key:   target.SNSQueueLimit,
+			Value: "0",
+		},
+	}
+)
+
+// GetNotifySNS - returns a map of registered notification 'sns' targets
+func GetNotifySNS(snsKVS map[string]config.KVS, transport *http.Transport) (map[string]target.SNSArgs, error) {
+	snsTargets := make(map[string]target.SNSArgs)
+	for k, kv := range mergeTargets(snsKVS, target.EnvSNSEnable, DefaultSNSKVS) {
+		enableEnv := target.EnvSNSEnable
+		if k != config.Default {
+			enableEnv = enableEnv + config.Default + k
+		}
+		enabled, err := config.ParseBool(env.Get(enableEnv, kv.Get(config.Enable)))
+		if err != nil {
+			return nil, err
+		}
+		if !enabled {
+			continue
+		}
+		endpointEnv := target.EnvSNSEndpoint
+		if k != config.Default {
+			endpointEnv = endpointEnv + config.Default + k
+		}
+		endpoint, err := xnet.ParseHTTPURL(env.Get(endpointEnv, kv.Get(target.SNSEndpoint)))
+		if err != nil {
+			return nil, err
+		}
+		topicARNEnv := target.EnvSNSTopicARN
+		if k != config.Default {
+			topicARNEnv = topicARNEnv + config.Default + k
+		}
+		regionEnv := target.EnvSNSRegion
+		if k != config.Default {
+			regionEnv = regionEnv + config.Default + k
+		}
+		accessKeyEnv := target.EnvSNSAccessKey
+		if k != config.Default {
+			accessKeyEnv = accessKeyEnv + config.Default + k
+		}
+		secretKeyEnv := target.EnvSNSSecretKey
+		if k != config.Default {
+			secretKeyEnv = secretKeyEnv + config.Default + k
+		}
+		queueDirEnv := target.EnvSNSQueueDir
+		if k != config.Default {
+			queueDirEnv = queueDirEnv + config.Default + k
+		}
+		queueLimitEnv := target.EnvSNSQueueLimit
+		if k != config.Default {
+			queueLimitEnv = queueLimitEnv + config.Default + k
+		}
+		queueLimit, err := strconv.ParseUint(env.Get(queueLimitEnv, kv.Get(target.SNSQueueLimit)), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		snsArgs := target.SNSArgs{
+			Enable:     enabled,
+			Endpoint:   *endpoint,
+			TopicARN:   env.Get(topicARNEnv, kv.Get(target.SNSTopicARN)),
+			Region:     env.Get(regionEnv, kv.Get(target.SNSRegion)),
+			AccessKey:  env.Get(accessKeyEnv, kv.Get(target.SNSAccessKey)),
+			SecretKey:  env.Get(secretKeyEnv, kv.Get(target.SNSSecretKey)),
+			Transport:  transport,
+			QueueDir:   env.Get(queueDirEnv, kv.Get(target.SNSQueueDir)),
+			QueueLimit: queueLimit,
+		}
+		if err = snsArgs.Validate(); err != nil {
+			return nil, err
+		}
+		snsTargets[k] = snsArgs
+	}
+	return snsTargets, nil
+}
+
+// DefaultPulsarKVS - default KV for Pulsar config
+var (
+	DefaultPulsarKVS = config.KVS{
+		config.KV{
+			Key:   config.Enable,
+			Value: config.EnableOff,
+		},
+		config.KV{
+			Key:   target.PulsarEndpoint,
+			Value: "",
+		},
+		config.KV{
+			Key:   target.PulsarTopic,
+			Value: "",
+		},
+		config.KV{
+			Key:   target.PulsarToken,
+			Value: "",
+		},
+		config.KV{
+			Key:   target.PulsarTLSSkipVerify,
+			Value: config.EnableOff,
+		},
+		config.KV{
+			Key:   target.PulsarClientCert,
+			Value: "",
+		},
+		config.KV{
+			Key:   target.PulsarClientKey,
+			Value: "",
+		},
+		config.KV{
+			Key:   target.PulsarQueueDir,
+			Value: "",
+		},
+		config.KV{
+			Key:   target.PulsarQueueLimit,
+			Value: "0",
+		},
+	}
+)
+
+// GetNotifyPulsar - returns a map of registered notification 'pulsar' targets
+func GetNotifyPulsar(pulsarKVS map[string]config.KVS, rootCAs *x509.CertPool) (map[string]target.PulsarArgs, error) {
+	pulsarTargets := make(map[string]target.PulsarArgs)
+	for k, kv := range mergeTargets(pulsarKVS, target.EnvPulsarEnable, DefaultPulsarKVS) {
+		enableEnv := target.EnvPulsarEnable
+		if k != config.Default {
+			enableEnv = enableEnv + config.Default + k
+		}
+		enabled, err := config.ParseBool(env.Get(enableEnv, kv.Get(config.Enable)))
+		if err != nil {
+			return nil, err
+		}
+		if !enabled {
+			continue
+		}
+		endpointEnv := target.EnvPulsarEndpoint
+		if k != config.Default {
+			endpointEnv = endpointEnv + config.Default + k
+		}
+		endpoint, err := xnet.ParseURL(env.Get(endpointEnv, kv.Get(target.PulsarEndpoint)))
+		if err != nil {
+			return nil, err
+		}
+		topicEnv := target.EnvPulsarTopic
+		if k != config.Default {
+			topicEnv = topicEnv + config.Default + k
+		}
+		tokenEnv := target.EnvPulsarToken
+		if k != config.Default {
+			tokenEnv = tokenEnv + config.Default + k
+		}
+		tlsSkipVerifyEnv := target.EnvPulsarTLSSkipVerify
+		if k != config.Default {
+			tlsSkipVerifyEnv = tlsSkipVerifyEnv + config.Default + k
+		}
+		clientCertEnv := target.EnvPulsarClientCert
+		if k != config.Default {
+			clientCertEnv = clientCertEnv + config.Default + k
+		}
+		clientKeyEnv := target.EnvPulsarClientKey
+		if k != config.Default {
+			clientKeyEnv = clientKeyEnv + config.Default + k
+		}
+		queueDirEnv := target.EnvPulsarQueueDir
+		if k != config.Default {
+			queueDirEnv = queueDirEnv + config.Default + k
+		}
+		queueLimitEnv := target.EnvPulsarQueueLimit
+		if k != config.Default {
+			queueLimitEnv = queueLimitEnv + config.Default + k
+		}
+		queueLimit, err := strconv.ParseUint(env.Get(queueLimitEnv, kv.Get(target.PulsarQueueLimit)), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		pulsarArgs := target.PulsarArgs{
+			Enable:        enabled,
+			Endpoint:      *endpoint,
+			Topic:         env.Get(topicEnv, kv.Get(target.PulsarTopic)),
+			Token:         env.Get(tokenEnv, kv.Get(target.PulsarToken)),
+			TLSSkipVerify: env.Get(tlsSkipVerifyEnv, kv.Get(target.PulsarTLSSkipVerify)) == config.EnableOn,
+			ClientCert:    env.Get(clientCertEnv, kv.Get(target.PulsarClientCert)),
+			ClientKey:     env.Get(clientKeyEnv, kv.Get(target.PulsarClientKey)),
+			RootCAs:       rootCAs,
+			QueueDir:      env.Get(queueDirEnv, kv.Get(target.PulsarQueueDir)),
+			QueueLimit:    queueLimit,
+		}
+		if err = pulsarArgs.Validate(); err != nil {
+			return nil, err
+		}
+		pulsarTargets[k] = pulsarArgs
+	}
+	return pulsarTargets, nil
+}
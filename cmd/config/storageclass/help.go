@@ -39,6 +39,30 @@ var (
 			Optional:    true,
 			Type:        "string",
 		},
+		config.HelpKV{
+			Key:         ClassCustom,
+			Description: `comma separated custom storage classes with their parity count e.g. "CRITICAL=EC:6,SCRATCH=EC:2"`,
+			Optional:    true,
+			Type:        "csv",
+		},
+		config.HelpKV{
+			Key:         ClassInlineThreshold,
+			Description: `comma separated storage classes with the shard size below which objects are inlined in xl.meta, defaults to "STANDARD=128KiB" e.g. "STANDARD=64KiB,CRITICAL=0"`,
+			Optional:    true,
+			Type:        "csv",
+		},
+		config.HelpKV{
+			Key:         ClassCodec,
+			Description: `comma separated storage classes with the erasure codec of new objects "rs", "rs-cauchy" or "lrc", defaults to "STANDARD=rs" e.g. "STANDARD=rs-cauchy,CRITICAL=lrc"`,
+			Optional:    true,
+			Type:        "csv",
+		},
+		config.HelpKV{
+			Key:         ClassBitrot,
+			Description: `comma separated storage classes with the bitrot algorithm of new objects "highwayhash", "blake3" or "xxh3", defaults to "STANDARD=highwayhash" e.g. "STANDARD=blake3,ARCHIVE=xxh3"`,
+			Optional:    true,
+			Type:        "csv",
+		},
 		config.HelpKV{
 			Key:         config.Comment,
 			Description: config.DefaultComment,
@@ -20,10 +20,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
 
+	"github.com/dustin/go-humanize"
+
 	"minio/cmd/config"
 	"minio/pkg/env"
 )
@@ -47,6 +50,11 @@ const (
 	ClassStandard = "standard"
 	ClassRRS      = "rrs"
 	ClassDMA      = "dma"
+	ClassCustom   = "custom"
+
+	ClassInlineThreshold = "inline_threshold"
+	ClassCodec           = "codec"
+	ClassBitrot          = "bitrot"
 
 	// Reduced redundancy storage class environment variable
 	RRSEnv = "MINIO_STORAGE_CLASS_RRS"
@@ -54,6 +62,14 @@ const (
 	StandardEnv = "MINIO_STORAGE_CLASS_STANDARD"
 	// DMA storage class environment variable
 	DMAEnv = "MINIO_STORAGE_CLASS_DMA"
+	// Custom storage classes environment variable
+	CustomEnv = "MINIO_STORAGE_CLASS_CUSTOM"
+	// Inline threshold environment variable
+	InlineThresholdEnv = "MINIO_STORAGE_CLASS_INLINE_THRESHOLD"
+	// Erasure codec environment variable
+	CodecEnv = "MINIO_STORAGE_CLASS_CODEC"
+	// Bitrot algorithm environment variable
+	BitrotEnv = "MINIO_STORAGE_CLASS_BITROT"
 
 	// Supported storage class scheme is EC
 	schemePrefix = "EC"
@@ -83,9 +99,56 @@ var (
 			Key:   ClassDMA,
 			Value: defaultDMA,
 		},
+		config.KV{
+			Key:   ClassCustom,
+			Value: "",
+		},
+		config.KV{
+			Key:   ClassInlineThreshold,
+			Value: "",
+		},
+		config.KV{
+			Key:   ClassCodec,
+			Value: "",
+		},
+		config.KV{
+			Key:   ClassBitrot,
+			Value: "",
+		},
 	}
 )
 
+// Supported erasure codecs of storage classes.
+const (
+	// Reed-Solomon with a Vandermonde matrix, the default.
+	CodecReedSolomon = "rs"
+	// Reed-Solomon with a Cauchy matrix.
+	CodecReedSolomonCauchy = "rs-cauchy"
+	// Locally repairable code, reads fewer shards to heal a drive.
+	CodecLRC = "lrc"
+)
+
+// Supported bitrot algorithms of storage classes.
+const (
+	// HighwayHash-256, the default.
+	BitrotHighwayHash = "highwayhash"
+	// BLAKE3-256, a cryptographic hash.
+	BitrotBLAKE3 = "blake3"
+	// XXH3-128, a faster non-cryptographic hash.
+	BitrotXXH3 = "xxh3"
+)
+
+// Names of custom storage classes are upper case
+// letters, digits and underscores, e.g. "CRITICAL".
+var customClassName = regexp.MustCompile(`^[A-Z][A-Z0-9_]{0,62}$`)
+
+// DefaultInlineThreshold - erasure coded shards of objects smaller
+// than the threshold are inlined in xl.meta unless configured.
+const DefaultInlineThreshold = 128 * humanize.KiByte
+
+// Inlined shards are read along with xl.meta, keep them small.
+const maxInlineThreshold = humanize.MiByte
+
 // StorageClass - holds storage class information
 type StorageClass struct {
 	Parity int
@@ -96,9 +159,14 @@ var ConfigLock = sync.RWMutex{}
 
 // Config storage class configuration
 type Config struct {
-	Standard StorageClass `json:"standard"`
-	RRS      StorageClass `json:"rrs"`
-	DMA      string       `json:"dma"`
+	Standard StorageClass            `json:"standard"`
+	RRS      StorageClass            `json:"rrs"`
+	DMA      string                  `json:"dma"`
+	Custom   map[string]StorageClass `json:"custom,omitempty"`
+
+	InlineThreshold map[string]int64  `json:"inline_threshold,omitempty"`
+	Codec           map[string]string `json:"codec,omitempty"`
+	Bitrot          map[string]string `json:"bitrot,omitempty"`
 }
 
 // UnmarshalJSON - Validate SS and RRS parity when unmarshalling JSON.
@@ -118,6 +186,18 @@ func IsValid(sc string) bool {
 	return sc == RRS || sc == STANDARD
 }
 
+// IsValid - returns true if input string is a valid storage
+// class kind supported or a configured custom storage class.
+func (sCfg *Config) IsValid(sc string) bool {
+	if IsValid(sc) {
+		return true
+	}
+	ConfigLock.RLock()
+	defer ConfigLock.RUnlock()
+	_, ok := sCfg.Custom[sc]
+	return ok
+}
+
 // UnmarshalText unmarshals storage class from its textual form into
 // storageClass structure.
 func (sc *StorageClass) UnmarshalText(b []byte) error {
@@ -177,6 +257,137 @@ func parseStorageClass(storageClassEnv string) (sc StorageClass, err error) {
 	}, nil
 }
 
+// Parses the custom storage classes of the form
+// "NAME=EC:N,NAME=EC:N" e.g. "CRITICAL=EC:6,SCRATCH=EC:2".
+func parseCustomStorageClasses(customEnv string) (map[string]StorageClass, error) {
+	custom := make(map[string]StorageClass)
+	for _, class := range strings.Split(customEnv, ",") {
+		class = strings.TrimSpace(class)
+		if class == "" {
+			continue
+		}
+		kv := strings.SplitN(class, "=", 2)
+		if len(kv) != 2 {
+			return nil, config.ErrStorageClassValue(nil).Msg("Missing parity of custom storage class " + class)
+		}
+		name := strings.TrimSpace(kv[0])
+		if !customClassName.MatchString(name) {
+			return nil, config.ErrStorageClassValue(nil).Msg("Invalid custom storage class name " + name +
+				", names consist of upper case letters, digits and underscores")
+		}
+		if IsValid(name) || name == DMA {
+			return nil, config.ErrStorageClassValue(nil).Msg("Custom storage class " + name + " is reserved")
+		}
+		if _, ok := custom[name]; ok {
+			return nil, config.ErrStorageClassValue(nil).Msg("Duplicate custom storage class " + name)
+		}
+		sc, err := parseStorageClass(strings.TrimSpace(kv[1]))
+		if err != nil {
+			return nil, err
+		}
+		custom[name] = sc
+	}
+	return custom, nil
+}
+
+// Parses the inline thresholds of the form "NAME=SIZE,NAME=SIZE"
+// e.g. "STANDARD=64KiB,CRITICAL=0", the threshold of STANDARD
+// applies to the storage classes without a threshold.
+func parseInlineThreshold(thresholdEnv string, custom map[string]StorageClass) (map[string]int64, error) {
+	thresholds := make(map[string]int64)
+	for _, threshold := range strings.Split(thresholdEnv, ",") {
+		threshold = strings.TrimSpace(threshold)
+		if threshold == "" {
+			continue
+		}
+		kv := strings.SplitN(threshold, "=", 2)
+		if len(kv) != 2 {
+			return nil, config.ErrStorageClassValue(nil).Msg("Missing inline threshold of storage class " + threshold)
+		}
+		name := strings.TrimSpace(kv[0])
+		if _, ok := custom[name]; !ok && !IsValid(name) {
+			return nil, config.ErrStorageClassValue(nil).Msg("Unknown storage class " + name + " in inline threshold")
+		}
+		if _, ok := thresholds[name]; ok {
+			return nil, config.ErrStorageClassValue(nil).Msg("Duplicate inline threshold of storage class " + name)
+		}
+		size, err := humanize.ParseBytes(strings.TrimSpace(kv[1]))
+		if err != nil {
+			return nil, config.ErrStorageClassValue(err).Msg("Invalid inline threshold of storage class " + name)
+		}
+		if size > maxInlineThreshold {
+			return nil, config.ErrStorageClassValue(nil).Msg(fmt.Sprintf("Inline threshold of storage class %s should be less than or equal to %s",
+				name, humanize.IBytes(maxInlineThreshold)))
+		}
+		thresholds[name] = int64(size)
+	}
+	return thresholds, nil
+}
+
+// Parses the erasure codecs of the form "NAME=CODEC,NAME=CODEC"
+// e.g. "STANDARD=rs-cauchy,CRITICAL=lrc", the codec of STANDARD
+// applies to the storage classes without a codec.
+func parseCodec(codecEnv string, custom map[string]StorageClass) (map[string]string, error) {
+	codecs := make(map[string]string)
+	for _, codec := range strings.Split(codecEnv, ",") {
+		codec = strings.TrimSpace(codec)
+		if codec == "" {
+			continue
+		}
+		kv := strings.SplitN(codec, "=", 2)
+		if len(kv) != 2 {
+			return nil, config.ErrStorageClassValue(nil).Msg("Missing erasure codec of storage class " + codec)
+		}
+		name := strings.TrimSpace(kv[0])
+		if _, ok := custom[name]; !ok && !IsValid(name) {
+			return nil, config.ErrStorageClassValue(nil).Msg("Unknown storage class " + name + " in erasure codec")
+		}
+		if _, ok := codecs[name]; ok {
+			return nil, config.ErrStorageClassValue(nil).Msg("Duplicate erasure codec of storage class " + name)
+		}
+		switch value := strings.TrimSpace(kv[1]); value {
+		case CodecReedSolomon, CodecReedSolomonCauchy, CodecLRC:
+			codecs[name] = value
+		default:
+			return nil, config.ErrStorageClassValue(nil).Msg(fmt.Sprintf("Unsupported erasure codec %s of storage class %s, supported codecs are %s, %s and %s",
+				value, name, CodecReedSolomon, CodecReedSolomonCauchy, CodecLRC))
+		}
+	}
+	return codecs, nil
+}
+
+// Parses the bitrot algorithms of the form "NAME=ALGO,NAME=ALGO"
+// e.g. "STANDARD=blake3,ARCHIVE=xxh3", the algorithm of STANDARD
+// applies to the storage classes without an algorithm.
+func parseBitrot(bitrotEnv string, custom map[string]StorageClass) (map[string]string, error) {
+	algos := make(map[string]string)
+	for _, algo := range strings.Split(bitrotEnv, ",") {
+		algo = strings.TrimSpace(algo)
+		if algo == "" {
+			continue
+		}
+		kv := strings.SplitN(algo, "=", 2)
+		if len(kv) != 2 {
+			return nil, config.ErrStorageClassValue(nil).Msg("Missing bitrot algorithm of storage class " + algo)
+		}
+		name := strings.TrimSpace(kv[0])
+		if _, ok := custom[name]; !ok && !IsValid(name) {
+			return nil, config.ErrStorageClassValue(nil).Msg("Unknown storage class " + name + " in bitrot algorithm")
+		}
+		if _, ok := algos[name]; ok {
+			return nil, config.ErrStorageClassValue(nil).Msg("Duplicate bitrot algorithm of storage class " + name)
+		}
+		switch value := strings.TrimSpace(kv[1]); value {
+		case BitrotHighwayHash, BitrotBLAKE3, BitrotXXH3:
+			algos[name] = value
+		default:
+			return nil, config.ErrStorageClassValue(nil).Msg(fmt.Sprintf("Unsupported bitrot algorithm %s of storage class %s, supported algorithms are %s, %s and %s",
+				value, name, BitrotHighwayHash, BitrotBLAKE3, BitrotXXH3))
+		}
+	}
+	return algos, nil
+}
+
 // ValidateParity validate standard storage class parity.
 func ValidateParity(ssParity, setDriveCount int) error {
 	// SS parity disks should be greater than or equal to minParityDisks.
@@ -224,6 +435,21 @@ func validateParity(ssParity, rrsParity, setDriveCount int) (err error) {
 	return nil
 }
 
+// Validates the parity disks of custom storage classes.
+func validateCustomParity(custom map[string]StorageClass, setDriveCount int) error {
+	for name, sc := range custom {
+		if sc.Parity < minParityDisks {
+			return fmt.Errorf("Custom storage class %s parity %d should be greater than or equal to %d",
+				name, sc.Parity, minParityDisks)
+		}
+		if sc.Parity > setDriveCount/2 {
+			return fmt.Errorf("Custom storage class %s parity %d should be less than or equal to %d",
+				name, sc.Parity, setDriveCount/2)
+		}
+	}
+	return nil
+}
+
 // GetParityForSC - Returns the data and parity drive count based on storage class
 // If storage class is set using the env vars MINIO_STORAGE_CLASS_RRS and
 // MINIO_STORAGE_CLASS_STANDARD or server config fields corresponding values are
@@ -238,10 +464,14 @@ func validateParity(ssParity, rrsParity, setDriveCount int) (err error) {
 //
 //	is returned, the caller is expected to choose the right parity
 //	at that point.
-func (sCfg Config) GetParityForSC(sc string) (parity int) {
+//
+// -- if input is a configured custom storage class its parity is
+//
+//	returned, unknown storage classes are treated as STANDARD.
+func (sCfg *Config) GetParityForSC(sc string) (parity int) {
 	ConfigLock.RLock()
 	defer ConfigLock.RUnlock()
-	switch strings.TrimSpace(sc) {
+	switch sc = strings.TrimSpace(sc); sc {
 	case RRS:
 		// set the rrs parity if available
 		if sCfg.RRS.Parity == 0 {
@@ -249,21 +479,91 @@ func (sCfg Config) GetParityForSC(sc string) (parity int) {
 		}
 		return sCfg.RRS.Parity
 	default:
+		if custom, ok := sCfg.Custom[sc]; ok {
+			return custom.Parity
+		}
 		return sCfg.Standard.Parity
 	}
 }
 
+// GetCustom - returns the parity of the custom storage classes.
+func (sCfg *Config) GetCustom() map[string]int {
+	ConfigLock.RLock()
+	defer ConfigLock.RUnlock()
+	custom := make(map[string]int, len(sCfg.Custom))
+	for name, sc := range sCfg.Custom {
+		custom[name] = sc.Parity
+	}
+	return custom
+}
+
+// GetInlineThreshold - returns the size below which the erasure
+// coded shards of objects of the storage class are inlined in
+// xl.meta, an empty storage class is STANDARD.
+func (sCfg *Config) GetInlineThreshold(sc string) int64 {
+	ConfigLock.RLock()
+	defer ConfigLock.RUnlock()
+	if sc = strings.TrimSpace(sc); sc == "" {
+		sc = STANDARD
+	}
+	if threshold, ok := sCfg.InlineThreshold[sc]; ok {
+		return threshold
+	}
+	if threshold, ok := sCfg.InlineThreshold[STANDARD]; ok {
+		return threshold
+	}
+	return DefaultInlineThreshold
+}
+
+// GetCodec - returns the erasure codec of new objects of the
+// storage class, an empty storage class is STANDARD.
+func (sCfg *Config) GetCodec(sc string) string {
+	ConfigLock.RLock()
+	defer ConfigLock.RUnlock()
+	if sc = strings.TrimSpace(sc); sc == "" {
+		sc = STANDARD
+	}
+	if codec, ok := sCfg.Codec[sc]; ok {
+		return codec
+	}
+	if codec, ok := sCfg.Codec[STANDARD]; ok {
+		return codec
+	}
+	return CodecReedSolomon
+}
+
+// GetBitrot - returns the bitrot algorithm of new objects of the
+// storage class, an empty storage class is STANDARD.
+func (sCfg *Config) GetBitrot(sc string) string {
+	ConfigLock.RLock()
+	defer ConfigLock.RUnlock()
+	if sc = strings.TrimSpace(sc); sc == "" {
+		sc = STANDARD
+	}
+	if algo, ok := sCfg.Bitrot[sc]; ok {
+		return algo
+	}
+	if algo, ok := sCfg.Bitrot[STANDARD]; ok {
+		return algo
+	}
+	return BitrotHighwayHash
+}
+
 // Update update storage-class with new config
-func (sCfg Config) Update(newCfg Config) {
+func (sCfg *Config) Update(newCfg Config) {
 	ConfigLock.Lock()
 	defer ConfigLock.Unlock()
 	sCfg.RRS = newCfg.RRS
 	sCfg.DMA = newCfg.DMA
 	sCfg.Standard = newCfg.Standard
+	sCfg.Custom = newCfg.Custom
+	sCfg.InlineThreshold = newCfg.InlineThreshold
+	sCfg.Codec = newCfg.Codec
+	sCfg.Bitrot = newCfg.Bitrot
 }
 
 // GetDMA - returns DMA configuration.
-func (sCfg Config) GetDMA() string {
+func (sCfg *Config) GetDMA() string {
 	ConfigLock.RLock()
 	defer ConfigLock.RUnlock()
 	return sCfg.DMA
@@ -273,7 +573,8 @@ func (sCfg Config) GetDMA() string {
 func Enabled(kvs config.KVS) bool {
 	ssc := kvs.Get(ClassStandard)
 	rrsc := kvs.Get(ClassRRS)
-	return ssc != "" || rrsc != ""
+	custom := kvs.Get(ClassCustom)
+	return ssc != "" || rrsc != "" || custom != ""
 }
 
 // LookupConfig - lookup storage class config and override with valid environment settings if any.
@@ -287,6 +588,10 @@ func LookupConfig(kvs config.KVS, setDriveCount int) (cfg Config, err error) {
 	ssc := env.Get(StandardEnv, kvs.Get(ClassStandard))
 	rrsc := env.Get(RRSEnv, kvs.Get(ClassRRS))
 	dma := env.Get(DMAEnv, kvs.Get(ClassDMA))
+	custom := env.Get(CustomEnv, kvs.Get(ClassCustom))
+	inlineThreshold := env.Get(InlineThresholdEnv, kvs.Get(ClassInlineThreshold))
+	codec := env.Get(CodecEnv, kvs.Get(ClassCodec))
+	bitrot := env.Get(BitrotEnv, kvs.Get(ClassBitrot))
 	// Check for environment variables and parse into storageClass struct
 	if ssc != "" {
 		cfg.Standard, err = parseStorageClass(ssc)
@@ -319,5 +624,36 @@ func LookupConfig(kvs config.KVS, setDriveCount int) (cfg Config, err error) {
 		return Config{}, err
 	}
 
+	if custom != "" {
+		cfg.Custom, err = parseCustomStorageClasses(custom)
+		if err != nil {
+			return Config{}, err
+		}
+		if err = validateCustomParity(cfg.Custom, setDriveCount); err != nil {
+			return Config{}, err
+		}
+	}
+
+	if inlineThreshold != "" {
+		cfg.InlineThreshold, err = parseInlineThreshold(inlineThreshold, cfg.Custom)
+		if err != nil {
+			return Config{}, err
+		}
+	}
+
+	if codec != "" {
+		cfg.Codec, err = parseCodec(codec, cfg.Custom)
+		if err != nil {
+			return Config{}, err
+		}
+	}
+
+	if bitrot != "" {
+		cfg.Bitrot, err = parseBitrot(bitrot, cfg.Custom)
+		if err != nil {
+			return Config{}, err
+		}
+	}
+
 	return cfg, nil
 }
@@ -20,6 +20,8 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+
+	"minio/cmd/config"
 )
 
 func TestParseStorageClass(t *testing.T) {
@@ -161,3 +163,215 @@ func TestIsValidStorageClassKind(t *testing.T) {
 		}
 	}
 }
+
+func TestParseCustomStorageClasses(t *testing.T) {
+	tests := []struct {
+		custom    string
+		want      map[string]StorageClass
+		expectErr bool
+	}{
+		{"CRITICAL=EC:6,SCRATCH=EC:2", map[string]StorageClass{"CRITICAL": {Parity: 6}, "SCRATCH": {Parity: 2}}, false},
+		{" CRITICAL = EC:6 , ", map[string]StorageClass{"CRITICAL": {Parity: 6}}, false},
+		{"CRITICAL", nil, true},
+		{"critical=EC:6", nil, true},
+		{"STANDARD=EC:6", nil, true},
+		{"CRITICAL=EC:6,CRITICAL=EC:4", nil, true},
+		{"CRITICAL=AB:6", nil, true},
+	}
+	for i, tt := range tests {
+		got, err := parseCustomStorageClasses(tt.custom)
+		if tt.expectErr != (err != nil) {
+			t.Fatalf("Test %d, expected error %t, got %v", i+1, tt.expectErr, err)
+		}
+		if err == nil && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Test %d, expected %v, got %v", i+1, tt.want, got)
+		}
+	}
+}
+
+func TestCustomStorageClass(t *testing.T) {
+	kvs := config.KVS{config.KV{Key: ClassCustom, Value: "CRITICAL=EC:6,SCRATCH=EC:2"}}
+	if _, err := LookupConfig(kvs, 8); err == nil {
+		t.Fatal("Expected parity above half of the drives to fail")
+	}
+
+	cfg, err := LookupConfig(kvs, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sCfg Config
+	sCfg.Update(cfg)
+
+	tests := []struct {
+		sc     string
+		valid  bool
+		parity int
+	}{
+		{"CRITICAL", true, 6},
+		{"SCRATCH", true, 2},
+		{STANDARD, true, 0},
+		{RRS, true, defaultRRSParity},
+		{"UNKNOWN", false, 0},
+	}
+	for i, tt := range tests {
+		if got := sCfg.IsValid(tt.sc); got != tt.valid {
+			t.Errorf("Test %d, expected valid %t, got %t", i+1, tt.valid, got)
+		}
+		if got := sCfg.GetParityForSC(tt.sc); got != tt.parity {
+			t.Errorf("Test %d, expected parity %d, got %d", i+1, tt.parity, got)
+		}
+	}
+}
+
+func TestInlineThreshold(t *testing.T) {
+	custom := config.KV{Key: ClassCustom, Value: "CRITICAL=EC:6"}
+	for _, threshold := range []string{
+		"STANDARD",
+		"STANDARD=2MiB",
+		"STANDARD=64KiB,STANDARD=32KiB",
+		"SCRATCH=64KiB",
+		"STANDARD=big",
+	} {
+		kvs := config.KVS{custom, config.KV{Key: ClassInlineThreshold, Value: threshold}}
+		if _, err := LookupConfig(kvs, 16); err == nil {
+			t.Errorf("Expected inline threshold %q to fail", threshold)
+		}
+	}
+
+	var sCfg Config
+	if got := sCfg.GetInlineThreshold(""); got != DefaultInlineThreshold {
+		t.Errorf("Expected default inline threshold %d, got %d", DefaultInlineThreshold, got)
+	}
+
+	kvs := config.KVS{custom, config.KV{Key: ClassInlineThreshold, Value: "STANDARD=64KiB, CRITICAL=0"}}
+	cfg, err := LookupConfig(kvs, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sCfg.Update(cfg)
+
+	tests := []struct {
+		sc        string
+		threshold int64
+	}{
+		{"", 64 << 10},
+		{STANDARD, 64 << 10},
+		{RRS, 64 << 10},
+		{"CRITICAL", 0},
+	}
+	for i, tt := range tests {
+		if got := sCfg.GetInlineThreshold(tt.sc); got != tt.threshold {
+			t.Errorf("Test %d, expected inline threshold %d, got %d", i+1, tt.threshold, got)
+		}
+	}
+}
+
+func TestCodec(t *testing.T) {
+	custom := config.KV{Key: ClassCustom, Value: "CRITICAL=EC:6"}
+	for _, codec := range []string{
+		"STANDARD",
+		"STANDARD=rs,STANDARD=lrc",
+		"SCRATCH=lrc",
+		"STANDARD=xor",
+	} {
+		kvs := config.KVS{custom, config.KV{Key: ClassCodec, Value: codec}}
+		if _, err := LookupConfig(kvs, 16); err == nil {
+			t.Errorf("Expected erasure codec %q to fail", codec)
+		}
+	}
+
+	var sCfg Config
+	if got := sCfg.GetCodec(""); got != CodecReedSolomon {
+		t.Errorf("Expected default erasure codec %s, got %s", CodecReedSolomon, got)
+	}
+
+	kvs := config.KVS{custom, config.KV{Key: ClassCodec, Value: "STANDARD=rs-cauchy, CRITICAL=lrc"}}
+	cfg, err := LookupConfig(kvs, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sCfg.Update(cfg)
+
+	tests := []struct {
+		sc    string
+		codec string
+	}{
+		{"", CodecReedSolomonCauchy},
+		{STANDARD, CodecReedSolomonCauchy},
+		{RRS, CodecReedSolomonCauchy},
+		{"CRITICAL", CodecLRC},
+	}
+	for i, tt := range tests {
+		if got := sCfg.GetCodec(tt.sc); got != tt.codec {
+			t.Errorf("Test %d, expected erasure codec %s, got %s", i+1, tt.codec, got)
+		}
+	}
+}
+
+func TestBitrot(t *testing.T) {
+	custom := config.KV{Key: ClassCustom, Value: "ARCHIVE=EC:6"}
+	for _, algo := range []string{
+		"STANDARD",
+		"STANDARD=blake3,STANDARD=xxh3",
+		"SCRATCH=xxh3",
+		"STANDARD=sha256",
+	} {
+		kvs := config.KVS{custom, config.KV{Key: ClassBitrot, Value: algo}}
+		if _, err := LookupConfig(kvs, 16); err == nil {
+			t.Errorf("Expected bitrot algorithm %q to fail", algo)
+		}
+	}
+
+	var sCfg Config
+	if got := sCfg.GetBitrot(""); got != BitrotHighwayHash {
+		t.Errorf("Expected default bitrot algorithm %s, got %s", BitrotHighwayHash, got)
+	}
+
+	kvs := config.KVS{custom, config.KV{Key: ClassBitrot, Value: "STANDARD=blake3, ARCHIVE=xxh3"}}
+	cfg, err := LookupConfig(kvs, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sCfg.Update(cfg)
+
+	tests := []struct {
+		sc   string
+		algo string
+	}{
+		{"", BitrotBLAKE3},
+		{STANDARD, BitrotBLAKE3},
+		{RRS, BitrotBLAKE3},
+		{"ARCHIVE", BitrotXXH3},
+	}
+	for i, tt := range tests {
+		if got := sCfg.GetBitrot(tt.sc); got != tt.algo {
+			t.Errorf("Test %d, expected bitrot algorithm %s, got %s", i+1, tt.algo, got)
+		}
+	}
+}
+
+// Run with -race, reading the config must not race its update.
+func TestConfigConcurrentUpdate(t *testing.T) {
+	var sCfg Config
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			sCfg.Update(Config{
+				Standard:        StorageClass{Parity: 2 + i%2},
+				Custom:          map[string]StorageClass{"ARCHIVE": {Parity: 4}},
+				InlineThreshold: map[string]int64{STANDARD: int64(i)},
+			})
+		}
+	}()
+
+	for i := 0; i < 1000; i++ {
+		sCfg.IsValid("ARCHIVE")
+		sCfg.GetParityForSC("ARCHIVE")
+		sCfg.GetInlineThreshold(STANDARD)
+		sCfg.GetCodec(STANDARD)
+		sCfg.GetBitrot(STANDARD)
+		sCfg.GetDMA()
+	}
+	<-done
+}
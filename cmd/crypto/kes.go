@@ -107,6 +107,13 @@ type kesService struct {
 	defaultKeyID string
 }
 
+var (
+	_ KMS             = (*kesService)(nil) // compiler check
+	_ kms.KeyLister   = (*kesService)(nil)
+	_ kms.KeyDeleter  = (*kesService)(nil)
+	_ kms.KeyImporter = (*kesService)(nil)
+)
+
 // NewKes returns a new kes KMS client. The returned KMS
 // uses the X.509 certificate to authenticate itself to
 // the kes server available at address.
@@ -168,6 +175,43 @@ func (kes *kesService) Stat() (kms.Status, error) {
 // CreateKey tries to create a new master key with the given keyID.
 func (kes *kesService) CreateKey(keyID string) error { return kes.client.CreateKey(keyID) }
 
+// ListKeys returns all keys whose name matches the pattern. KES
+// does not expose when a key has been created.
+func (kes *kesService) ListKeys(pattern string) ([]kms.KeyInfo, error) {
+	if pattern == "" {
+		pattern = "*"
+	}
+	names, err := kes.client.ListKeys(pattern)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]kms.KeyInfo, 0, len(names))
+	for _, name := range names {
+		keys = append(keys, kms.KeyInfo{KeyID: name})
+	}
+	return keys, nil
+}
+
+// DeleteKey deletes the master key with the given keyID.
+func (kes *kesService) DeleteKey(keyID string) error {
+	err := kes.client.DeleteKey(keyID)
+	if e, ok := err.(kesError); ok && e.Status() == http.StatusNotFound {
+		return kms.ErrKeyNotFound
+	}
+	return err
+}
+
+// ImportKey imports the key material as new master key with
+// the given keyID.
+func (kes *kesService) ImportKey(keyID string, key []byte) error {
+	if err := kes.client.ImportKey(keyID, key); err == ErrKESKeyExists {
+		return kms.ErrKeyExists
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
 // GenerateKey returns a new plaintext key, generated by the KMS,
 // and a sealed version of this plaintext key encrypted using the
 // named key referenced by keyID. It also binds the generated key
@@ -210,6 +254,9 @@ func (kes *kesService) DecryptKey(keyID string, ciphertext []byte, ctx Context)
 // kesClient implements the bare minimum functionality needed for
 // MinIO to talk to a KES server. In particular, it implements
 //   - CreateKey       (API: /v1/key/create/)
+//   - ImportKey       (API: /v1/key/import/)
+//   - DeleteKey       (API: /v1/key/delete/)
+//   - ListKeys        (API: /v1/key/list/)
 //   - GenerateDataKey (API: /v1/key/generate/)
 //   - DecryptDataKey  (API: /v1/key/decrypt/)
 type kesClient struct {
@@ -225,13 +272,62 @@ type kesClient struct {
 // any point in time.
 func (c *kesClient) CreateKey(name string) error {
 	path := fmt.Sprintf("/v1/key/create/%s", url.PathEscape(name))
-	_, err := c.postRetry(path, nil, 0) // No request body and no response expected
+	_, err := c.doRetry(http.MethodPost, path, nil, 0) // No request body and no response expected
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+// ImportKey imports the given cryptographic key as a new key
+// with the specified name.
+func (c *kesClient) ImportKey(name string, key []byte) error {
+	type Request struct {
+		Bytes []byte `json:"bytes"`
+	}
+	body, err := json.Marshal(Request{Bytes: key})
+	if err != nil {
+		return err
+	}
+	path := fmt.Sprintf("/v1/key/import/%s", url.PathEscape(name))
+	_, err = c.doRetry(http.MethodPost, path, bytes.NewReader(body), 0)
+	return err
+}
+
+// DeleteKey deletes the cryptographic key with the specified name.
+func (c *kesClient) DeleteKey(name string) error {
+	path := fmt.Sprintf("/v1/key/delete/%s", url.PathEscape(name))
+	_, err := c.doRetry(http.MethodDelete, path, nil, 0)
+	return err
+}
+
+// ListKeys returns the names of all cryptographic keys matching
+// the pattern. The KES server responds with a stream of JSON
+// objects, one per key.
+func (c *kesClient) ListKeys(pattern string) ([]string, error) {
+	type Response struct {
+		Name string `json:"name"`
+	}
+
+	const limit = 16 << 20 // Allows listing ~100k keys
+	path := fmt.Sprintf("/v1/key/list/%s", url.PathEscape(pattern))
+	resp, err := c.doRetry(http.MethodGet, path, nil, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for decoder := json.NewDecoder(resp); ; {
+		var response Response
+		if err = decoder.Decode(&response); err == io.EOF {
+			return names, nil
+		} else if err != nil {
+			return nil, err
+		}
+		names = append(names, response.Name)
+	}
+}
+
 // GenerateDataKey requests a new data key from the KES server.
 // On success, the KES server will respond with the plaintext key
 // and the ciphertext key as the plaintext key encrypted with
@@ -258,7 +354,7 @@ func (c *kesClient) GenerateDataKey(name string, context []byte) ([]byte, []byte
 
 	const limit = 1 << 20 // A plaintext/ciphertext key pair will never be larger than 1 MB
 	path := fmt.Sprintf("/v1/key/generate/%s", url.PathEscape(name))
-	resp, err := c.postRetry(path, bytes.NewReader(body), limit)
+	resp, err := c.doRetry(http.MethodPost, path, bytes.NewReader(body), limit)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -295,7 +391,7 @@ func (c *kesClient) DecryptDataKey(name string, ciphertext, context []byte) ([]b
 
 	const limit = 1 << 20 // A data key will never be larger than 1 MiB
 	path := fmt.Sprintf("/v1/key/decrypt/%s", url.PathEscape(name))
-	resp, err := c.postRetry(path, bytes.NewReader(body), limit)
+	resp, err := c.doRetry(http.MethodPost, path, bytes.NewReader(body), limit)
 	if err != nil {
 		return nil, err
 	}
@@ -366,11 +462,11 @@ func parseErrorResponse(resp *http.Response) error {
 	return NewKESError(resp.StatusCode, sb.String())
 }
 
-func (c *kesClient) post(url string, body io.Reader, limit int64) (io.Reader, error) {
+func (c *kesClient) do(method, url string, body io.Reader, limit int64) (io.Reader, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return nil, err
 	}
@@ -395,14 +491,14 @@ func (c *kesClient) post(url string, body io.Reader, limit int64) (io.Reader, er
 	return &respBody, nil
 }
 
-func (c *kesClient) postRetry(path string, body io.ReadSeeker, limit int64) (io.Reader, error) {
+func (c *kesClient) doRetry(method, path string, body io.ReadSeeker, limit int64) (io.Reader, error) {
 	retryMax := 1 + len(c.endpoints)
 	for i := 0; ; i++ {
 		if body != nil {
 			body.Seek(0, io.SeekStart) // seek to the beginning of the body.
 		}
 
-		response, err := c.post(c.endpoints[i%len(c.endpoints)]+path, body, limit)
+		response, err := c.do(method, c.endpoints[i%len(c.endpoints)]+path, body, limit)
 		if err == nil {
 			return response, nil
 		}
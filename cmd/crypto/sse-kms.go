@@ -191,6 +191,7 @@ func (ssekms) ParseMetadata(metadata map[string]string) (keyID string, kmsKey []
 			return keyID, kmsKey, sealedKey, ctx, Errorf("The internal sealed KMS data key for SSE-KMS is invalid")
 		}
 	}
+	ctx = Context{}
 	b64Ctx, ok := metadata[MetaContext]
 	if ok {
 		b, err := base64.StdEncoding.DecodeString(b64Ctx)
@@ -198,7 +199,7 @@ func (ssekms) ParseMetadata(metadata map[string]string) (keyID string, kmsKey []
 			return keyID, kmsKey, sealedKey, ctx, Errorf("The internal KMS context is not base64-encoded")
 		}
 		var json = jsoniter.ConfigCompatibleWithStandardLibrary
-		if err = json.Unmarshal(b, ctx); err != nil {
+		if err = json.Unmarshal(b, &ctx); err != nil {
 			return keyID, kmsKey, sealedKey, ctx, Errorf("The internal sealed KMS context is invalid")
 		}
 	}
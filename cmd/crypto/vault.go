@@ -0,0 +1,475 @@
+// MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	xhttp "minio/cmd/http"
+	"minio/pkg/kms"
+)
+
+// VaultAuth contains the credentials used by MinIO
+// to authenticate to a Vault server. Either a token
+// or an AppRole ID and secret must be specified.
+type VaultAuth struct {
+	// Token is a static Vault token.
+	Token string
+
+	// AppRoleID and AppRoleSecret are the
+	// credentials of the Vault AppRole used
+	// to obtain and renew a token.
+	AppRoleID     string
+	AppRoleSecret string
+}
+
+// VaultConfig contains the configuration required
+// to use the transit secrets engine of a Vault server
+// as KMS.
+type VaultConfig struct {
+	Enabled bool
+
+	// The Vault server endpoint.
+	Endpoint string
+
+	// The Vault namespace, if any. Namespaces
+	// are a Vault enterprise feature.
+	Namespace string
+
+	// The mount path of the transit
+	// secrets engine. Defaults to "transit".
+	Engine string
+
+	// The credentials used to authenticate
+	// to the Vault server.
+	Auth VaultAuth
+
+	// Path to a file or directory containing
+	// the CA certificate(s) that issued the
+	// TLS certificate of the Vault server.
+	CAPath string
+
+	// The default key ID returned by KMS.Stat().
+	DefaultKeyID string
+
+	// The HTTP transport configuration for
+	// the Vault client.
+	Transport *http.Transport
+}
+
+// Verify verifies if the vault configuration is correct
+func (v VaultConfig) Verify() (err error) {
+	switch {
+	case v.Endpoint == "":
+		err = Errorf("crypto: missing vault endpoint")
+	case v.Auth.Token == "" && v.Auth.AppRoleID == "":
+		err = Errorf("crypto: missing vault token or approle ID")
+	case v.Auth.Token != "" && v.Auth.AppRoleID != "":
+		err = Errorf("crypto: vault token and approle ID are mutually exclusive")
+	case v.Auth.AppRoleID != "" && v.Auth.AppRoleSecret == "":
+		err = Errorf("crypto: missing vault approle secret")
+	case v.DefaultKeyID == "":
+		err = Errorf("crypto: missing default key id")
+	}
+	return err
+}
+
+type vaultService struct {
+	config     VaultConfig
+	httpClient http.Client
+
+	lock        sync.Mutex
+	token       string
+	tokenExpiry time.Time // zero for static tokens
+}
+
+var (
+	_ KMS            = (*vaultService)(nil) // compiler check
+	_ kms.KeyRotator = (*vaultService)(nil)
+	_ kms.KeyLister  = (*vaultService)(nil)
+	_ kms.KeyDeleter = (*vaultService)(nil)
+)
+
+// NewVault returns a new Vault KMS client. The returned KMS
+// uses the transit secrets engine of the Vault server to
+// generate and decrypt data keys.
+func NewVault(cfg VaultConfig) (KMS, error) {
+	if err := cfg.Verify(); err != nil {
+		return nil, err
+	}
+	if cfg.Engine == "" {
+		cfg.Engine = "transit"
+	}
+	cfg.Endpoint = strings.TrimSuffix(cfg.Endpoint, "/")
+	cfg.Engine = strings.Trim(cfg.Engine, "/")
+
+	if cfg.CAPath != "" {
+		if cfg.Transport.TLSClientConfig == nil || cfg.Transport.TLSClientConfig.RootCAs == nil {
+			rootCAs, _ := x509.SystemCertPool()
+			if rootCAs == nil {
+				rootCAs = x509.NewCertPool()
+			}
+			if cfg.Transport.TLSClientConfig == nil {
+				cfg.Transport.TLSClientConfig = &tls.Config{}
+			}
+			cfg.Transport.TLSClientConfig.RootCAs = rootCAs
+		}
+		if err := loadCACertificates(cfg.CAPath, cfg.Transport.TLSClientConfig.RootCAs); err != nil {
+			return nil, err
+		}
+	}
+
+	return &vaultService{
+		config:     cfg,
+		httpClient: http.Client{Transport: cfg.Transport},
+		token:      cfg.Auth.Token,
+	}, nil
+}
+
+func (v *vaultService) Stat() (kms.Status, error) {
+	return kms.Status{
+		Name:       "Vault",
+		Endpoints:  []string{v.config.Endpoint},
+		DefaultKey: v.config.DefaultKeyID,
+	}, nil
+}
+
+// CreateKey creates a new AES-256-GCM transit key with the given
+// key ID. It returns kms.ErrKeyExists if the key already exists.
+func (v *vaultService) CreateKey(keyID string) error {
+	path := v.keyPath("keys", keyID)
+	if err := v.call(http.MethodGet, path, nil, nil); err == nil {
+		return kms.ErrKeyExists
+	} else if e, ok := err.(vaultError); !ok || e.Status() != http.StatusNotFound {
+		return err
+	}
+
+	type Request struct {
+		Type string `json:"type"`
+	}
+	return v.call(http.MethodPost, path, Request{Type: "aes256-gcm96"}, nil)
+}
+
+// RotateKey creates a new version of the transit key. Data keys
+// are encrypted with the latest version while previous versions
+// remain available for decryption.
+func (v *vaultService) RotateKey(keyID string) error {
+	return v.call(http.MethodPost, v.keyPath("keys", keyID)+"/rotate", nil, nil)
+}
+
+// ListKeys returns all transit keys whose name matches the pattern.
+// The transit engine only lists key names, so the versions of each
+// matching key are read separately.
+func (v *vaultService) ListKeys(pattern string) ([]kms.KeyInfo, error) {
+	type ListResponse struct {
+		Data struct {
+			Keys []string `json:"keys"`
+		} `json:"data"`
+	}
+	var list ListResponse
+	if err := v.call(http.MethodGet, "/v1/"+v.config.Engine+"/keys?list=true", nil, &list); err != nil {
+		if e, ok := err.(vaultError); ok && e.Status() == http.StatusNotFound {
+			return nil, nil // The engine returns 404 if there are no keys
+		}
+		return nil, err
+	}
+
+	type KeyResponse struct {
+		Data struct {
+			Keys map[string]interface{} `json:"keys"` // version -> creation time
+		} `json:"data"`
+	}
+	var keys []kms.KeyInfo
+	for _, name := range list.Data.Keys {
+		if pattern != "" {
+			if ok, err := path.Match(pattern, name); err != nil {
+				return nil, err
+			} else if !ok {
+				continue
+			}
+		}
+		var key KeyResponse
+		if err := v.call(http.MethodGet, v.keyPath("keys", name), nil, &key); err != nil {
+			return nil, err
+		}
+		info := kms.KeyInfo{KeyID: name, Versions: len(key.Data.Keys)}
+		if sec, ok := key.Data.Keys["1"].(float64); ok {
+			// Symmetric keys map versions to UNIX timestamps.
+			info.CreatedAt = time.Unix(int64(sec), 0).UTC()
+		}
+		keys = append(keys, info)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].KeyID < keys[j].KeyID })
+	return keys, nil
+}
+
+// DeleteKey deletes the transit key. The transit engine refuses to
+// delete keys unless deletion has been allowed in the key config.
+func (v *vaultService) DeleteKey(keyID string) error {
+	keyPath := v.keyPath("keys", keyID)
+	if err := v.call(http.MethodGet, keyPath, nil, nil); err != nil {
+		if e, ok := err.(vaultError); ok && e.Status() == http.StatusNotFound {
+			return kms.ErrKeyNotFound
+		}
+		return err
+	}
+
+	type Request struct {
+		DeletionAllowed bool `json:"deletion_allowed"`
+	}
+	if err := v.call(http.MethodPost, keyPath+"/config", Request{DeletionAllowed: true}, nil); err != nil {
+		return err
+	}
+	return v.call(http.MethodDelete, keyPath, nil, nil)
+}
+
+// vaultCiphertext is the ciphertext of a data key. The transit
+// engine does not bind a context to non-derived keys. Therefore,
+// the context is bound to the data key by a MAC keyed with the
+// plaintext data key.
+type vaultCiphertext struct {
+	Ciphertext string `json:"ciphertext"` // vault:v<version>:<base64>
+	MAC        []byte `json:"mac"`
+}
+
+// GenerateKey returns a new plaintext key, generated by the
+// transit engine, and a sealed version of this plaintext key
+// encrypted using the latest version of the named key referenced
+// by keyID. It also binds the generated key cryptographically to
+// the provided context.
+func (v *vaultService) GenerateKey(keyID string, ctx Context) (kms.DEK, error) {
+	if keyID == "" {
+		keyID = v.config.DefaultKeyID
+	}
+	context, err := ctx.MarshalText()
+	if err != nil {
+		return kms.DEK{}, err
+	}
+
+	type Response struct {
+		Data struct {
+			Plaintext  string `json:"plaintext"`
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}
+	var response Response
+	if err = v.call(http.MethodPost, v.keyPath("datakey/plaintext", keyID), struct{}{}, &response); err != nil {
+		return kms.DEK{}, err
+	}
+	plaintext, err := base64.StdEncoding.DecodeString(response.Data.Plaintext)
+	if err != nil {
+		return kms.DEK{}, err
+	}
+
+	ciphertext, err := json.Marshal(vaultCiphertext{
+		Ciphertext: response.Data.Ciphertext,
+		MAC:        vaultContextMAC(plaintext, context),
+	})
+	if err != nil {
+		return kms.DEK{}, err
+	}
+	return kms.DEK{
+		KeyID:      keyID,
+		Plaintext:  plaintext,
+		Ciphertext: ciphertext,
+	}, nil
+}
+
+// DecryptKey returns the decrypted sealedKey as plaintext key.
+// The key version used to encrypt the key is part of the
+// ciphertext.
+//
+// The context must be same context as the one provided while
+// generating the plaintext key / sealedKey.
+func (v *vaultService) DecryptKey(keyID string, ciphertext []byte, ctx Context) ([]byte, error) {
+	context, err := ctx.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	var sealedKey vaultCiphertext
+	if err = json.Unmarshal(ciphertext, &sealedKey); err != nil {
+		return nil, err
+	}
+
+	type Request struct {
+		Ciphertext string `json:"ciphertext"`
+	}
+	type Response struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+	var response Response
+	if err = v.call(http.MethodPost, v.keyPath("decrypt", keyID), Request{Ciphertext: sealedKey.Ciphertext}, &response); err != nil {
+		return nil, err
+	}
+	plaintext, err := base64.StdEncoding.DecodeString(response.Data.Plaintext)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(sealedKey.MAC, vaultContextMAC(plaintext, context)) {
+		return nil, Errorf("crypto: encrypted key is not authentic")
+	}
+	return plaintext, nil
+}
+
+func vaultContextMAC(key, context []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(context)
+	return mac.Sum(nil)
+}
+
+// vaultError is an error returned by the Vault server.
+type vaultError struct {
+	code    int
+	message string
+}
+
+// Status returns the HTTP status code of the error.
+func (e vaultError) Status() int { return e.code }
+
+func (e vaultError) Error() string { return e.message }
+
+func (v *vaultService) keyPath(api, keyID string) string {
+	return "/v1/" + v.config.Engine + "/" + api + "/" + url.PathEscape(keyID)
+}
+
+// login obtains a new token using the AppRole credentials if the
+// current token is about to expire. Must be called with the lock
+// held.
+func (v *vaultService) login() error {
+	if v.config.Auth.AppRoleID == "" {
+		return nil
+	}
+	if v.token != "" && time.Now().Before(v.tokenExpiry) {
+		return nil
+	}
+
+	type Request struct {
+		RoleID   string `json:"role_id"`
+		SecretID string `json:"secret_id"`
+	}
+	type Response struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int64  `json:"lease_duration"`
+		} `json:"auth"`
+	}
+	var response Response
+	err := v.do(http.MethodPost, "/v1/auth/approle/login", "", Request{
+		RoleID:   v.config.Auth.AppRoleID,
+		SecretID: v.config.Auth.AppRoleSecret,
+	}, &response)
+	if err != nil {
+		return err
+	}
+	if response.Auth.ClientToken == "" {
+		return Errorf("crypto: vault approle login returned no token")
+	}
+
+	// Renew the token once 80% of its lease has passed.
+	lease := time.Duration(response.Auth.LeaseDuration) * time.Second
+	v.token, v.tokenExpiry = response.Auth.ClientToken, time.Now().Add(lease*4/5)
+	return nil
+}
+
+// call sends an authenticated request to the Vault server. A
+// token obtained via AppRole is renewed once if rejected.
+func (v *vaultService) call(method, path string, request, response interface{}) error {
+	v.lock.Lock()
+	if err := v.login(); err != nil {
+		v.lock.Unlock()
+		return err
+	}
+	token := v.token
+	v.lock.Unlock()
+
+	err := v.do(method, path, token, request, response)
+	if e, ok := err.(vaultError); ok && e.Status() == http.StatusForbidden && v.config.Auth.AppRoleID != "" {
+		v.lock.Lock()
+		if v.token == token {
+			v.token = ""
+		}
+		if err = v.login(); err != nil {
+			v.lock.Unlock()
+			return err
+		}
+		token = v.token
+		v.lock.Unlock()
+		err = v.do(method, path, token, request, response)
+	}
+	return err
+}
+
+func (v *vaultService) do(method, path, token string, request, response interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var body io.Reader
+	if request != nil {
+		data, err := json.Marshal(request)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, v.config.Endpoint+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if v.config.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.config.Namespace)
+	}
+
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer xhttp.DrainBody(resp.Body)
+
+	const limit = 1 << 20 // A Vault response will never be larger than 1 MiB
+	if resp.StatusCode >= 300 {
+		type Response struct {
+			Errors []string `json:"errors"`
+		}
+		var errResp Response
+		if err = json.NewDecoder(io.LimitReader(resp.Body, limit)).Decode(&errResp); err == nil && len(errResp.Errors) > 0 {
+			return vaultError{code: resp.StatusCode, message: "vault: " + strings.Join(errResp.Errors, ", ")}
+		}
+		return vaultError{code: resp.StatusCode, message: "vault: " + resp.Status}
+	}
+	if response == nil {
+		return nil
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, limit)).Decode(response)
+}
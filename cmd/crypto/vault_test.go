@@ -0,0 +1,190 @@
+// MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"bytes"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"minio/pkg/kms"
+)
+
+// newFakeVault returns a server emulating the AppRole login and the
+// transit engine APIs used by the Vault KMS. The fake "encryption"
+// only prefixes the plaintext.
+func newFakeVault() *httptest.Server {
+	keys, deletable := map[string]bool{}, map[string]bool{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeError := func(code int, msg string) {
+			w.WriteHeader(code)
+			w.Write([]byte(`{"errors":["` + msg + `"]}`))
+		}
+		if r.URL.Path == "/v1/auth/approle/login" {
+			var req struct {
+				RoleID   string `json:"role_id"`
+				SecretID string `json:"secret_id"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.RoleID != "role" || req.SecretID != "secret" {
+				writeError(http.StatusBadRequest, "invalid role or secret ID")
+				return
+			}
+			w.Write([]byte(`{"auth":{"client_token":"approle-token","lease_duration":3600}}`))
+			return
+		}
+		if r.Header.Get("X-Vault-Token") != "approle-token" {
+			writeError(http.StatusForbidden, "permission denied")
+			return
+		}
+
+		path := strings.TrimPrefix(r.URL.Path, "/v1/transit/")
+		switch {
+		case path == "keys" && r.URL.Query().Get("list") == "true":
+			var names []string
+			for name := range keys {
+				names = append(names, `"`+name+`"`)
+			}
+			if len(names) == 0 {
+				writeError(http.StatusNotFound, "")
+				return
+			}
+			w.Write([]byte(`{"data":{"keys":[` + strings.Join(names, ",") + `]}}`))
+		case strings.HasPrefix(path, "keys/") && strings.HasSuffix(path, "/config"):
+			deletable[strings.TrimSuffix(strings.TrimPrefix(path, "keys/"), "/config")] = true
+			w.WriteHeader(http.StatusNoContent)
+		case strings.HasPrefix(path, "keys/"):
+			name := strings.TrimPrefix(path, "keys/")
+			switch r.Method {
+			case http.MethodGet:
+				if !keys[name] {
+					writeError(http.StatusNotFound, "")
+					return
+				}
+				w.Write([]byte(`{"data":{"keys":{"1":1600000000}}}`))
+				return
+			case http.MethodDelete:
+				if !deletable[name] {
+					writeError(http.StatusBadRequest, "deletion is not allowed for this key")
+					return
+				}
+				delete(keys, name)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			keys[name] = true
+			w.WriteHeader(http.StatusNoContent)
+		case strings.HasPrefix(path, "datakey/plaintext/"):
+			if !keys[strings.TrimPrefix(path, "datakey/plaintext/")] {
+				writeError(http.StatusBadRequest, "encryption key not found")
+				return
+			}
+			plaintext := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+			w.Write([]byte(`{"data":{"plaintext":"` + plaintext + `","ciphertext":"vault:v1:` + plaintext + `"}}`))
+		case strings.HasPrefix(path, "decrypt/"):
+			var req struct {
+				Ciphertext string `json:"ciphertext"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				writeError(http.StatusBadRequest, err.Error())
+				return
+			}
+			w.Write([]byte(`{"data":{"plaintext":"` + strings.TrimPrefix(req.Ciphertext, "vault:v1:") + `"}}`))
+		default:
+			writeError(http.StatusNotFound, "")
+		}
+	}))
+}
+
+func TestVaultKMS(t *testing.T) {
+	server := newFakeVault()
+	defer server.Close()
+
+	KMS, err := NewVault(VaultConfig{
+		Enabled:      true,
+		Endpoint:     server.URL,
+		Auth:         VaultAuth{AppRoleID: "role", AppRoleSecret: "secret"},
+		DefaultKeyID: "my-key",
+		Transport:    &http.Transport{},
+	})
+	if err != nil {
+		t.Fatalf("Failed to initialize KMS: %v", err)
+	}
+
+	if _, err = KMS.GenerateKey("", kms.Context{}); err == nil {
+		t.Fatal("Generated a key with a non-existing master key")
+	}
+	if err = KMS.CreateKey("my-key"); err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+	if err = KMS.CreateKey("my-key"); err != kms.ErrKeyExists {
+		t.Fatalf("Expected %v, got %v", kms.ErrKeyExists, err)
+	}
+
+	context := kms.Context{"bucket": "object"}
+	key, err := KMS.GenerateKey("", context)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	plaintext, err := KMS.DecryptKey(key.KeyID, key.Ciphertext, context)
+	if err != nil {
+		t.Fatalf("Failed to decrypt key: %v", err)
+	}
+	if !bytes.Equal(key.Plaintext, plaintext) {
+		t.Fatalf("Decrypted key does not match generated one: got %x - want %x", plaintext, key.Plaintext)
+	}
+	if _, err = KMS.DecryptKey(key.KeyID, key.Ciphertext, kms.Context{"bucket": "other"}); err == nil {
+		t.Fatal("Decrypted key with a different context")
+	}
+
+	if err = KMS.CreateKey("other-key"); err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+	keys, err := KMS.(kms.KeyLister).ListKeys("my-*")
+	if err != nil {
+		t.Fatalf("Failed to list keys: %v", err)
+	}
+	if len(keys) != 1 || keys[0].KeyID != "my-key" || keys[0].Versions != 1 || keys[0].CreatedAt.Unix() != 1600000000 {
+		t.Fatalf("Unexpected keys: %v", keys)
+	}
+	if err = KMS.(kms.KeyDeleter).DeleteKey("my-key"); err != nil {
+		t.Fatalf("Failed to delete key: %v", err)
+	}
+	if err = KMS.(kms.KeyDeleter).DeleteKey("my-key"); err != kms.ErrKeyNotFound {
+		t.Fatalf("Expected %v, got %v", kms.ErrKeyNotFound, err)
+	}
+}
+
+func TestVaultConfigVerify(t *testing.T) {
+	testCases := []struct {
+		config  VaultConfig
+		success bool
+	}{
+		{VaultConfig{Endpoint: "https://vault:8200", Auth: VaultAuth{Token: "token"}, DefaultKeyID: "key"}, true},
+		{VaultConfig{Endpoint: "https://vault:8200", Auth: VaultAuth{AppRoleID: "role", AppRoleSecret: "secret"}, DefaultKeyID: "key"}, true},
+		{VaultConfig{Auth: VaultAuth{Token: "token"}, DefaultKeyID: "key"}, false},
+		{VaultConfig{Endpoint: "https://vault:8200", DefaultKeyID: "key"}, false},
+		{VaultConfig{Endpoint: "https://vault:8200", Auth: VaultAuth{Token: "token", AppRoleID: "role", AppRoleSecret: "secret"}, DefaultKeyID: "key"}, false},
+		{VaultConfig{Endpoint: "https://vault:8200", Auth: VaultAuth{AppRoleID: "role"}, DefaultKeyID: "key"}, false},
+		{VaultConfig{Endpoint: "https://vault:8200", Auth: VaultAuth{Token: "token"}}, false},
+	}
+	for i, testCase := range testCases {
+		if err := testCase.config.Verify(); (err == nil) != testCase.success {
+			t.Errorf("Test %d: expected success %v, got %v", i, testCase.success, err)
+		}
+	}
+}
@@ -800,6 +800,25 @@ type sizeSummary struct {
 	replicaSize    int64
 	pendingCount   uint64
 	failedCount    uint64
+	inlineCount    uint64
+	inlineSize     int64
+	partsCount     uint64
+	partsSize      int64
+}
+
+// addDataLayout accounts the object version as inlined in xl.meta
+// or stored in part files, remote and deleted versions are skipped.
+func (s *sizeSummary) addDataLayout(fi FileInfo, size int64) {
+	if fi.Deleted || fi.TransitionStatus != "" {
+		return
+	}
+	if fi.InlineData() || fi.Size == 0 {
+		s.inlineCount++
+		s.inlineSize += size
+		return
+	}
+	s.partsCount++
+	s.partsSize += size
 }
 
 type getSizeFn func(item scannerItem) (sizeSummary, error)
@@ -997,7 +1016,7 @@ func evalActionFromLifecycle(ctx context.Context, lc lifecycle.Lifecycle, obj Ob
 func applyTransitionAction(ctx context.Context, action lifecycle.Action, objLayer ObjectLayer, obj ObjectInfo) bool {
 	opts := ObjectOptions{}
 	if obj.TransitionStatus == "" {
-		opts.Versioned = globalBucketVersioningSys.Enabled(obj.Bucket)
+		opts.Versioned = globalBucketVersioningSys.PrefixEnabled(obj.Bucket, obj.Name)
 		opts.VersionID = obj.VersionID
 		opts.TransitionStatus = lifecycle.TransitionPending
 		if _, err := objLayer.DeleteObject(ctx, obj.Bucket, obj.Name, opts); err != nil {
@@ -1047,7 +1066,7 @@ func applyExpiryOnNonTransitionedObjects(ctx context.Context, objLayer ObjectLay
 		opts.VersionID = obj.VersionID
 	}
 	if opts.VersionID == "" {
-		opts.Versioned = globalBucketVersioningSys.Enabled(obj.Bucket)
+		opts.Versioned = globalBucketVersioningSys.PrefixEnabled(obj.Bucket, obj.Name)
 	}
 
 	obj, err := objLayer.DeleteObject(ctx, obj.Bucket, obj.Name, opts)
@@ -53,6 +53,17 @@ type dataUsageEntry struct {
 	Objects          uint64
 	ObjSizes         sizeHistogram
 	ReplicationStats replicationStats
+	InlineStats      inlineStats
+}
+
+//msgp:tuple inlineStats
+type inlineStats struct {
+	// Object versions with data inlined in xl.meta.
+	InlineCount uint64
+	InlineSize  uint64
+	// Object versions with data in part files.
+	PartsCount uint64
+	PartsSize  uint64
 }
 
 //msgp:tuple replicationStats
@@ -91,7 +102,17 @@ type dataUsageEntryV3 struct {
 	Children               dataUsageHashMap
 }
 
-// dataUsageCache contains a cache of data usage entries latest version 4.
+//msgp:tuple dataUsageEntryV4
+type dataUsageEntryV4 struct {
+	Children dataUsageHashMap
+	// These fields do no include any children.
+	Size             int64
+	Objects          uint64
+	ObjSizes         sizeHistogram
+	ReplicationStats replicationStats
+}
+
+// dataUsageCache contains a cache of data usage entries latest version 5.
 type dataUsageCache struct {
 	Info  dataUsageCacheInfo
 	Cache map[string]dataUsageEntry
@@ -112,6 +133,13 @@ type dataUsageCacheV3 struct {
 	Cache map[string]dataUsageEntryV3
 }
 
+// dataUsageCache contains a cache of data usage entries version 4.
+type dataUsageCacheV4 struct {
+	Info  dataUsageCacheInfo
+	Cache map[string]dataUsageEntryV4
+	Disks []string
+}
+
 //msgp:ignore dataUsageEntryInfo
 type dataUsageEntryInfo struct {
 	Name   string
@@ -139,7 +167,10 @@ func (e *dataUsageEntry) addSizes(summary sizeSummary) {
 	e.ReplicationStats.ReplicaSize += uint64(summary.replicaSize)
 	e.ReplicationStats.PendingCount += uint64(summary.pendingCount)
 	e.ReplicationStats.FailedCount += uint64(summary.failedCount)
-
+	e.InlineStats.InlineCount += summary.inlineCount
+	e.InlineStats.InlineSize += uint64(summary.inlineSize)
+	e.InlineStats.PartsCount += summary.partsCount
+	e.InlineStats.PartsSize += uint64(summary.partsSize)
 }
 
 // merge other data usage entry into this, excluding children.
@@ -152,6 +183,10 @@ func (e *dataUsageEntry) merge(other dataUsageEntry) {
 	e.ReplicationStats.ReplicaSize += other.ReplicationStats.ReplicaSize
 	e.ReplicationStats.PendingCount += other.ReplicationStats.PendingCount
 	e.ReplicationStats.FailedCount += other.ReplicationStats.FailedCount
+	e.InlineStats.InlineCount += other.InlineStats.InlineCount
+	e.InlineStats.InlineSize += other.InlineStats.InlineSize
+	e.InlineStats.PartsCount += other.InlineStats.PartsCount
+	e.InlineStats.PartsSize += other.InlineStats.PartsSize
 
 	for i, v := range other.ObjSizes[:] {
 		e.ObjSizes[i] += v
@@ -295,6 +330,10 @@ func (d *dataUsageCache) dui(path string, buckets []BucketInfo) madmin.DataUsage
 		ReplicaSize:             flat.ReplicationStats.ReplicaSize,
 		ReplicationPendingCount: flat.ReplicationStats.PendingCount,
 		ReplicationFailedCount:  flat.ReplicationStats.FailedCount,
+		InlineCount:             flat.InlineStats.InlineCount,
+		InlineSize:              flat.InlineStats.InlineSize,
+		PartsCount:              flat.InlineStats.PartsCount,
+		PartsSize:               flat.InlineStats.PartsSize,
 		BucketsCount:            uint64(len(e.Children)),
 		BucketsUsage:            d.bucketsUsageInfo(buckets),
 	}
@@ -430,6 +469,10 @@ func (d *dataUsageCache) bucketsUsageInfo(buckets []BucketInfo) map[string]madmi
 			ReplicationPendingCount: flat.ReplicationStats.PendingCount,
 			ReplicationFailedCount:  flat.ReplicationStats.FailedCount,
 			ReplicaSize:             flat.ReplicationStats.ReplicaSize,
+			InlineCount:             flat.InlineStats.InlineCount,
+			InlineSize:              flat.InlineStats.InlineSize,
+			PartsCount:              flat.InlineStats.PartsCount,
+			PartsSize:               flat.InlineStats.PartsSize,
 			ObjectSizesHistogram:    flat.ObjSizes.toMap(),
 		}
 	}
@@ -453,6 +496,10 @@ func (d *dataUsageCache) bucketUsageInfo(bucket string) madmin.BucketUsageInfo {
 		ReplicationFailedSize:   flat.ReplicationStats.FailedSize,
 		ReplicationFailedCount:  flat.ReplicationStats.FailedCount,
 		ReplicaSize:             flat.ReplicationStats.ReplicaSize,
+		InlineCount:             flat.InlineStats.InlineCount,
+		InlineSize:              flat.InlineStats.InlineSize,
+		PartsCount:              flat.InlineStats.PartsCount,
+		PartsSize:               flat.InlineStats.PartsSize,
 		ObjectSizesHistogram:    flat.ObjSizes.toMap(),
 	}
 }
@@ -577,6 +624,7 @@ func (d *dataUsageCache) save(ctx context.Context, store objectIO, name string)
 // Bumping the cache version will drop data from previous versions
 // and write new data with the new version.
 const (
+	dataUsageCacheVerV5 = 5
 	dataUsageCacheVerV4 = 4
 	dataUsageCacheVerV3 = 3
 	dataUsageCacheVerV2 = 2
@@ -586,7 +634,7 @@ const (
 // serialize the contents of the cache.
 func (d *dataUsageCache) serializeTo(dst io.Writer) error {
 	// Add version and compress.
-	_, err := dst.Write([]byte{dataUsageCacheVerV4})
+	_, err := dst.Write([]byte{dataUsageCacheVerV5})
 	if err != nil {
 		return err
 	}
@@ -683,6 +731,30 @@ func (d *dataUsageCache) deserialize(r io.Reader) error {
 			return err
 		}
 		defer dec.Close()
+		dold := &dataUsageCacheV4{}
+		if err = dold.DecodeMsg(msgp.NewReader(dec)); err != nil {
+			return err
+		}
+		d.Info = dold.Info
+		d.Disks = dold.Disks
+		d.Cache = make(map[string]dataUsageEntry, len(dold.Cache))
+		for k, v := range dold.Cache {
+			d.Cache[k] = dataUsageEntry{
+				Children:         v.Children,
+				Size:             v.Size,
+				Objects:          v.Objects,
+				ObjSizes:         v.ObjSizes,
+				ReplicationStats: v.ReplicationStats,
+			}
+		}
+		return nil
+	case dataUsageCacheVerV5:
+		// Zstd compressed.
+		dec, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(2))
+		if err != nil {
+			return err
+		}
+		defer dec.Close()
 
 		return d.DecodeMsg(msgp.NewReader(dec))
 	}
@@ -0,0 +1,278 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"minio/cmd/config/heal"
+	"minio/cmd/logger"
+	"minio/cmd/logger/message/audit"
+	"minio/pkg/smart"
+)
+
+const (
+	// Interval to check if the SMART attributes of local drives are due
+	// for a poll, the poll interval itself is configurable.
+	defaultMonitorSmartInterval = time.Minute
+)
+
+// SMART attributes with alert thresholds.
+const (
+	smartAttrTemperature        = "temperature"
+	smartAttrReallocatedSectors = "reallocated_sectors"
+	smartAttrMediaErrors        = "media_errors"
+	smartAttrWearLevel          = "wear_level"
+)
+
+// driveSmartAlert is a SMART attribute of a drive above its threshold.
+type driveSmartAlert struct {
+	Attribute string
+	Value     float64
+	Threshold float64
+}
+
+// driveSmart is the last polled SMART health of a local drive.
+type driveSmart struct {
+	Drive  string
+	Health smart.Health
+	Alerts []driveSmartAlert
+}
+
+// driveSmartState holds the SMART health of the local drives.
+type driveSmartState struct {
+	mu       sync.RWMutex
+	drives   map[string]driveSmart
+	lastPoll time.Time
+}
+
+var globalDriveSmart = &driveSmartState{drives: make(map[string]driveSmart)}
+
+// list returns the SMART health of the local drives sorted by drive.
+func (s *driveSmartState) list() []driveSmart {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	drives := make([]driveSmart, 0, len(s.drives))
+	for _, d := range s.drives {
+		drives = append(drives, d)
+	}
+	sort.Slice(drives, func(i, j int) bool {
+		return drives[i].Drive < drives[j].Drive
+	})
+	return drives
+}
+
+// update stores the SMART health of a drive and returns the
+// alerts raised and cleared since the previous poll.
+func (s *driveSmartState) update(d driveSmart) (raised, cleared []driveSmartAlert) {
+	s.mu.Lock()
+	prev := s.drives[d.Drive]
+	s.drives[d.Drive] = d
+	s.mu.Unlock()
+
+	active := make(map[string]bool, len(prev.Alerts))
+	for _, a := range prev.Alerts {
+		active[a.Attribute] = true
+	}
+	for _, a := range d.Alerts {
+		if !active[a.Attribute] {
+			raised = append(raised, a)
+		}
+		delete(active, a.Attribute)
+	}
+	for _, a := range prev.Alerts {
+		if active[a.Attribute] {
+			cleared = append(cleared, a)
+		}
+	}
+	return raised, cleared
+}
+
+var (
+	smartNvmePartition = regexp.MustCompile(`^(nvme\d+n\d+)p\d+$`)
+	smartDiskPartition = regexp.MustCompile(`^((?:sd|hd|vd|xvd)[a-z]+)\d+$`)
+)
+
+// smartDevice returns the whole device of a partition,
+// SMART attributes are only reported for whole devices.
+func smartDevice(partition string) string {
+	dir, name := filepath.Split(partition)
+	for _, re := range []*regexp.Regexp{smartNvmePartition, smartDiskPartition} {
+		if m := re.FindStringSubmatch(name); m != nil {
+			return dir + m[1]
+		}
+	}
+	return partition
+}
+
+// smartMount is a mounted block device.
+type smartMount struct {
+	Device     string
+	Mountpoint string
+}
+
+// smartDevicesForMounts maps each drive path to the whole device of
+// the mount with the longest mountpoint containing the path.
+func smartDevicesForMounts(paths []string, mounts []smartMount) map[string]string {
+	devices := make(map[string]string, len(paths))
+	for _, path := range paths {
+		var best smartMount
+		for _, m := range mounts {
+			if !strings.HasPrefix(m.Device, "/dev/") || strings.Contains(m.Device, "loop") {
+				continue
+			}
+			if !pathIsMountpointOf(m.Mountpoint, path) || len(m.Mountpoint) <= len(best.Mountpoint) {
+				continue
+			}
+			best = m
+		}
+		if best.Device != "" {
+			devices[path] = smartDevice(best.Device)
+		}
+	}
+	return devices
+}
+
+// pathIsMountpointOf returns if path is on the mountpoint.
+func pathIsMountpointOf(mountpoint, path string) bool {
+	if mountpoint == "/" || mountpoint == path {
+		return true
+	}
+	return strings.HasPrefix(path, strings.TrimSuffix(mountpoint, "/")+"/")
+}
+
+// smartAlerts returns the SMART attributes above their thresholds.
+func smartAlerts(h smart.Health, cfg heal.Config) (alerts []driveSmartAlert) {
+	if h.Temperature != nil && *h.Temperature > cfg.SmartMaxTemperature {
+		alerts = append(alerts, driveSmartAlert{smartAttrTemperature, float64(*h.Temperature), float64(cfg.SmartMaxTemperature)})
+	}
+	if h.ReallocatedSectors != nil && *h.ReallocatedSectors > cfg.SmartMaxReallocatedSectors {
+		alerts = append(alerts, driveSmartAlert{smartAttrReallocatedSectors, float64(*h.ReallocatedSectors), float64(cfg.SmartMaxReallocatedSectors)})
+	}
+	if h.MediaErrors != nil && *h.MediaErrors > cfg.SmartMaxMediaErrors {
+		alerts = append(alerts, driveSmartAlert{smartAttrMediaErrors, float64(*h.MediaErrors), float64(cfg.SmartMaxMediaErrors)})
+	}
+	if h.WearLevel != nil && *h.WearLevel > cfg.SmartMaxWearLevel {
+		alerts = append(alerts, driveSmartAlert{smartAttrWearLevel, float64(*h.WearLevel), float64(cfg.SmartMaxWearLevel)})
+	}
+	return alerts
+}
+
+// auditLogDriveSmartAlert sends an audit entry for a raised drive alert.
+func auditLogDriveSmartAlert(ctx context.Context, drive, device string, a driveSmartAlert) {
+	entry := audit.NewEntry(globalDeploymentID)
+	entry.Trigger = "internal-drive-smart"
+	entry.API.Name = "DriveSMARTAlert"
+	entry.Tags = map[string]interface{}{
+		"drive":     drive,
+		"device":    device,
+		"attribute": a.Attribute,
+		"value":     a.Value,
+		"threshold": a.Threshold,
+	}
+	ctx = logger.SetAuditEntry(ctx, &entry)
+	logger.AuditLog(ctx, nil, nil, nil)
+}
+
+// localDrivePaths returns the paths of the local drives.
+func localDrivePaths() (paths []string) {
+	for _, ep := range globalEndpoints {
+		for _, endpoint := range ep.Endpoints {
+			if endpoint.IsLocal {
+				paths = append(paths, endpoint.Path)
+			}
+		}
+	}
+	return paths
+}
+
+// pollLocalDrivesSmart polls the SMART attributes of the local drives
+// and raises an alert for every attribute crossing its threshold.
+func pollLocalDrivesSmart(ctx context.Context, cfg heal.Config) {
+	paths := localDrivePaths()
+	devices, err := smartDevices(ctx, paths)
+	if err != nil {
+		logger.LogOnceIf(ctx, fmt.Errorf("unable to find the devices of local drives: %w", err), "drive-smart-devices")
+		return
+	}
+
+	for _, path := range paths {
+		device, ok := devices[path]
+		if !ok {
+			continue
+		}
+		health, err := readSmartHealth(device)
+		if err != nil {
+			logger.LogOnceIf(ctx, fmt.Errorf("unable to read SMART attributes of drive %s (%s): %w", path, device, err), "drive-smart-"+path)
+			continue
+		}
+
+		raised, cleared := globalDriveSmart.update(driveSmart{
+			Drive:  path,
+			Health: health,
+			Alerts: smartAlerts(health, cfg),
+		})
+		for _, a := range raised {
+			logger.LogIf(ctx, fmt.Errorf("drive %s (%s) %s is %v, above the threshold of %v",
+				path, device, strings.Replace(a.Attribute, "_", " ", -1), a.Value, a.Threshold))
+			auditLogDriveSmartAlert(ctx, path, device, a)
+		}
+		for _, a := range cleared {
+			logger.Info(fmt.Sprintf("drive %s (%s) %s is back below the threshold",
+				path, device, strings.Replace(a.Attribute, "_", " ", -1)))
+		}
+	}
+}
+
+// monitorLocalDrivesSmart - periodically polls the SMART attributes
+// of the local drives, a poll interval of 0 disables polling.
+func monitorLocalDrivesSmart(ctx context.Context) {
+	smartTimer := time.NewTimer(defaultMonitorSmartInterval)
+	defer smartTimer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-smartTimer.C:
+			globalHealConfigMu.Lock()
+			cfg := globalHealConfig
+			globalHealConfigMu.Unlock()
+
+			globalDriveSmart.mu.RLock()
+			lastPoll := globalDriveSmart.lastPoll
+			globalDriveSmart.mu.RUnlock()
+
+			if cfg.SmartInterval > 0 && time.Since(lastPoll) >= cfg.SmartInterval {
+				pollLocalDrivesSmart(ctx, cfg)
+
+				globalDriveSmart.mu.Lock()
+				globalDriveSmart.lastPoll = time.Now()
+				globalDriveSmart.mu.Unlock()
+			}
+			smartTimer.Reset(defaultMonitorSmartInterval)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+//go:build !linux
+// +build !linux
+
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+
+	"minio/pkg/smart"
+)
+
+// SMART attributes are only read on Linux.
+func smartDevices(ctx context.Context, paths []string) (map[string]string, error) {
+	return nil, nil
+}
+
+func readSmartHealth(device string) (smart.Health, error) {
+	return smart.Health{Device: device}, fmt.Errorf("unsupported platform: %s", runtime.GOOS)
+}
@@ -0,0 +1,118 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"minio/cmd/config/heal"
+	"minio/pkg/smart"
+)
+
+func TestSmartDevice(t *testing.T) {
+	testCases := map[string]string{
+		"/dev/nvme0n1p1": "/dev/nvme0n1",
+		"/dev/nvme0n1":   "/dev/nvme0n1",
+		"/dev/sda1":      "/dev/sda",
+		"/dev/sdab12":    "/dev/sdab",
+		"/dev/xvdb2":     "/dev/xvdb",
+		"/dev/sdb":       "/dev/sdb",
+		"/dev/md0":       "/dev/md0",
+	}
+	for partition, want := range testCases {
+		if got := smartDevice(partition); got != want {
+			t.Errorf("%s: expected %s, got %s", partition, want, got)
+		}
+	}
+}
+
+func TestSmartDevicesForMounts(t *testing.T) {
+	mounts := []smartMount{
+		{Device: "/dev/sda2", Mountpoint: "/"},
+		{Device: "/dev/nvme0n1p1", Mountpoint: "/mnt/disk1"},
+		{Device: "/dev/sdb", Mountpoint: "/mnt/disk10"},
+		{Device: "/dev/loop0", Mountpoint: "/mnt/disk2"},
+		{Device: "tmpfs", Mountpoint: "/mnt/disk3"},
+	}
+	got := smartDevicesForMounts([]string{"/mnt/disk1/data", "/mnt/disk10", "/mnt/disk2", "/mnt/disk3"}, mounts)
+	want := map[string]string{
+		"/mnt/disk1/data": "/dev/nvme0n1",
+		"/mnt/disk10":     "/dev/sdb",
+		"/mnt/disk2":      "/dev/sda",
+		"/mnt/disk3":      "/dev/sda",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestSmartAlerts(t *testing.T) {
+	cfg := heal.Config{
+		SmartMaxTemperature:        70,
+		SmartMaxReallocatedSectors: 50,
+		SmartMaxMediaErrors:        0,
+		SmartMaxWearLevel:          90,
+	}
+	temperature, reallocated, mediaErrors, wearLevel := int64(70), uint64(51), uint64(0), uint64(95)
+	h := smart.Health{
+		Temperature:        &temperature,
+		ReallocatedSectors: &reallocated,
+		MediaErrors:        &mediaErrors,
+		WearLevel:          &wearLevel,
+	}
+	want := []driveSmartAlert{
+		{smartAttrReallocatedSectors, 51, 50},
+		{smartAttrWearLevel, 95, 90},
+	}
+	if got := smartAlerts(h, cfg); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	// Attributes not reported by a drive raise no alerts.
+	if got := smartAlerts(smart.Health{}, cfg); len(got) != 0 {
+		t.Errorf("expected no alerts, got %v", got)
+	}
+}
+
+func TestDriveSmartStateUpdate(t *testing.T) {
+	s := &driveSmartState{drives: make(map[string]driveSmart)}
+	temperature := driveSmartAlert{smartAttrTemperature, 75, 70}
+	mediaErrors := driveSmartAlert{smartAttrMediaErrors, 1, 0}
+
+	raised, cleared := s.update(driveSmart{Drive: "/mnt/disk1", Alerts: []driveSmartAlert{temperature}})
+	if !reflect.DeepEqual(raised, []driveSmartAlert{temperature}) || len(cleared) != 0 {
+		t.Fatalf("expected the temperature alert to be raised, got %v and %v", raised, cleared)
+	}
+
+	// Alerts still active are not raised again.
+	raised, cleared = s.update(driveSmart{Drive: "/mnt/disk1", Alerts: []driveSmartAlert{temperature, mediaErrors}})
+	if !reflect.DeepEqual(raised, []driveSmartAlert{mediaErrors}) || len(cleared) != 0 {
+		t.Fatalf("expected only the media errors alert to be raised, got %v and %v", raised, cleared)
+	}
+
+	raised, cleared = s.update(driveSmart{Drive: "/mnt/disk1", Alerts: []driveSmartAlert{mediaErrors}})
+	if len(raised) != 0 || !reflect.DeepEqual(cleared, []driveSmartAlert{temperature}) {
+		t.Fatalf("expected the temperature alert to be cleared, got %v and %v", raised, cleared)
+	}
+
+	s.update(driveSmart{Drive: "/mnt/disk0"})
+	drives := s.list()
+	if len(drives) != 2 || drives[0].Drive != "/mnt/disk0" || drives[1].Drive != "/mnt/disk1" {
+		t.Fatalf("unexpected drives %v", drives)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"crypto/subtle"
+	"encoding/base64"
 	"encoding/binary"
 	"encoding/hex"
 	"errors"
@@ -37,6 +38,7 @@ import (
 	xhttp "minio/cmd/http"
 	"minio/cmd/logger"
 	"minio/pkg/fips"
+	iampolicy "minio/pkg/iam/policy"
 )
 
 var (
@@ -44,6 +46,10 @@ var (
 	errEncryptedObject      = errors.New("The object was stored using a form of SSE")
 	errInvalidSSEParameters = errors.New("The SSE-C key for key-rotation is not correct") // special access denied
 	errKMSNotConfigured     = errors.New("KMS not configured for a server side encrypted object")
+	// error returned when an object key is not sealed by the KMS
+	errKMSObjectNotEncrypted = errors.New("The object is not encrypted with a KMS master key")
+	// error returned when the requester may not use an SSE-KMS master key
+	errKMSKeyAccessDenied = errors.New("Access to the KMS master key is denied")
 	// Additional MinIO errors for SSE-C requests.
 	errObjectTampered = errors.New("The requested object was modified and may be compromised")
 	// error returned when invalid encryption parameters are specified
@@ -91,7 +97,7 @@ func isEncryptedMultipart(objInfo ObjectInfo) bool {
 // ParseSSECopyCustomerRequest parses the SSE-C header fields of the provided request.
 // It returns the client provided key on success.
 func ParseSSECopyCustomerRequest(h http.Header, metadata map[string]string) (key []byte, err error) {
-	if crypto.S3.IsEncrypted(metadata) && crypto.SSECopy.IsRequested(h) {
+	if (crypto.S3.IsEncrypted(metadata) || crypto.S3KMS.IsEncrypted(metadata)) && crypto.SSECopy.IsRequested(h) {
 		return nil, crypto.ErrIncompatibleEncryptionMethod
 	}
 	k, err := crypto.SSECopy.ParseHTTP(h)
@@ -167,9 +173,81 @@ func rotateKey(oldKey []byte, newKey []byte, bucket, object string, metadata map
 	}
 }
 
-func newEncryptMetadata(key []byte, bucket, object string, metadata map[string]string, sseS3 bool) (crypto.ObjectKey, error) {
+// rewrapObjectKey re-seals the object key of an SSE-S3 or SSE-KMS
+// object with a new data key generated by the KMS from the current
+// version of the master key the object was encrypted with. The object
+// key itself, and therefore the object data, does not change.
+func rewrapObjectKey(bucket, object string, metadata map[string]string) error {
+	if GlobalKMS == nil {
+		return errKMSNotConfigured
+	}
+
+	var (
+		keyID     string
+		kmsKey    []byte
+		sealedKey crypto.SealedKey
+		kmsCtx    crypto.Context
+		sseType   crypto.Type
+		err       error
+	)
+	switch {
+	case crypto.S3.IsEncrypted(metadata):
+		sseType = crypto.S3
+		keyID, kmsKey, sealedKey, err = crypto.S3.ParseMetadata(metadata)
+		kmsCtx = crypto.Context{bucket: path.Join(bucket, object)}
+	case crypto.S3KMS.IsEncrypted(metadata):
+		sseType = crypto.S3KMS
+		keyID, kmsKey, sealedKey, kmsCtx, err = crypto.S3KMS.ParseMetadata(metadata)
+		if kmsCtx == nil {
+			kmsCtx = crypto.Context{}
+		}
+		if _, ok := kmsCtx[bucket]; !ok {
+			kmsCtx[bucket] = path.Join(bucket, object)
+		}
+	default:
+		return errKMSObjectNotEncrypted
+	}
+	if err != nil {
+		return err
+	}
+	if keyID == "" {
+		// Sealed with a static master key, not the KMS.
+		return errKMSObjectNotEncrypted
+	}
+
+	oldKey, err := GlobalKMS.DecryptKey(keyID, kmsKey, kmsCtx)
+	if err != nil {
+		return err
+	}
+	var objectKey crypto.ObjectKey
+	if err = objectKey.Unseal(oldKey, sealedKey, sseType.String(), bucket, object); err != nil {
+		return err
+	}
+
+	newKey, err := GlobalKMS.GenerateKey(keyID, kmsCtx)
+	if err != nil {
+		return err
+	}
+	sealedKey = objectKey.Seal(newKey.Plaintext, crypto.GenerateIV(rand.Reader), sseType.String(), bucket, object)
+	if sseType == crypto.S3 {
+		crypto.S3.CreateMetadata(metadata, newKey.KeyID, newKey.Ciphertext, sealedKey)
+	} else {
+		crypto.S3KMS.CreateMetadata(metadata, newKey.KeyID, newKey.Ciphertext, sealedKey)
+	}
+	return nil
+}
+
+func newEncryptMetadata(kind crypto.Type, keyID string, key []byte, bucket, object string, metadata map[string]string, kmsCtx crypto.Context) (crypto.ObjectKey, error) {
+	return sealObjectKey(nil, kind, keyID, key, bucket, object, metadata, kmsCtx)
+}
+
+// sealObjectKey seals the object key with the SSE-C client key, the
+// SSE-S3 or the SSE-KMS master key and adds the sealed key to metadata.
+// A new object key is generated if objectKey is nil.
+func sealObjectKey(objectKey *crypto.ObjectKey, kind crypto.Type, keyID string, key []byte, bucket, object string, metadata map[string]string, kmsCtx crypto.Context) (crypto.ObjectKey, error) {
 	var sealedKey crypto.SealedKey
-	if sseS3 {
+	switch kind {
+	case crypto.S3:
 		if GlobalKMS == nil {
 			return crypto.ObjectKey{}, errKMSNotConfigured
 		}
@@ -178,19 +256,90 @@ func newEncryptMetadata(key []byte, bucket, object string, metadata map[string]s
 			return crypto.ObjectKey{}, err
 		}
 
-		objectKey := crypto.GenerateKey(key.Plaintext, rand.Reader)
+		if objectKey == nil {
+			k := crypto.GenerateKey(key.Plaintext, rand.Reader)
+			objectKey = &k
+		}
 		sealedKey = objectKey.Seal(key.Plaintext, crypto.GenerateIV(rand.Reader), crypto.S3.String(), bucket, object)
 		crypto.S3.CreateMetadata(metadata, key.KeyID, key.Ciphertext, sealedKey)
-		return objectKey, nil
+		return *objectKey, nil
+	case crypto.S3KMS:
+		if GlobalKMS == nil {
+			return crypto.ObjectKey{}, errKMSNotConfigured
+		}
+
+		// The bucket/object pair is always part of the KMS context
+		// but only a client provided context has to be stored.
+		ctx := crypto.Context{}
+		for k, v := range kmsCtx {
+			ctx[k] = v
+		}
+		if _, ok := ctx[bucket]; !ok {
+			ctx[bucket] = path.Join(bucket, object)
+		}
+		key, err := GlobalKMS.GenerateKey(keyID, ctx)
+		if err != nil {
+			return crypto.ObjectKey{}, err
+		}
+
+		if objectKey == nil {
+			k := crypto.GenerateKey(key.Plaintext, rand.Reader)
+			objectKey = &k
+		}
+		sealedKey = objectKey.Seal(key.Plaintext, crypto.GenerateIV(rand.Reader), crypto.S3KMS.String(), bucket, object)
+		crypto.S3KMS.CreateMetadata(metadata, key.KeyID, key.Ciphertext, sealedKey)
+		if len(kmsCtx) > 0 {
+			b, err := kmsCtx.MarshalText()
+			if err != nil {
+				return crypto.ObjectKey{}, err
+			}
+			metadata[crypto.MetaContext] = base64.StdEncoding.EncodeToString(b)
+		}
+		return *objectKey, nil
+	}
+	if objectKey == nil {
+		k := crypto.GenerateKey(key, rand.Reader)
+		objectKey = &k
 	}
-	objectKey := crypto.GenerateKey(key, rand.Reader)
 	sealedKey = objectKey.Seal(key, crypto.GenerateIV(rand.Reader), crypto.SSEC.String(), bucket, object)
 	crypto.SSEC.CreateMetadata(metadata, sealedKey)
-	return objectKey, nil
+	return *objectKey, nil
+}
+
+// resealObjectKey unseals the object key of an encrypted object and
+// seals it again with the SSE-C client key, the SSE-S3 or the SSE-KMS
+// master key requested for it, replacing all sealed keys in metadata.
+// The object key, and therefore the object data and its parts, does not
+// change such that an object can be moved between SSE-C keys, SSE-S3 and
+// SSE-KMS by only updating its metadata. oldKey is the SSE-C client key
+// of an SSE-C encrypted object.
+func resealObjectKey(oldKey []byte, kind crypto.Type, keyID string, newKey []byte, kmsCtx crypto.Context, bucket, object string, metadata map[string]string) error {
+	if kind == crypto.SSEC && crypto.SSEC.IsEncrypted(metadata) {
+		return rotateKey(oldKey, newKey, bucket, object, metadata)
+	}
+
+	key, err := decryptObjectInfo(oldKey, bucket, object, metadata)
+	if err != nil {
+		if crypto.SSEC.IsEncrypted(metadata) {
+			return crypto.ErrInvalidCustomerKey
+		}
+		return err
+	}
+	var objectKey crypto.ObjectKey
+	copy(objectKey[:], key)
+
+	multipart := crypto.IsMultiPart(metadata)
+	crypto.RemoveInternalEntries(metadata)
+	delete(metadata, crypto.MetaContext)
+	if multipart {
+		metadata[crypto.MetaMultipart] = ""
+	}
+	_, err = sealObjectKey(&objectKey, kind, keyID, newKey, bucket, object, metadata, kmsCtx)
+	return err
 }
 
-func newEncryptReader(content io.Reader, key []byte, bucket, object string, metadata map[string]string, sseS3 bool) (io.Reader, crypto.ObjectKey, error) {
-	objectEncryptionKey, err := newEncryptMetadata(key, bucket, object, metadata, sseS3)
+func newEncryptReader(content io.Reader, kind crypto.Type, keyID string, key []byte, bucket, object string, metadata map[string]string, kmsCtx crypto.Context) (io.Reader, crypto.ObjectKey, error) {
+	objectEncryptionKey, err := newEncryptMetadata(kind, keyID, key, bucket, object, metadata, kmsCtx)
 	if err != nil {
 		return nil, crypto.ObjectKey{}, err
 	}
@@ -203,19 +352,59 @@ func newEncryptReader(content io.Reader, key []byte, bucket, object string, meta
 	return reader, objectEncryptionKey, nil
 }
 
+// parseEncryptionRequest returns the kind of server-side encryption
+// requested by the HTTP headers along with the SSE-C client key or
+// the SSE-KMS key ID and context.
+func parseEncryptionRequest(h http.Header) (kind crypto.Type, keyID string, key []byte, kmsCtx crypto.Context, err error) {
+	switch {
+	case crypto.SSEC.IsRequested(h):
+		if crypto.S3.IsRequested(h) || crypto.S3KMS.IsRequested(h) {
+			return nil, "", nil, nil, crypto.ErrIncompatibleEncryptionMethod
+		}
+		key, err = ParseSSECustomerHeader(h)
+		return crypto.SSEC, "", key, nil, err
+	case crypto.S3.IsRequested(h):
+		return crypto.S3, "", nil, nil, nil
+	case crypto.S3KMS.IsRequested(h):
+		keyID, kmsCtx, err = crypto.S3KMS.ParseHTTP(h)
+		return crypto.S3KMS, keyID, nil, kmsCtx, err
+	}
+	return nil, "", nil, nil, nil
+}
+
+// checkKMSKeyAccess returns errKMSKeyAccessDenied if the requester is not
+// allowed to perform action with the SSE-KMS master key keyID. An empty key
+// ID refers to the default key of the KMS.
+func checkKMSKeyAccess(r *http.Request, action iampolicy.Action, bucket, object, keyID string) error {
+	if GlobalKMS == nil {
+		return errKMSNotConfigured
+	}
+	if keyID == "" {
+		stat, err := GlobalKMS.Stat()
+		if err != nil {
+			return err
+		}
+		keyID = stat.DefaultKey
+	}
+	if isKMSActionAllowed(r, action, bucket, object, keyID) != ErrNone {
+		return errKMSKeyAccessDenied
+	}
+	return nil
+}
+
 // set new encryption metadata from http request headers for SSE-C and generated key from KMS in the case of
-// SSE-S3
+// SSE-S3 and SSE-KMS
 func setEncryptionMetadata(r *http.Request, bucket, object string, metadata map[string]string) (err error) {
-	var (
-		key []byte
-	)
-	if crypto.SSEC.IsRequested(r.Header) {
-		key, err = ParseSSECustomerRequest(r)
-		if err != nil {
-			return
+	kind, keyID, key, kmsCtx, err := parseEncryptionRequest(r.Header)
+	if err != nil {
+		return err
+	}
+	if kind == crypto.S3KMS {
+		if err = checkKMSKeyAccess(r, iampolicy.KMSGenerateKeyAction, bucket, object, keyID); err != nil {
+			return err
 		}
 	}
-	_, err = newEncryptMetadata(key, bucket, object, metadata, crypto.S3.IsRequested(r.Header))
+	_, err = newEncryptMetadata(kind, keyID, key, bucket, object, metadata, kmsCtx)
 	return
 }
 
@@ -223,24 +412,21 @@ func setEncryptionMetadata(r *http.Request, bucket, object string, metadata map[
 // with the client provided key. It also marks the object as client-side-encrypted
 // and sets the correct headers.
 func EncryptRequest(content io.Reader, r *http.Request, bucket, object string, metadata map[string]string) (io.Reader, crypto.ObjectKey, error) {
-	if crypto.S3.IsRequested(r.Header) && crypto.SSEC.IsRequested(r.Header) {
-		return nil, crypto.ObjectKey{}, crypto.ErrIncompatibleEncryptionMethod
+	kind, keyID, key, kmsCtx, err := parseEncryptionRequest(r.Header)
+	if err != nil {
+		return nil, crypto.ObjectKey{}, err
+	}
+	if kind == crypto.S3KMS {
+		if err = checkKMSKeyAccess(r, iampolicy.KMSGenerateKeyAction, bucket, object, keyID); err != nil {
+			return nil, crypto.ObjectKey{}, err
+		}
 	}
 	if r.ContentLength > encryptBufferThreshold {
 		// The encryption reads in blocks of 64KB.
 		// We add a buffer on bigger files to reduce the number of syscalls upstream.
 		content = bufio.NewReaderSize(content, encryptBufferSize)
 	}
-
-	var key []byte
-	if crypto.SSEC.IsRequested(r.Header) {
-		var err error
-		key, err = ParseSSECustomerRequest(r)
-		if err != nil {
-			return nil, crypto.ObjectKey{}, err
-		}
-	}
-	return newEncryptReader(content, key, bucket, object, metadata, crypto.S3.IsRequested(r.Header))
+	return newEncryptReader(content, kind, keyID, key, bucket, object, metadata, kmsCtx)
 }
 
 func decryptObjectInfo(key []byte, bucket, object string, metadata map[string]string) ([]byte, error) {
@@ -287,7 +473,7 @@ func decryptObjectInfo(key []byte, bucket, object string, metadata map[string]st
 // DecryptRequestWithSequenceNumberR - same as
 // DecryptRequestWithSequenceNumber but with a reader
 func DecryptRequestWithSequenceNumberR(client io.Reader, h http.Header, bucket, object string, seqNumber uint32, metadata map[string]string) (io.Reader, error) {
-	if crypto.S3.IsEncrypted(metadata) {
+	if crypto.S3.IsEncrypted(metadata) || crypto.S3KMS.IsEncrypted(metadata) {
 		return newDecryptReader(client, nil, bucket, object, seqNumber, metadata)
 	}
 
@@ -354,26 +540,38 @@ func DecryptBlocksRequestR(inputReader io.Reader, h http.Header, seqNumber uint3
 		return reader, nil
 	}
 
+	// The object key is unsealed once, the keys of all parts
+	// are derived from it.
+	var (
+		key []byte
+		err error
+	)
+	if crypto.SSEC.IsEncrypted(oi.UserDefined) {
+		if copySource {
+			key, err = ParseSSECopyCustomerRequest(h, oi.UserDefined)
+		} else {
+			key, err = ParseSSECustomerHeader(h)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	objectEncryptionKey, err := decryptObjectInfo(key, bucket, object, oi.UserDefined)
+	if err != nil {
+		return nil, err
+	}
+
 	partDecRelOffset := int64(seqNumber) * SSEDAREPackageBlockSize
 	partEncRelOffset := int64(seqNumber) * (SSEDAREPackageBlockSize + SSEDAREPackageMetaSize)
 
 	w := &DecryptBlocksReader{
-		reader:            inputReader,
-		startSeqNum:       seqNumber,
-		partDecRelOffset:  partDecRelOffset,
-		partEncRelOffset:  partEncRelOffset,
-		parts:             oi.Parts,
-		partIndex:         partStart,
-		header:            h,
-		bucket:            bucket,
-		object:            object,
-		customerKeyHeader: h.Get(xhttp.AmzServerSideEncryptionCustomerKey),
-		copySource:        copySource,
-		metadata:          cloneMSS(oi.UserDefined),
-	}
-
-	if w.copySource {
-		w.customerKeyHeader = h.Get(xhttp.AmzServerSideEncryptionCopyCustomerKey)
+		reader:              inputReader,
+		startSeqNum:         seqNumber,
+		partDecRelOffset:    partDecRelOffset,
+		partEncRelOffset:    partEncRelOffset,
+		parts:               oi.Parts,
+		partIndex:           partStart,
+		objectEncryptionKey: objectEncryptionKey,
 	}
 
 	if err := w.buildDecrypter(w.parts[w.partIndex].Number); err != nil {
@@ -395,48 +593,18 @@ type DecryptBlocksReader struct {
 	// Current part index
 	partIndex int
 	// Parts information
-	parts          []ObjectPartInfo
-	header         http.Header
-	bucket, object string
-	metadata       map[string]string
+	parts []ObjectPartInfo
+	// Object key the part keys are derived from
+	objectEncryptionKey []byte
 
 	partDecRelOffset, partEncRelOffset int64
-
-	copySource bool
-	// Customer Key
-	customerKeyHeader string
 }
 
 func (d *DecryptBlocksReader) buildDecrypter(partID int) error {
-	m := cloneMSS(d.metadata)
-	// Initialize the first decrypter; new decrypters will be
-	// initialized in Read() operation as needed.
-	var key []byte
-	var err error
-	if d.copySource {
-		if crypto.SSEC.IsEncrypted(d.metadata) {
-			d.header.Set(xhttp.AmzServerSideEncryptionCopyCustomerKey, d.customerKeyHeader)
-			key, err = ParseSSECopyCustomerRequest(d.header, d.metadata)
-		}
-	} else {
-		if crypto.SSEC.IsEncrypted(d.metadata) {
-			d.header.Set(xhttp.AmzServerSideEncryptionCustomerKey, d.customerKeyHeader)
-			key, err = ParseSSECustomerHeader(d.header)
-		}
-	}
-	if err != nil {
-		return err
-	}
-
-	objectEncryptionKey, err := decryptObjectInfo(key, d.bucket, d.object, m)
-	if err != nil {
-		return err
-	}
-
 	var partIDbin [4]byte
 	binary.LittleEndian.PutUint32(partIDbin[:], uint32(partID)) // marshal part ID
 
-	mac := hmac.New(sha256.New, objectEncryptionKey) // derive part encryption key from part ID and object key
+	mac := hmac.New(sha256.New, d.objectEncryptionKey) // derive part encryption key from part ID and object key
 	mac.Write(partIDbin[:])
 	partEncryptionKey := mac.Sum(nil)
 
@@ -774,7 +942,7 @@ func DecryptObjectInfo(info *ObjectInfo, r *http.Request) (encrypted bool, err e
 	// disallow X-Amz-Server-Side-Encryption header on HEAD and GET
 	switch r.Method {
 	case http.MethodGet, http.MethodHead:
-		if crypto.S3.IsRequested(headers) {
+		if crypto.S3.IsRequested(headers) || crypto.S3KMS.IsRequested(headers) {
 			return false, errInvalidEncryptionParameters
 		}
 	}
@@ -791,12 +959,19 @@ func DecryptObjectInfo(info *ObjectInfo, r *http.Request) (encrypted bool, err e
 			}
 		}
 
-		if crypto.S3.IsEncrypted(info.UserDefined) && r.Header.Get(xhttp.AmzCopySource) == "" {
+		if (crypto.S3.IsEncrypted(info.UserDefined) || crypto.S3KMS.IsEncrypted(info.UserDefined)) && r.Header.Get(xhttp.AmzCopySource) == "" {
 			if crypto.SSEC.IsRequested(headers) || crypto.SSECopy.IsRequested(headers) {
 				return encrypted, errEncryptedObject
 			}
 		}
 
+		// Reading an SSE-KMS object requires access to its master key.
+		if crypto.S3KMS.IsEncrypted(info.UserDefined) {
+			if err = checkKMSKeyAccess(r, iampolicy.KMSDecryptAction, info.Bucket, info.Name, info.UserDefined[crypto.MetaKeyID]); err != nil {
+				return encrypted, err
+			}
+		}
+
 		if _, err = info.DecryptedSize(); err != nil {
 			return encrypted, err
 		}
@@ -28,6 +28,7 @@ import (
 
 	"minio/cmd/crypto"
 	xhttp "minio/cmd/http"
+	"minio/pkg/kms"
 )
 
 var encryptRequestTests = []struct {
@@ -623,3 +624,100 @@ func TestGetDefaultOpts(t *testing.T) {
 		}
 	}
 }
+
+func TestRewrapObjectKey(t *testing.T) {
+	defer func(kmsBackup kms.KMS) { GlobalKMS = kmsBackup }(GlobalKMS)
+
+	var err error
+	GlobalKMS, err = kms.Parse("my-minio-key:5lF+0pJM0OWwlQrvK2S/I7W9mO4a6rJJI7wzj7v09cw=")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const bucket, object = "bucket", "object"
+	metadata := map[string]string{}
+	objectKey, err := newEncryptMetadata(crypto.S3, "", nil, bucket, object, metadata, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldMetadata := cloneMSS(metadata)
+
+	if err = rewrapObjectKey(bucket, object, metadata); err != nil {
+		t.Fatalf("Failed to rewrap object key: %v", err)
+	}
+	if metadata[crypto.MetaKeyID] != oldMetadata[crypto.MetaKeyID] {
+		t.Errorf("Master key ID changed: got %s - want %s", metadata[crypto.MetaKeyID], oldMetadata[crypto.MetaKeyID])
+	}
+	if metadata[crypto.MetaDataEncryptionKey] == oldMetadata[crypto.MetaDataEncryptionKey] {
+		t.Error("Sealed KMS data key has not been replaced")
+	}
+	if metadata[crypto.MetaSealedKeyS3] == oldMetadata[crypto.MetaSealedKeyS3] {
+		t.Error("Sealed object key has not been replaced")
+	}
+
+	key, err := crypto.S3.UnsealObjectKey(GlobalKMS, metadata, bucket, object)
+	if err != nil {
+		t.Fatalf("Failed to unseal rewrapped object key: %v", err)
+	}
+	if key != objectKey {
+		t.Error("Rewrapped object key does not match the original object key")
+	}
+
+	if err = rewrapObjectKey(bucket, object, map[string]string{}); err != errKMSObjectNotEncrypted {
+		t.Errorf("Unencrypted object: got %v - want %v", err, errKMSObjectNotEncrypted)
+	}
+	ssecMetadata := map[string]string{}
+	if _, err = newEncryptMetadata(crypto.SSEC, "", make([]byte, 32), bucket, object, ssecMetadata, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err = rewrapObjectKey(bucket, object, ssecMetadata); err != errKMSObjectNotEncrypted {
+		t.Errorf("SSE-C object: got %v - want %v", err, errKMSObjectNotEncrypted)
+	}
+}
+
+func TestNewEncryptMetadataKMS(t *testing.T) {
+	defer func(kmsBackup kms.KMS) { GlobalKMS = kmsBackup }(GlobalKMS)
+
+	var err error
+	GlobalKMS, err = kms.Parse("my-minio-key:5lF+0pJM0OWwlQrvK2S/I7W9mO4a6rJJI7wzj7v09cw=")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const bucket, object = "bucket", "object"
+	testCases := []crypto.Context{
+		nil,
+		{"tenant": "tenant-a"},
+	}
+	for i, kmsCtx := range testCases {
+		metadata := map[string]string{}
+		objectKey, err := newEncryptMetadata(crypto.S3KMS, "my-minio-key", nil, bucket, object, metadata, kmsCtx)
+		if err != nil {
+			t.Fatalf("Test %d: failed to encrypt metadata: %v", i, err)
+		}
+		if kind, _ := crypto.IsEncrypted(metadata); kind != crypto.S3KMS {
+			t.Errorf("Test %d: got encryption type %v - want %v", i, kind, crypto.S3KMS)
+		}
+		if metadata[crypto.MetaKeyID] != "my-minio-key" {
+			t.Errorf("Test %d: got key ID %s - want %s", i, metadata[crypto.MetaKeyID], "my-minio-key")
+		}
+		if _, ok := metadata[crypto.MetaContext]; ok != (len(kmsCtx) > 0) {
+			t.Errorf("Test %d: KMS context stored: %v", i, ok)
+		}
+
+		key, err := decryptObjectInfo(nil, bucket, object, metadata)
+		if err != nil {
+			t.Fatalf("Test %d: failed to decrypt object key: %v", i, err)
+		}
+		if !bytes.Equal(key, objectKey[:]) {
+			t.Errorf("Test %d: decrypted object key does not match the original object key", i)
+		}
+		if _, err = decryptObjectInfo(nil, bucket, "other-object", metadata); err == nil {
+			t.Errorf("Test %d: object key of another object has been decrypted", i)
+		}
+	}
+
+	if _, err = newEncryptMetadata(crypto.S3KMS, "unknown-key", nil, bucket, object, map[string]string{}, nil); err == nil {
+		t.Error("Object key has been sealed with an unknown master key")
+	}
+}
@@ -223,6 +223,31 @@ func (l EndpointServerPools) GetLocalPoolIdx(ep Endpoint) int {
 	return -1
 }
 
+// FindEndpoint returns the endpoint whose string form is s.
+func (l EndpointServerPools) FindEndpoint(s string) (Endpoint, bool) {
+	for _, zep := range l {
+		for _, ep := range zep.Endpoints {
+			if ep.String() == s {
+				return ep, true
+			}
+		}
+	}
+	return Endpoint{}, false
+}
+
+// HasHost returns true if host, given as host:port, is
+// the host of any of the endpoints.
+func (l EndpointServerPools) HasHost(host string) bool {
+	for _, zep := range l {
+		for _, ep := range zep.Endpoints {
+			if ep.Type() == URLEndpointType && ep.Host == host {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Add add pool endpoints
 func (l *EndpointServerPools) Add(zeps PoolEndpoints) error {
 	existSet := set.NewStringSet()
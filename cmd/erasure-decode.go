@@ -31,6 +31,7 @@ type parallelReader struct {
 	readers       []io.ReaderAt
 	orgReaders    []io.ReaderAt
 	dataBlocks    int
+	lrc           *lrcEncoder
 	errs          []error
 	offset        int64
 	shardSize     int64
@@ -50,6 +51,7 @@ func newParallelReader(readers []io.ReaderAt, e Erasure, offset, totalLength int
 		orgReaders:    readers,
 		errs:          make([]error, len(readers)),
 		dataBlocks:    e.dataBlocks,
+		lrc:           e.lrc(),
 		offset:        (offset / e.blockSize) * e.ShardSize(),
 		shardSize:     e.ShardSize(),
 		shardFileSize: e.ShardFileSize(totalLength),
@@ -89,6 +91,15 @@ func (p *parallelReader) preferReaders(prefer []bool) {
 
 // Returns if buf can be erasure decoded.
 func (p *parallelReader) canDecode(buf [][]byte) bool {
+	if p.lrc != nil {
+		// Not every set of dataBlocks shards of a locally
+		// repairable code can be decoded.
+		present := make([]bool, len(buf))
+		for i, b := range buf {
+			present[i] = len(b) > 0
+		}
+		return p.lrc.canReconstruct(present)
+	}
 	bufCount := 0
 	for _, b := range buf {
 		if len(b) > 0 {
@@ -125,6 +136,7 @@ func (p *parallelReader) Read(dst [][]byte) ([][]byte, error) {
 
 	bitrotHeal := int32(0)       // Atomic bool flag.
 	missingPartsHeal := int32(0) // Atomic bool flag.
+	pendingReads := int32(0)     // Atomic counter.
 	readerIndex := 0
 	var wg sync.WaitGroup
 	// if readTrigger is true, it implies next disk.ReadAt() should be tried
@@ -144,11 +156,13 @@ func (p *parallelReader) Read(dst [][]byte) ([][]byte, error) {
 			continue
 		}
 		wg.Add(1)
+		atomic.AddInt32(&pendingReads, 1)
 		go func(i int) {
 			defer wg.Done()
 			rr := p.readers[i]
 			if rr == nil {
 				// Since reader is nil, trigger another read.
+				atomic.AddInt32(&pendingReads, -1)
 				readTriggerCh <- true
 				return
 			}
@@ -175,14 +189,19 @@ func (p *parallelReader) Read(dst [][]byte) ([][]byte, error) {
 				p.errs[i] = err
 
 				// Since ReadAt returned error, trigger another read.
+				atomic.AddInt32(&pendingReads, -1)
 				readTriggerCh <- true
 				return
 			}
 			newBufLK.Lock()
 			newBuf[bufIdx] = p.buf[bufIdx][:n]
+			canDecode := p.canDecode(newBuf)
 			newBufLK.Unlock()
-			// Since ReadAt returned success, there is no need to trigger another read.
-			readTriggerCh <- false
+			// Since ReadAt returned success, there is no need to trigger another read,
+			// unless the shards of a locally repairable code read so far cannot be
+			// decoded and no other read is pending.
+			pending := atomic.AddInt32(&pendingReads, -1)
+			readTriggerCh <- p.lrc != nil && pending == 0 && !canDecode
 		}(readerIndex)
 		readerIndex++
 	}
@@ -389,7 +389,7 @@ func (er erasureObjects) healObject(ctx context.Context, bucket string, object s
 	}
 
 	var inlineBuffers []*bytes.Buffer
-	if len(latestMeta.Parts) <= 1 && latestMeta.Size < smallFileThreshold {
+	if latestMeta.InlineData() {
 		inlineBuffers = make([]*bytes.Buffer, len(outDatedDisks))
 	}
 
@@ -406,7 +406,7 @@ func (er erasureObjects) healObject(ctx context.Context, bucket string, object s
 		// Heal each part. erasureHealFile() will write the healed
 		// part to .minio/tmp/uuid/ which needs to be renamed later to
 		// the final location.
-		erasure, err := NewErasure(ctx, latestMeta.Erasure.DataBlocks,
+		erasure, err := NewErasureWithAlgo(ctx, latestMeta.Erasure.Algo(), latestMeta.Erasure.DataBlocks,
 			latestMeta.Erasure.ParityBlocks, latestMeta.Erasure.BlockSize)
 		if err != nil {
 			return result, toObjectErr(err, bucket, object)
@@ -437,10 +437,10 @@ func (er erasureObjects) healObject(ctx context.Context, bucket string, object s
 				partPath := pathJoin(tmpID, dstDataDir, fmt.Sprintf("part.%d", partNumber))
 				if len(inlineBuffers) > 0 {
 					inlineBuffers[i] = bytes.NewBuffer(make([]byte, 0, erasure.ShardFileSize(latestMeta.Size)))
-					writers[i] = newStreamingBitrotWriterBuffer(inlineBuffers[i], DefaultBitrotAlgorithm, erasure.ShardSize())
+					writers[i] = newStreamingBitrotWriterBuffer(inlineBuffers[i], checksumAlgo, erasure.ShardSize())
 				} else {
 					writers[i] = newBitrotWriter(disk, minioMetaTmpBucket, partPath,
-						tillOffset, DefaultBitrotAlgorithm, erasure.ShardSize(), true)
+						tillOffset, checksumAlgo, erasure.ShardSize(), true)
 				}
 			}
 			err = erasure.Heal(ctx, readers, writers, partSize)
@@ -0,0 +1,262 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	xhttp "minio/cmd/http"
+	"minio/cmd/logger"
+	"minio/pkg/console"
+)
+
+// inlineDataKey marks the object versions with their data inlined in xl.meta.
+const inlineDataKey = ReservedMetadataPrefixLower + "inline-data"
+
+// InlineData returns true if the data of the object version is inlined in xl.meta.
+func (fi FileInfo) InlineData() bool {
+	_, ok := fi.Metadata[inlineDataKey]
+	return ok
+}
+
+// SetInlineData marks the data of the object version as inlined in xl.meta.
+func (fi *FileInfo) SetInlineData() {
+	if fi.Metadata == nil {
+		fi.Metadata = make(map[string]string, 1)
+	}
+	fi.Metadata[inlineDataKey] = "true"
+}
+
+// markInlineData marks the object version as inlined if xl.meta holds its
+// data, for versions inlined before inlined versions were marked as such.
+func markInlineData(xlMeta xlMetaV2, fi *FileInfo) {
+	if fi.Deleted || fi.Size == 0 || fi.InlineData() {
+		return
+	}
+	versionID := fi.VersionID
+	if versionID == "" {
+		versionID = nullVersionID
+	}
+	if len(xlMeta.data.find(versionID)) > 0 || len(xlMeta.data.find(fi.DataDir)) > 0 {
+		fi.SetInlineData()
+	}
+}
+
+// shouldInlineData returns true if the erasure coded shards of the given
+// size of an object of the storage class are inlined in xl.meta. As the
+// data of all versions is held by xl.meta the threshold of versioned
+// objects is an eighth.
+func shouldInlineData(sc string, shardFileSize int64, versioned bool) bool {
+	if shardFileSize < 0 {
+		return false
+	}
+	threshold := globalStorageClass.GetInlineThreshold(sc)
+	if versioned {
+		threshold /= 8
+	}
+	return shardFileSize < threshold
+}
+
+// inlineDataMismatch returns true if the data of the object version is
+// inlined in xl.meta while it belongs in part files or vice versa, after
+// the inline threshold has changed. Only objects uploaded in a single
+// part with data are considered, multipart objects are never inlined.
+func (fi FileInfo) inlineDataMismatch() bool {
+	if fi.Deleted || fi.XLV1 || fi.TransitionStatus != "" || fi.Size == 0 || !fi.IsValid() {
+		return false
+	}
+	if len(fi.Parts) != 1 || fi.Parts[0].ETag != "" {
+		return false
+	}
+	versioned := fi.VersionID != "" && fi.VersionID != nullVersionID
+	inline := shouldInlineData(fi.Metadata[xhttp.AmzStorageClass], fi.Erasure.ShardFileSize(fi.Size), versioned)
+	return inline != fi.InlineData()
+}
+
+// migrateInlineData rewrites the data of the object version inline into
+// xl.meta or into part files, whichever the inline threshold asks for.
+// The data is rewritten as stored, compressed or encrypted objects are
+// not decoded, and the version keeps its metadata and modification time.
+func (er erasureObjects) migrateInlineData(ctx context.Context, bucket, object, versionID string) error {
+	lk := er.NewNSLock(bucket, object)
+	ctx, err := lk.GetLock(ctx, globalOperationTimeout)
+	if err != nil {
+		return err
+	}
+	defer lk.Unlock()
+
+	fi, metaArr, onlineDisks, err := er.getObjectFileInfo(ctx, bucket, object, ObjectOptions{VersionID: versionID}, true)
+	if err != nil {
+		return toObjectErr(err, bucket, object, versionID)
+	}
+
+	// The object may have changed since it was scanned.
+	if !fi.inlineDataMismatch() {
+		return nil
+	}
+	inline := !fi.InlineData()
+
+	erasure, err := NewErasureWithAlgo(ctx, fi.Erasure.Algo(), fi.Erasure.DataBlocks, fi.Erasure.ParityBlocks, fi.Erasure.BlockSize)
+	if err != nil {
+		return toObjectErr(err, bucket, object)
+	}
+
+	writeQuorum := fi.Erasure.DataBlocks
+	if fi.Erasure.DataBlocks == fi.Erasure.ParityBlocks || fi.Erasure.Algo() == LocalReconstruction {
+		writeQuorum++
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(er.getObjectWithFileInfo(ctx, bucket, object, 0, fi.Size, pw, fi, metaArr, onlineDisks))
+	}()
+	defer pr.Close()
+
+	nfi := fi
+	nfi.DataDir = mustGetUUID()
+	nfi.Data = nil
+	nfi.Erasure.Index = 0
+	nfi.Erasure.Checksums = nil
+	nfi.Parts = nil
+	nfi.Metadata = make(map[string]string, len(fi.Metadata)+1)
+	for k, v := range fi.Metadata {
+		nfi.Metadata[k] = v
+	}
+	if inline {
+		nfi.SetInlineData()
+	} else {
+		delete(nfi.Metadata, inlineDataKey)
+	}
+
+	tempObj := mustGetUUID()
+	tempErasureObj := pathJoin(tempObj, nfi.DataDir, "part.1")
+	defer er.deleteObject(context.Background(), minioMetaTmpBucket, tempObj, writeQuorum)
+
+	// Write to the drives which hold the current version, any
+	// other drive is brought up to date by healing.
+	disks := shuffleDisks(onlineDisks, fi.Erasure.Distribution)
+	shardFileSize := erasure.ShardFileSize(fi.Size)
+	// Keep the bitrot algorithm the object was written with.
+	bitrotAlgo := fi.Erasure.GetChecksumInfo(fi.Parts[0].Number).Algorithm
+	if !bitrotAlgo.Available() {
+		bitrotAlgo = DefaultBitrotAlgorithm
+	}
+	writers := make([]io.Writer, len(disks))
+	inlineBuffers := make([]*bytes.Buffer, len(disks))
+	for i, disk := range disks {
+		if disk == nil {
+			continue
+		}
+		if inline {
+			inlineBuffers[i] = bytes.NewBuffer(make([]byte, 0, shardFileSize))
+			writers[i] = newStreamingBitrotWriterBuffer(inlineBuffers[i], bitrotAlgo, erasure.ShardSize())
+			continue
+		}
+		writers[i] = newBitrotWriter(disk, minioMetaTmpBucket, tempErasureObj,
+			shardFileSize, bitrotAlgo, erasure.ShardSize(), false)
+	}
+
+	var buffer []byte
+	if fi.Size < fi.Erasure.BlockSize {
+		buffer = make([]byte, fi.Size)
+	} else {
+		buffer = er.bp.Get()
+		defer er.bp.Put(buffer)
+	}
+	if len(buffer) > int(fi.Erasure.BlockSize) {
+		buffer = buffer[:fi.Erasure.BlockSize]
+	}
+
+	n, err := erasure.Encode(ctx, pr, writers, buffer, writeQuorum)
+	closeBitrotWriters(writers)
+	if err != nil {
+		return toObjectErr(err, minioMetaTmpBucket, tempErasureObj)
+	}
+	if n < fi.Size {
+		return IncompleteBody{Bucket: bucket, Object: object}
+	}
+
+	partsMetadata := make([]FileInfo, len(disks))
+	for i, w := range writers {
+		if w == nil {
+			disks[i] = nil
+			continue
+		}
+		partsMetadata[i] = nfi
+		if inline {
+			partsMetadata[i].Data = inlineBuffers[i].Bytes()
+		}
+		partsMetadata[i].AddObjectPart(fi.Parts[0].Number, "", n, fi.Parts[0].ActualSize)
+		partsMetadata[i].Erasure.AddChecksumInfo(ChecksumInfo{
+			PartNumber: fi.Parts[0].Number,
+			Algorithm:  bitrotAlgo,
+			Hash:       bitrotWriterSum(w),
+		})
+	}
+
+	if _, err = renameData(ctx, disks, minioMetaTmpBucket, tempObj, partsMetadata, bucket, object, writeQuorum); err != nil {
+		return toObjectErr(err, bucket, object)
+	}
+	return nil
+}
+
+// migrateInlineData - rewrites the data of the object version inline or into part files.
+func (s *erasureSets) migrateInlineData(ctx context.Context, bucket, object, versionID string) error {
+	return s.getHashedSet(object).migrateInlineData(ctx, bucket, object, versionID)
+}
+
+// migrateInlineData - rewrites the data of the object version inline or into part files.
+func (z *erasureServerPools) migrateInlineData(ctx context.Context, bucket, object, versionID string) error {
+	object = encodeDirObject(object)
+	if z.SinglePool() {
+		return z.serverPools[0].migrateInlineData(ctx, bucket, object, versionID)
+	}
+
+	idx, err := z.getPoolIdxExisting(ctx, bucket, object)
+	if err != nil {
+		return err
+	}
+
+	return z.serverPools[idx].migrateInlineData(ctx, bucket, object, versionID)
+}
+
+// applyInlineMigration moves the data of the object version inline into
+// xl.meta or out into part files once the inline threshold has changed.
+func (i *scannerItem) applyInlineMigration(ctx context.Context, o ObjectLayer, fi FileInfo) {
+	if !fi.inlineDataMismatch() {
+		return
+	}
+	z, ok := o.(*erasureServerPools)
+	if !ok {
+		return
+	}
+
+	versionID := fi.VersionID
+	if versionID == "" {
+		versionID = nullVersionID
+	}
+	if i.debug {
+		console.Debugf(applyActionsLogPrefix+" inline data migration: %q (version-id=%s)\n", i.objectPath(), versionID)
+	}
+
+	err := z.migrateInlineData(ctx, i.bucket, i.objectPath(), versionID)
+	if err != nil && !isErrObjectNotFound(err) && !isErrVersionNotFound(err) {
+		logger.LogIf(ctx, err)
+	}
+}
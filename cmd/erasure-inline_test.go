@@ -0,0 +1,157 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"minio/cmd/config/storageclass"
+)
+
+func TestShouldInlineData(t *testing.T) {
+	restoreGlobalStorageClass := globalStorageClass
+	defer func() {
+		globalStorageClass = restoreGlobalStorageClass
+	}()
+	globalStorageClass = storageclass.Config{
+		Custom: map[string]storageclass.StorageClass{
+			"CRITICAL": {Parity: 6},
+		},
+		InlineThreshold: map[string]int64{
+			storageclass.STANDARD: 64 * 1024,
+			"CRITICAL":            0,
+		},
+	}
+
+	testCases := []struct {
+		sc            string
+		shardFileSize int64
+		versioned     bool
+		expected      bool
+	}{
+		{"", 1024, false, true},
+		{"", 64 * 1024, false, false},
+		{"", 16 * 1024, true, false},
+		{"", 4 * 1024, true, true},
+		// Falls back to the threshold of the standard storage class.
+		{storageclass.RRS, 1024, false, true},
+		{"CRITICAL", 1, false, false},
+		{"", -1, false, false},
+	}
+
+	for i, testCase := range testCases {
+		if got := shouldInlineData(testCase.sc, testCase.shardFileSize, testCase.versioned); got != testCase.expected {
+			t.Errorf("Test %d: expected %v, got %v", i+1, testCase.expected, got)
+		}
+	}
+}
+
+func TestMigrateInlineData(t *testing.T) {
+	restoreGlobalStorageClass := globalStorageClass
+	defer func() {
+		globalStorageClass = restoreGlobalStorageClass
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	z := obj.(*erasureServerPools)
+	xl := z.serverPools[0].sets[0]
+
+	bucket, object := "bucket", "object"
+	if err = obj.MakeBucketWithLocation(ctx, bucket, BucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	data := bytes.Repeat([]byte("a"), 4*1024)
+	_, err = obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), ObjectOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkObject := func(inline bool) {
+		t.Helper()
+		fi, _, _, err := xl.getObjectFileInfo(ctx, bucket, object, ObjectOptions{}, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fi.InlineData() != inline {
+			t.Fatalf("Expected inline data %v, got %v", inline, fi.InlineData())
+		}
+		// Inlined data leaves no part files behind.
+		for _, dir := range fsDirs {
+			_, err = os.Stat(pathJoin(dir, bucket, object, fi.DataDir, "part.1"))
+			if os.IsNotExist(err) != inline {
+				t.Fatalf("Expected part file to exist %v, got %v", !inline, err)
+			}
+		}
+		if fi.inlineDataMismatch() {
+			t.Fatal("Expected inline data to match the threshold")
+		}
+
+		gr, err := obj.GetObjectNInfo(ctx, bucket, object, nil, nil, readLock, ObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer gr.Close()
+		got, err := ioutil.ReadAll(gr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Fatal("Unexpected object data after migration")
+		}
+	}
+	checkObject(true)
+
+	migrate := func() {
+		t.Helper()
+		fi, _, _, err := xl.getObjectFileInfo(ctx, bucket, object, ObjectOptions{}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !fi.inlineDataMismatch() {
+			t.Fatal("Expected inline data not to match the threshold")
+		}
+		if err = z.migrateInlineData(ctx, bucket, object, nullVersionID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Move the data out into part files.
+	globalStorageClass = storageclass.Config{
+		InlineThreshold: map[string]int64{storageclass.STANDARD: 0},
+	}
+	migrate()
+	checkObject(false)
+
+	// And back inline.
+	globalStorageClass = storageclass.Config{}
+	migrate()
+	checkObject(true)
+}
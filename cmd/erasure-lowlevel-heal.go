@@ -19,6 +19,7 @@ package cmd
 import (
 	"context"
 	"io"
+	"sync"
 
 	"minio/cmd/logger"
 )
@@ -26,9 +27,20 @@ import (
 // Heal heals the shard files on non-nil writers. Note that the quorum passed is 1
 // as healing should continue even if it has been successful healing only one shard file.
 func (e Erasure) Heal(ctx context.Context, readers []io.ReaderAt, writers []io.Writer, size int64) error {
+	var offset int64
+	if lrc := e.lrc(); lrc != nil && size > 0 {
+		var err error
+		if offset, err = e.healLocal(ctx, lrc, readers, writers, size); err != nil {
+			return err
+		}
+		if offset == size {
+			return nil
+		}
+	}
+
 	r, w := io.Pipe()
 	go func() {
-		if _, err := e.Decode(ctx, w, readers, 0, size, size, nil); err != nil {
+		if _, err := e.Decode(ctx, w, readers, offset, size-offset, size, nil); err != nil {
 			w.CloseWithError(err)
 			return
 		}
@@ -40,9 +52,96 @@ func (e Erasure) Heal(ctx context.Context, readers []io.ReaderAt, writers []io.W
 	if err != nil {
 		return err
 	}
-	if n != size {
+	if n != size-offset {
 		logger.LogIf(ctx, errLessData)
 		return errLessData
 	}
 	return nil
 }
+
+// healLocal heals the shard files of a locally repairable code on non-nil
+// writers from the other shards of their local group, which reads about
+// half of the shards read by decoding. It returns the size of the data
+// healed, the rest is healed by decoding once a shard of a group cannot
+// be read, or right away if a shard has no readable local group.
+func (e Erasure) healLocal(ctx context.Context, lrc *lrcEncoder, readers []io.ReaderAt, writers []io.Writer, size int64) (int64, error) {
+	repairSets := make(map[int][]int)
+	for i, w := range writers {
+		if w == nil {
+			continue
+		}
+		set := lrc.localRepairSet(i)
+		if set == nil {
+			return 0, nil
+		}
+		for _, j := range set {
+			if readers[j] == nil || writers[j] != nil {
+				return 0, nil
+			}
+		}
+		repairSets[i] = set
+	}
+	if len(repairSets) == 0 {
+		return 0, nil
+	}
+
+	shardSize := e.ShardSize()
+	shardFileSize := e.ShardFileSize(size)
+	bufs := make([][]byte, len(readers))
+	errs := make([]error, len(readers))
+	healed := make([]byte, shardSize)
+
+	var offset int64
+	for shardOffset := int64(0); shardOffset < shardFileSize; shardOffset += shardSize {
+		n := shardSize
+		if shardFileSize-shardOffset < n {
+			n = shardFileSize - shardOffset
+		}
+
+		var wg sync.WaitGroup
+		for _, set := range repairSets {
+			for _, j := range set {
+				if bufs[j] == nil {
+					bufs[j] = make([]byte, shardSize)
+				}
+				wg.Add(1)
+				go func(j int) {
+					defer wg.Done()
+					_, errs[j] = readers[j].ReadAt(bufs[j][:n], shardOffset)
+				}(j)
+			}
+		}
+		wg.Wait()
+		for j, err := range errs {
+			if err != nil {
+				// Decode the rest without this reader.
+				readers[j] = nil
+				return offset, nil
+			}
+		}
+
+		written := 0
+		for i, set := range repairSets {
+			copy(healed[:n], bufs[set[0]][:n])
+			for _, j := range set[1:] {
+				galMulSliceXor(1, bufs[j][:n], healed[:n])
+			}
+			if _, err := writers[i].Write(healed[:n]); err != nil {
+				writers[i] = nil
+				delete(repairSets, i)
+				continue
+			}
+			written++
+		}
+		if written == 0 {
+			logger.LogIf(ctx, errDiskNotFound)
+			return 0, errDiskNotFound
+		}
+
+		offset += e.blockSize
+		if offset > size {
+			offset = size
+		}
+	}
+	return offset, nil
+}
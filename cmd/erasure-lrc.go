@@ -0,0 +1,482 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/klauspost/reedsolomon"
+)
+
+// Number of local groups of a locally repairable code.
+const lrcLocalGroups = 2
+
+// lrcSupported returns true if objects of dataBlocks data and
+// parityBlocks parity shards can use a locally repairable code, two
+// parity shards are local and at least one is global.
+func lrcSupported(dataBlocks, parityBlocks int) bool {
+	return dataBlocks >= 2*lrcLocalGroups && parityBlocks > lrcLocalGroups
+}
+
+var errLRCUpdate = errors.New("lrc: update is not supported")
+
+// lrcEncoder is a locally repairable code implementing
+// reedsolomon.Encoder. The data shards are split in two local
+// groups, each protected by a local XOR parity, and all data shards
+// are protected by the remaining Cauchy Reed-Solomon global parities:
+//
+//	[data group 0][data group 1][local 0][local 1][global 0..n]
+//
+// A single lost shard of a group is rebuilt from the rest of its
+// group, reading about half of the data shards, while the global
+// parities rebuild the remaining loss patterns.
+type lrcEncoder struct {
+	dataShards, parityShards int
+
+	// Data shard indexes of the local groups.
+	groups [lrcLocalGroups][]int
+	// Reed-Solomon encoder of the global parities.
+	global reedsolomon.Encoder
+	// Coefficients of the global parities over the data shards.
+	coeffs [][]byte
+	// Splits and joins the data, which only depends on the shard counts.
+	splitter reedsolomon.Encoder
+}
+
+func newLRCEncoder(dataShards, parityShards int, opts ...reedsolomon.Option) (*lrcEncoder, error) {
+	if !lrcSupported(dataShards, parityShards) {
+		return nil, reedsolomon.ErrInvShardNum
+	}
+	if dataShards+parityShards > 256 {
+		return nil, reedsolomon.ErrMaxShardNum
+	}
+	global, err := reedsolomon.New(dataShards, parityShards-lrcLocalGroups, append(opts, reedsolomon.WithCauchyMatrix())...)
+	if err != nil {
+		return nil, err
+	}
+	splitter, err := reedsolomon.New(dataShards, parityShards)
+	if err != nil {
+		return nil, err
+	}
+	l := &lrcEncoder{
+		dataShards:   dataShards,
+		parityShards: parityShards,
+		global:       global,
+		splitter:     splitter,
+	}
+	groupSize := ceilFrac(int64(dataShards), lrcLocalGroups)
+	for i := 0; i < dataShards; i++ {
+		g := int64(i) / groupSize
+		l.groups[g] = append(l.groups[g], i)
+	}
+
+	// The global parity of data shards with a single 1 byte are
+	// the coefficients of that data shard.
+	globalShards := parityShards - lrcLocalGroups
+	l.coeffs = make([][]byte, globalShards)
+	for k := range l.coeffs {
+		l.coeffs[k] = make([]byte, dataShards)
+	}
+	for j := 0; j < dataShards; j++ {
+		shards := make([][]byte, dataShards+globalShards)
+		for i := range shards {
+			shards[i] = make([]byte, 1)
+		}
+		shards[j][0] = 1
+		if err = global.Encode(shards); err != nil {
+			return nil, err
+		}
+		for k := range l.coeffs {
+			l.coeffs[k][j] = shards[dataShards+k][0]
+		}
+	}
+	return l, nil
+}
+
+// localParity returns the shard index of the local parity of group g.
+func (l *lrcEncoder) localParity(g int) int {
+	return l.dataShards + g
+}
+
+// group returns the local group of a data or local parity shard, -1
+// for global parities.
+func (l *lrcEncoder) group(i int) int {
+	if i >= l.dataShards {
+		if g := i - l.dataShards; g < lrcLocalGroups {
+			return g
+		}
+		return -1
+	}
+	for g := range l.groups {
+		if i <= l.groups[g][len(l.groups[g])-1] {
+			return g
+		}
+	}
+	return -1
+}
+
+// localRepairSet returns the shards read to rebuild shard i from its
+// local group, nil if shard i is a global parity.
+func (l *lrcEncoder) localRepairSet(i int) []int {
+	g := l.group(i)
+	if g < 0 {
+		return nil
+	}
+	set := make([]int, 0, len(l.groups[g]))
+	for _, j := range l.groups[g] {
+		if j != i {
+			set = append(set, j)
+		}
+	}
+	if p := l.localParity(g); p != i {
+		set = append(set, p)
+	}
+	return set
+}
+
+// globalShards returns the data shards followed by the global
+// parities, as expected by the global encoder.
+func (l *lrcEncoder) globalShards(shards [][]byte) [][]byte {
+	sub := make([][]byte, 0, len(shards)-lrcLocalGroups)
+	sub = append(sub, shards[:l.dataShards]...)
+	return append(sub, shards[l.dataShards+lrcLocalGroups:]...)
+}
+
+// shardSize returns the size of the present shards.
+func (l *lrcEncoder) shardSize(shards [][]byte) (int, error) {
+	if len(shards) != l.dataShards+l.parityShards {
+		return 0, reedsolomon.ErrTooFewShards
+	}
+	size := 0
+	for _, shard := range shards {
+		if len(shard) == 0 {
+			continue
+		}
+		if size != 0 && len(shard) != size {
+			return 0, reedsolomon.ErrShardSize
+		}
+		size = len(shard)
+	}
+	if size == 0 {
+		return 0, reedsolomon.ErrShardNoData
+	}
+	return size, nil
+}
+
+// xorGroup computes the XOR of the data shards of group g into dst.
+func (l *lrcEncoder) xorGroup(dst []byte, shards [][]byte, g int) {
+	copy(dst, shards[l.groups[g][0]])
+	for _, j := range l.groups[g][1:] {
+		galMulSliceXor(1, shards[j], dst)
+	}
+}
+
+// Encode computes the local and global parities of the data shards.
+func (l *lrcEncoder) Encode(shards [][]byte) error {
+	size, err := l.shardSize(shards)
+	if err != nil {
+		return err
+	}
+	for i := range shards {
+		if len(shards[i]) != size {
+			return reedsolomon.ErrShardSize
+		}
+	}
+	for g := range l.groups {
+		l.xorGroup(shards[l.localParity(g)], shards, g)
+	}
+	return l.global.Encode(l.globalShards(shards))
+}
+
+// Verify returns true if the parities match the data shards.
+func (l *lrcEncoder) Verify(shards [][]byte) (bool, error) {
+	size, err := l.shardSize(shards)
+	if err != nil {
+		return false, err
+	}
+	for i := range shards {
+		if len(shards[i]) != size {
+			return false, reedsolomon.ErrShardSize
+		}
+	}
+	local := make([]byte, size)
+	for g := range l.groups {
+		l.xorGroup(local, shards, g)
+		if !bytes.Equal(local, shards[l.localParity(g)]) {
+			return false, nil
+		}
+	}
+	return l.global.Verify(l.globalShards(shards))
+}
+
+// Reconstruct rebuilds all missing shards.
+func (l *lrcEncoder) Reconstruct(shards [][]byte) error {
+	return l.reconstruct(shards, false)
+}
+
+// ReconstructData rebuilds the missing data shards only.
+func (l *lrcEncoder) ReconstructData(shards [][]byte) error {
+	return l.reconstruct(shards, true)
+}
+
+// Update is not supported, parities are recomputed with Encode.
+func (l *lrcEncoder) Update(shards [][]byte, newDatashards [][]byte) error {
+	return errLRCUpdate
+}
+
+// Split the data into equally sized data shards and allocates the parities.
+func (l *lrcEncoder) Split(data []byte) ([][]byte, error) {
+	return l.splitter.Split(data)
+}
+
+// Join the data shards and writes outSize bytes to dst.
+func (l *lrcEncoder) Join(dst io.Writer, shards [][]byte, outSize int) error {
+	return l.splitter.Join(dst, shards, outSize)
+}
+
+// equation returns the coefficients of the parity shard p over the
+// data shards, a local parity is the sum of its group.
+func (l *lrcEncoder) equation(p int) []byte {
+	if g := p - l.dataShards; g < lrcLocalGroups {
+		row := make([]byte, l.dataShards)
+		for _, j := range l.groups[g] {
+			row[j] = 1
+		}
+		return row
+	}
+	return l.coeffs[p-l.dataShards-lrcLocalGroups]
+}
+
+// decodePlan selects the parity shards to solve the missing data
+// shards from and returns them with the inverse of their equations
+// over the missing data shards. Local parities are preferred so a
+// single lost shard of a group only reads its group.
+func (l *lrcEncoder) decodePlan(present []bool) (unknowns, rows []int, inverse [][]byte, err error) {
+	for j := 0; j < l.dataShards; j++ {
+		if !present[j] {
+			unknowns = append(unknowns, j)
+		}
+	}
+	if len(unknowns) == 0 {
+		return nil, nil, nil, nil
+	}
+
+	// Greedily pick independent equations, reducing a copy of the
+	// selected rows to find whether a candidate adds rank.
+	var reduced [][]byte
+	var pivots []int
+	for p := l.dataShards; p < l.dataShards+l.parityShards && len(rows) < len(unknowns); p++ {
+		if !present[p] {
+			continue
+		}
+		eq := l.equation(p)
+		row := make([]byte, len(unknowns))
+		for c, j := range unknowns {
+			row[c] = eq[j]
+		}
+		for r, pivot := range pivots {
+			if f := row[pivot]; f != 0 {
+				galMulSliceXor(f, reduced[r], row)
+			}
+		}
+		pivot := -1
+		for c := range row {
+			if row[c] != 0 {
+				pivot = c
+				break
+			}
+		}
+		if pivot < 0 {
+			continue
+		}
+		// Normalize the pivot and eliminate it from the other rows.
+		scale := galInverse(row[pivot])
+		for c := range row {
+			row[c] = galMultiply(row[c], scale)
+		}
+		for r := range reduced {
+			if f := reduced[r][pivot]; f != 0 {
+				galMulSliceXor(f, row, reduced[r])
+			}
+		}
+		reduced = append(reduced, row)
+		pivots = append(pivots, pivot)
+		rows = append(rows, p)
+	}
+	if len(rows) < len(unknowns) {
+		return nil, nil, nil, reedsolomon.ErrTooFewShards
+	}
+
+	// Invert the equations of the selected rows over the unknowns.
+	n := len(unknowns)
+	matrix := make([][]byte, n)
+	for r, p := range rows {
+		eq := l.equation(p)
+		matrix[r] = make([]byte, 2*n)
+		for c, j := range unknowns {
+			matrix[r][c] = eq[j]
+		}
+		matrix[r][n+r] = 1
+	}
+	for c := 0; c < n; c++ {
+		pivot := c
+		for pivot < n && matrix[pivot][c] == 0 {
+			pivot++
+		}
+		if pivot == n {
+			return nil, nil, nil, reedsolomon.ErrTooFewShards
+		}
+		matrix[c], matrix[pivot] = matrix[pivot], matrix[c]
+		scale := galInverse(matrix[c][c])
+		for k := range matrix[c] {
+			matrix[c][k] = galMultiply(matrix[c][k], scale)
+		}
+		for r := 0; r < n; r++ {
+			if f := matrix[r][c]; r != c && f != 0 {
+				galMulSliceXor(f, matrix[c], matrix[r])
+			}
+		}
+	}
+	inverse = make([][]byte, n)
+	for r := range matrix {
+		inverse[r] = matrix[r][n:]
+	}
+	return unknowns, rows, inverse, nil
+}
+
+// canReconstruct returns true if the data shards can be rebuilt from
+// the present shards.
+func (l *lrcEncoder) canReconstruct(present []bool) bool {
+	_, _, _, err := l.decodePlan(present)
+	return err == nil
+}
+
+func (l *lrcEncoder) reconstruct(shards [][]byte, dataOnly bool) error {
+	size, err := l.shardSize(shards)
+	if err != nil {
+		return err
+	}
+	present := make([]bool, len(shards))
+	complete := true
+	for i := range shards {
+		present[i] = len(shards[i]) != 0
+		complete = complete && present[i]
+	}
+	if complete {
+		return nil
+	}
+	unknowns, rows, inverse, err := l.decodePlan(present)
+	if err != nil {
+		return err
+	}
+
+	alloc := func(i int) {
+		if cap(shards[i]) >= size {
+			shards[i] = shards[i][:size]
+			for k := range shards[i] {
+				shards[i][k] = 0
+			}
+		} else {
+			shards[i] = make([]byte, size)
+		}
+	}
+
+	if len(unknowns) > 0 {
+		// Move the present data shards to the right hand side of the
+		// selected equations.
+		known := make([][]byte, len(rows))
+		for r, p := range rows {
+			known[r] = make([]byte, size)
+			copy(known[r], shards[p])
+			eq := l.equation(p)
+			for j := 0; j < l.dataShards; j++ {
+				if present[j] && eq[j] != 0 {
+					galMulSliceXor(eq[j], shards[j], known[r])
+				}
+			}
+		}
+		for c, j := range unknowns {
+			alloc(j)
+			for r := range rows {
+				if f := inverse[c][r]; f != 0 {
+					galMulSliceXor(f, known[r], shards[j])
+				}
+			}
+		}
+	}
+	if dataOnly {
+		return nil
+	}
+
+	for g := range l.groups {
+		if p := l.localParity(g); !present[p] {
+			alloc(p)
+			l.xorGroup(shards[p], shards, g)
+		}
+	}
+	globals := l.globalShards(shards)
+	if err = l.global.Reconstruct(globals); err != nil {
+		return err
+	}
+	copy(shards[l.dataShards+lrcLocalGroups:], globals[l.dataShards:])
+	return nil
+}
+
+// GF(2^8) arithmetic with the polynomial of the reedsolomon package,
+// which computes the coefficients of the global parities.
+var galExpTable, galLogTable = func() (exp [510]byte, log [256]byte) {
+	x := 1
+	for i := 0; i < 255; i++ {
+		exp[i], exp[i+255] = byte(x), byte(x)
+		log[x] = byte(i)
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= 0x11d
+		}
+	}
+	return exp, log
+}()
+
+func galMultiply(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return galExpTable[int(galLogTable[a])+int(galLogTable[b])]
+}
+
+func galInverse(a byte) byte {
+	return galExpTable[255-int(galLogTable[a])]
+}
+
+// galMulSliceXor computes out ^= c * in.
+func galMulSliceXor(c byte, in, out []byte) {
+	if c == 1 {
+		for i := range out {
+			out[i] ^= in[i]
+		}
+		return
+	}
+	var table [256]byte
+	for i := range table {
+		table[i] = galMultiply(c, byte(i))
+	}
+	for i := range out {
+		out[i] ^= table[in[i]]
+	}
+}
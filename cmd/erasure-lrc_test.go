@@ -0,0 +1,342 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"minio/cmd/config/storageclass"
+	xhttp "minio/cmd/http"
+	"minio/pkg/madmin"
+)
+
+// Calls fn with every set of up to n shard indexes out of total.
+func forEachErasure(total, n int, fn func(missing []int)) {
+	var walk func(start int, missing []int)
+	walk = func(start int, missing []int) {
+		fn(missing)
+		if len(missing) == n {
+			return
+		}
+		for i := start; i < total; i++ {
+			walk(i+1, append(missing, i))
+		}
+	}
+	walk(0, nil)
+}
+
+func TestLRCReconstruct(t *testing.T) {
+	for _, conf := range [][2]int{{4, 3}, {4, 4}, {6, 3}, {12, 4}} {
+		dataBlocks, parityBlocks := conf[0], conf[1]
+		lrc, err := newLRCEncoder(dataBlocks, parityBlocks)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data := make([]byte, 1000)
+		if _, err = io.ReadFull(rand.Reader, data); err != nil {
+			t.Fatal(err)
+		}
+		encoded, err := lrc.Split(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = lrc.Encode(encoded); err != nil {
+			t.Fatal(err)
+		}
+		if ok, err := lrc.Verify(encoded); !ok || err != nil {
+			t.Fatalf("[d:%d,p:%d] Expected the parities to verify, got %v", dataBlocks, parityBlocks, err)
+		}
+
+		// Any parityBlocks-1 lost shards are reconstructed.
+		forEachErasure(dataBlocks+parityBlocks, parityBlocks-1, func(missing []int) {
+			shards := make([][]byte, len(encoded))
+			copy(shards, encoded)
+			present := make([]bool, len(shards))
+			for i := range present {
+				present[i] = true
+			}
+			for _, i := range missing {
+				shards[i] = nil
+				present[i] = false
+			}
+			if !lrc.canReconstruct(present) {
+				t.Fatalf("[d:%d,p:%d] Expected shards %v to be reconstructable", dataBlocks, parityBlocks, missing)
+			}
+			if err := lrc.Reconstruct(shards); err != nil {
+				t.Fatalf("[d:%d,p:%d] Failed to reconstruct shards %v: %v", dataBlocks, parityBlocks, missing, err)
+			}
+			for i := range shards {
+				if !bytes.Equal(shards[i], encoded[i]) {
+					t.Fatalf("[d:%d,p:%d] Shard %d differs after reconstructing shards %v", dataBlocks, parityBlocks, i, missing)
+				}
+			}
+		})
+
+		// A group without its global parities cannot lose more
+		// than one shard.
+		shards := make([][]byte, len(encoded))
+		copy(shards, encoded)
+		shards[lrc.groups[0][0]], shards[lrc.groups[0][1]] = nil, nil
+		for i := dataBlocks + lrcLocalGroups; i < len(shards); i++ {
+			shards[i] = nil
+		}
+		if err = lrc.ReconstructData(shards); err == nil {
+			t.Fatalf("[d:%d,p:%d] Expected reconstructing two shards of a group to fail", dataBlocks, parityBlocks)
+		}
+	}
+}
+
+// countingReaderAt counts the calls to ReadAt.
+type countingReaderAt struct {
+	io.ReaderAt
+	reads *int32
+}
+
+func (r countingReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	atomic.AddInt32(r.reads, 1)
+	return r.ReaderAt.ReadAt(p, off)
+}
+
+func TestLRCHealLocal(t *testing.T) {
+	const dataBlocks, parityBlocks = 8, 4
+	size := int64(3*blockSizeV2 + 1234)
+
+	setup, err := newErasureTestSetup(dataBlocks, parityBlocks, blockSizeV2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer setup.Remove()
+	disks := setup.disks
+
+	erasure, err := NewErasureWithAlgo(context.Background(), LocalReconstruction, dataBlocks, parityBlocks, blockSizeV2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := make([]byte, size)
+	if _, err = io.ReadFull(rand.Reader, data); err != nil {
+		t.Fatal(err)
+	}
+	writers := make([]io.Writer, len(disks))
+	for i, disk := range disks {
+		writers[i] = newBitrotWriter(disk, "testbucket", "testobject",
+			erasure.ShardFileSize(size), DefaultBitrotAlgorithm, erasure.ShardSize(), true)
+	}
+	_, err = erasure.Encode(context.Background(), bytes.NewReader(data), writers, make([]byte, blockSizeV2), dataBlocks+1)
+	closeBitrotWriters(writers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	shardPath := func(i int) string {
+		return pathJoin(setup.diskPaths[i], "testbucket", "testobject")
+	}
+	heal := func(stale ...int) (reads []int32) {
+		t.Helper()
+		want := make(map[int][]byte, len(stale))
+		for _, i := range stale {
+			if want[i], err = ioutil.ReadFile(shardPath(i)); err != nil {
+				t.Fatal(err)
+			}
+			os.Remove(shardPath(i))
+		}
+
+		reads = make([]int32, len(disks))
+		readers := make([]io.ReaderAt, len(disks))
+		staleWriters := make([]io.Writer, len(disks))
+		for i, disk := range disks {
+			if _, ok := want[i]; ok {
+				staleWriters[i] = newBitrotWriter(disk, "testbucket", "testobject",
+					erasure.ShardFileSize(size), DefaultBitrotAlgorithm, erasure.ShardSize(), true)
+				continue
+			}
+			readers[i] = countingReaderAt{
+				ReaderAt: newBitrotReader(disk, nil, "testbucket", "testobject", erasure.ShardFileSize(size),
+					DefaultBitrotAlgorithm, nil, erasure.ShardSize()),
+				reads: &reads[i],
+			}
+		}
+		err = erasure.Heal(context.Background(), readers, staleWriters, size)
+		closeBitrotReaders(readers)
+		closeBitrotWriters(staleWriters)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, shard := range want {
+			got, err := ioutil.ReadFile(shardPath(i))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, shard) {
+				t.Fatalf("Healed shard %d differs", i)
+			}
+		}
+		return reads
+	}
+
+	// A data shard is healed from the rest of its group.
+	lrc := erasure.lrc()
+	reads := heal(0)
+	for i := range reads {
+		inGroup := lrc.group(i) == 0 && i != 0
+		if (reads[i] > 0) != inGroup {
+			t.Fatalf("Unexpected %d reads of shard %d healing shard 0 locally", reads[i], i)
+		}
+	}
+
+	// A data shard of each group and a local parity.
+	heal(1, lrc.groups[1][0])
+	heal(lrc.localParity(1))
+
+	// Two shards of the same group and a global parity need a full decode.
+	reads = heal(0, 1, dataBlocks+parityBlocks-1)
+	var total int
+	for i := range reads {
+		if reads[i] > 0 {
+			total++
+		}
+	}
+	if total < dataBlocks {
+		t.Fatalf("Expected at least %d shards read, got %d", dataBlocks, total)
+	}
+}
+
+func TestErasureMixedAlgorithms(t *testing.T) {
+	restoreGlobalStorageClass := globalStorageClass
+	defer func() {
+		globalStorageClass = restoreGlobalStorageClass
+	}()
+	globalStorageClass = storageclass.Config{
+		Custom: map[string]storageclass.StorageClass{
+			"CRITICAL": {Parity: 4},
+		},
+		InlineThreshold: map[string]int64{storageclass.STANDARD: 0},
+		Codec: map[string]string{
+			storageclass.STANDARD: storageclass.CodecReedSolomonCauchy,
+			storageclass.RRS:      storageclass.CodecReedSolomon,
+			"CRITICAL":            storageclass.CodecLRC,
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	obj, fsDirs, err := prepareErasure16(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer obj.Shutdown(context.Background())
+	defer removeRoots(fsDirs)
+
+	z := obj.(*erasureServerPools)
+	xl := z.serverPools[0].sets[0]
+
+	bucket := "bucket"
+	if err = obj.MakeBucketWithLocation(ctx, bucket, BucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	objects := map[string]struct {
+		sc   string
+		algo ErasureAlgo
+	}{
+		"standard": {"", ReedSolomonCauchy},
+		"rrs":      {storageclass.RRS, ReedSolomon},
+		"critical": {"CRITICAL", LocalReconstruction},
+	}
+	data := make([]byte, 3*blockSizeV2/2)
+	if _, err = io.ReadFull(rand.Reader, data); err != nil {
+		t.Fatal(err)
+	}
+	for object, o := range objects {
+		opts := ObjectOptions{UserDefined: map[string]string{xhttp.AmzStorageClass: o.sc}}
+		_, err = obj.PutObject(ctx, bucket, object, mustGetPutObjReader(t, bytes.NewReader(data), int64(len(data)), "", ""), opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for object, o := range objects {
+		fi, _, _, err := xl.getObjectFileInfo(ctx, bucket, object, ObjectOptions{}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fi.Erasure.Algo() != o.algo {
+			t.Fatalf("%s: expected erasure algorithm %v, got %q", object, o.algo, fi.Erasure.Algorithm)
+		}
+
+		// Lose the shards of a data and a parity drive.
+		disks := xl.getDisks()
+		offline := make([]StorageAPI, len(disks))
+		copy(offline, disks)
+		for _, index := range []int{1, fi.Erasure.DataBlocks + 1} {
+			for i, disk := range disks {
+				if fi.Erasure.Distribution[i] == index {
+					if err = os.RemoveAll(pathJoin(disk.Endpoint().Path, bucket, object, fi.DataDir)); err != nil {
+						t.Fatal(err)
+					}
+					offline[i] = nil
+				}
+			}
+		}
+
+		// Degraded read with the drives offline.
+		getDisks := xl.getDisks
+		z.serverPools[0].erasureDisksMu.Lock()
+		xl.getDisks = func() []StorageAPI {
+			return offline
+		}
+		z.serverPools[0].erasureDisksMu.Unlock()
+		gr, err := obj.GetObjectNInfo(ctx, bucket, object, nil, nil, readLock, ObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ioutil.ReadAll(gr)
+		gr.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Fatalf("%s: unexpected object data with missing shards", object)
+		}
+		z.serverPools[0].erasureDisksMu.Lock()
+		xl.getDisks = getDisks
+		z.serverPools[0].erasureDisksMu.Unlock()
+
+		if _, err = obj.HealObject(ctx, bucket, object, "", madmin.HealOpts{ScanMode: madmin.HealNormalScan}); err != nil {
+			t.Fatal(err)
+		}
+		for _, disk := range disks {
+			dfi, err := disk.ReadVersion(ctx, bucket, object, "", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if dfi.Erasure.Algo() != o.algo {
+				t.Fatalf("%s: expected healed erasure algorithm %v, got %q", object, o.algo, dfi.Erasure.Algorithm)
+			}
+			if err = disk.VerifyFile(ctx, bucket, object, dfi); err != nil {
+				t.Fatalf("%s: healed shard on %s: %v", object, disk, err)
+			}
+		}
+	}
+}
@@ -81,6 +81,11 @@ func (e ErasureInfo) ShardSize() int64 {
 	return ceilFrac(e.BlockSize, int64(e.DataBlocks))
 }
 
+// Algo - returns the erasure coding algorithm of the object.
+func (e ErasureInfo) Algo() ErasureAlgo {
+	return erasureAlgoFromString(e.Algorithm)
+}
+
 // IsValid - tells if erasure info fields are valid.
 func (fi FileInfo) IsValid() bool {
 	if fi.Deleted {
@@ -329,7 +334,9 @@ func objectQuorumFromMeta(ctx context.Context, partsMetaData []FileInfo, errs []
 	}
 
 	writeQuorum := dataBlocks
-	if dataBlocks == parityBlocks {
+	// Not every set of dataBlocks shards of a locally repairable
+	// code can be decoded, any parityBlocks-1 lost shards can.
+	if dataBlocks == parityBlocks || latestFileInfo.Erasure.Algo() == LocalReconstruction {
 		writeQuorum++
 	}
 
@@ -283,17 +283,18 @@ func (er erasureObjects) ListMultipartUploads(ctx context.Context, bucket, objec
 // disks. `uploads.json` carries metadata regarding on-going multipart
 // operation(s) on the object.
 func (er erasureObjects) newMultipartUpload(ctx context.Context, bucket string, object string, opts ObjectOptions) (string, error) {
-	onlineDisks := er.getDisks()
+	onlineDisks := globalMaintenance.writeDisks(er.getDisks())
 	parityDrives := globalStorageClass.GetParityForSC(opts.UserDefined[xhttp.AmzStorageClass])
 	if parityDrives <= 0 {
 		parityDrives = er.defaultParityCount
 	}
 
 	dataDrives := len(onlineDisks) - parityDrives
+	algo := erasureAlgoForSC(opts.UserDefined[xhttp.AmzStorageClass], dataDrives, parityDrives)
 	// we now know the number of blocks this object needs for data and parity.
 	// establish the writeQuorum using this data
 	writeQuorum := dataDrives
-	if dataDrives == parityDrives {
+	if dataDrives == parityDrives || algo == LocalReconstruction {
 		writeQuorum++
 	}
 
@@ -301,6 +302,7 @@ func (er erasureObjects) newMultipartUpload(ctx context.Context, bucket string,
 	partsMetadata := make([]FileInfo, len(onlineDisks))
 
 	fi := newFileInfo(pathJoin(bucket, object), dataDrives, parityDrives)
+	fi.Erasure.Algorithm = algo.String()
 	if opts.Versioned {
 		fi.VersionID = opts.VersionID
 		if fi.VersionID == "" {
@@ -326,6 +328,10 @@ func (er erasureObjects) newMultipartUpload(ctx context.Context, bucket string,
 
 	onlineDisks, partsMetadata = shuffleDisksAndPartsMetadata(onlineDisks, partsMetadata, fi)
 
+	// All parts use the bitrot algorithm of the storage class at the
+	// time the upload was created.
+	opts.UserDefined[bitrotAlgorithmKey] = bitrotAlgorithmForSC(opts.UserDefined[xhttp.AmzStorageClass]).String()
+
 	// Fill all the necessary metadata.
 	// Update `xl.meta` content on each disks.
 	for index := range partsMetadata {
@@ -424,8 +430,10 @@ func (er erasureObjects) PutObjectPart(ctx context.Context, bucket, object, uplo
 		return pi, toObjectErr(reducedErr, bucket, object)
 	}
 
-	// List all online disks.
+	// List all online disks, the drives of nodes in
+	// maintenance are offline for new writes.
 	onlineDisks, modTime, dataDir := listOnlineDisks(storageDisks, partsMetadata, errs)
+	onlineDisks = globalMaintenance.writeDisks(onlineDisks)
 
 	// Pick one from the first valid metadata.
 	fi, err := pickValidFileInfo(ctx, partsMetadata, modTime, dataDir, writeQuorum)
@@ -450,7 +458,7 @@ func (er erasureObjects) PutObjectPart(ctx context.Context, bucket, object, uplo
 		}
 	}()
 
-	erasure, err := NewErasure(ctx, fi.Erasure.DataBlocks, fi.Erasure.ParityBlocks, fi.Erasure.BlockSize)
+	erasure, err := NewErasureWithAlgo(ctx, fi.Erasure.Algo(), fi.Erasure.DataBlocks, fi.Erasure.ParityBlocks, fi.Erasure.BlockSize)
 	if err != nil {
 		return pi, toObjectErr(err, bucket, object)
 	}
@@ -478,13 +486,18 @@ func (er erasureObjects) PutObjectPart(ctx context.Context, bucket, object, uplo
 	if len(buffer) > int(fi.Erasure.BlockSize) {
 		buffer = buffer[:fi.Erasure.BlockSize]
 	}
+	// Uploads created before the bitrot algorithm was recorded use the default.
+	bitrotAlgo := BitrotAlgorithmFromString(fi.Metadata[bitrotAlgorithmKey])
+	if !bitrotAlgo.Streaming() {
+		bitrotAlgo = DefaultBitrotAlgorithm
+	}
 	writers := make([]io.Writer, len(onlineDisks))
 	for i, disk := range onlineDisks {
 		if disk == nil {
 			continue
 		}
 		writers[i] = newBitrotWriter(disk, minioMetaTmpBucket, tmpPartPath,
-			erasure.ShardFileSize(data.Size()), DefaultBitrotAlgorithm, erasure.ShardSize(), false)
+			erasure.ShardFileSize(data.Size()), bitrotAlgo, erasure.ShardSize(), false)
 	}
 
 	n, err := erasure.Encode(ctx, data, writers, buffer, writeQuorum)
@@ -560,7 +573,7 @@ func (er erasureObjects) PutObjectPart(ctx context.Context, bucket, object, uplo
 		partsMetadata[i].Parts = fi.Parts
 		partsMetadata[i].Erasure.AddChecksumInfo(ChecksumInfo{
 			PartNumber: partID,
-			Algorithm:  DefaultBitrotAlgorithm,
+			Algorithm:  bitrotAlgo,
 			Hash:       bitrotWriterSum(writers[i]),
 		})
 	}
@@ -778,6 +791,7 @@ func (er erasureObjects) CompleteMultipartUpload(ctx context.Context, bucket str
 	}
 
 	onlineDisks, modTime, dataDir := listOnlineDisks(storageDisks, partsMetadata, errs)
+	onlineDisks = globalMaintenance.writeDisks(onlineDisks)
 
 	// Pick one from the first valid metadata.
 	fi, err := pickValidFileInfo(ctx, partsMetadata, modTime, dataDir, writeQuorum)
@@ -863,6 +877,9 @@ func (er erasureObjects) CompleteMultipartUpload(ctx context.Context, bucket str
 	// Save the consolidated actual size.
 	fi.Metadata[ReservedMetadataPrefix+"actual-size"] = strconv.FormatInt(objectActualSize, 10)
 
+	// The bitrot algorithm of the parts is recorded with their checksums.
+	delete(fi.Metadata, bitrotAlgorithmKey)
+
 	// Update all erasure metadata, make sure to not modify fields like
 	// checksum which are different on each disks.
 	for index := range partsMetadata {
@@ -81,8 +81,10 @@ func (er erasureObjects) CopyObject(ctx context.Context, srcBucket, srcObject, d
 		return oi, toObjectErr(err, srcBucket, srcObject)
 	}
 
-	// List all online disks.
+	// List all online disks, the drives of nodes in
+	// maintenance are offline for new writes.
 	onlineDisks, modTime, dataDir := listOnlineDisks(storageDisks, metaArr, errs)
+	onlineDisks = globalMaintenance.writeDisks(onlineDisks)
 
 	// Pick latest valid metadata.
 	fi, err := pickValidFileInfo(ctx, metaArr, modTime, dataDir, readQuorum)
@@ -96,6 +98,11 @@ func (er erasureObjects) CopyObject(ctx context.Context, srcBucket, srcObject, d
 		return fi.ToObjectInfo(srcBucket, srcObject), toObjectErr(errMethodNotAllowed, srcBucket, srcObject)
 	}
 
+	// Reject the update if the object has changed since srcInfo was read.
+	if srcOpts.CheckPrecondFn != nil && srcOpts.CheckPrecondFn(fi.ToObjectInfo(srcBucket, srcObject)) {
+		return oi, PreConditionFailed{}
+	}
+
 	versionID := srcInfo.VersionID
 	if srcInfo.versionOnly {
 		versionID = dstOpts.VersionID
@@ -124,10 +131,19 @@ func (er erasureObjects) CopyObject(ctx context.Context, srcBucket, srcObject, d
 	}
 
 	// Write unique `xl.meta` for each disk.
-	if _, err = writeUniqueFileInfo(ctx, onlineDisks, srcBucket, srcObject, metaArr, writeQuorum); err != nil {
+	if onlineDisks, err = writeUniqueFileInfo(ctx, onlineDisks, srcBucket, srcObject, metaArr, writeQuorum); err != nil {
 		return oi, toObjectErr(err, srcBucket, srcObject)
 	}
 
+	// Send the update to the MRF list if a disk was left out.
+	for _, disk := range onlineDisks {
+		if disk != nil && disk.IsOnline() {
+			continue
+		}
+		er.addPartial(srcBucket, srcObject, versionID)
+		break
+	}
+
 	return fi.ToObjectInfo(srcBucket, srcObject), nil
 }
 
@@ -269,7 +285,7 @@ func (er erasureObjects) getObjectWithFileInfo(ctx context.Context, bucket, obje
 	}
 
 	var totalBytesRead int64
-	erasure, err := NewErasure(ctx, fi.Erasure.DataBlocks, fi.Erasure.ParityBlocks, fi.Erasure.BlockSize)
+	erasure, err := NewErasureWithAlgo(ctx, fi.Erasure.Algo(), fi.Erasure.DataBlocks, fi.Erasure.ParityBlocks, fi.Erasure.BlockSize)
 	if err != nil {
 		return toObjectErr(err, bucket, object)
 	}
@@ -607,7 +623,8 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 		opts.UserDefined = make(map[string]string)
 	}
 
-	storageDisks := er.getDisks()
+	// The drives of nodes in maintenance are offline for new writes.
+	storageDisks := globalMaintenance.writeDisks(er.getDisks())
 
 	parityDrives := len(storageDisks) / 2
 	if !opts.MaxParity {
@@ -618,11 +635,13 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 		}
 	}
 	dataDrives := len(storageDisks) - parityDrives
+	algo := erasureAlgoForSC(opts.UserDefined[xhttp.AmzStorageClass], dataDrives, parityDrives)
+	bitrotAlgo := bitrotAlgorithmForSC(opts.UserDefined[xhttp.AmzStorageClass])
 
 	// we now know the number of blocks this object needs for data and parity.
 	// writeQuorum is dataBlocks + 1
 	writeQuorum := dataDrives
-	if dataDrives == parityDrives {
+	if dataDrives == parityDrives || algo == LocalReconstruction {
 		writeQuorum++
 	}
 
@@ -643,6 +662,7 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 	partsMetadata := make([]FileInfo, len(storageDisks))
 
 	fi := newFileInfo(pathJoin(bucket, object), dataDrives, parityDrives)
+	fi.Erasure.Algorithm = algo.String()
 
 	if opts.Versioned {
 		fi.VersionID = opts.VersionID
@@ -661,7 +681,7 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 	var onlineDisks []StorageAPI
 	onlineDisks, partsMetadata = shuffleDisksAndPartsMetadata(storageDisks, partsMetadata, fi)
 
-	erasure, err := NewErasure(ctx, fi.Erasure.DataBlocks, fi.Erasure.ParityBlocks, fi.Erasure.BlockSize)
+	erasure, err := NewErasureWithAlgo(ctx, fi.Erasure.Algo(), fi.Erasure.DataBlocks, fi.Erasure.ParityBlocks, fi.Erasure.BlockSize)
 	if err != nil {
 		return ObjectInfo{}, toObjectErr(err, bucket, object)
 	}
@@ -706,12 +726,11 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 	shardFileSize := erasure.ShardFileSize(data.Size())
 	writers := make([]io.Writer, len(onlineDisks))
 	var inlineBuffers []*bytes.Buffer
-	if shardFileSize >= 0 {
-		if !opts.Versioned && shardFileSize < smallFileThreshold {
-			inlineBuffers = make([]*bytes.Buffer, len(onlineDisks))
-		} else if shardFileSize < smallFileThreshold/8 {
-			inlineBuffers = make([]*bytes.Buffer, len(onlineDisks))
-		}
+	if shouldInlineData(opts.UserDefined[xhttp.AmzStorageClass], shardFileSize, opts.Versioned) {
+		inlineBuffers = make([]*bytes.Buffer, len(onlineDisks))
+		opts.UserDefined[inlineDataKey] = "true"
+	} else {
+		delete(opts.UserDefined, inlineDataKey)
 	}
 	for i, disk := range onlineDisks {
 		if disk == nil {
@@ -720,11 +739,11 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 
 		if len(inlineBuffers) > 0 {
 			inlineBuffers[i] = bytes.NewBuffer(make([]byte, 0, shardFileSize))
-			writers[i] = newStreamingBitrotWriterBuffer(inlineBuffers[i], DefaultBitrotAlgorithm, erasure.ShardSize())
+			writers[i] = newStreamingBitrotWriterBuffer(inlineBuffers[i], bitrotAlgo, erasure.ShardSize())
 			continue
 		}
 		writers[i] = newBitrotWriter(disk, minioMetaTmpBucket, tempErasureObj,
-			shardFileSize, DefaultBitrotAlgorithm, erasure.ShardSize(), false)
+			shardFileSize, bitrotAlgo, erasure.ShardSize(), false)
 	}
 
 	n, erasureErr := erasure.Encode(ctx, data, writers, buffer, writeQuorum)
@@ -762,7 +781,7 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 		partsMetadata[i].AddObjectPart(1, "", n, data.ActualSize())
 		partsMetadata[i].Erasure.AddChecksumInfo(ChecksumInfo{
 			PartNumber: 1,
-			Algorithm:  DefaultBitrotAlgorithm,
+			Algorithm:  bitrotAlgo,
 			Hash:       bitrotWriterSum(w),
 		})
 	}
@@ -819,7 +838,7 @@ func (er erasureObjects) putObject(ctx context.Context, bucket string, object st
 
 func (er erasureObjects) deleteObjectVersion(ctx context.Context, bucket, object string, writeQuorum int, fi FileInfo, forceDelMarker bool) error {
 	defer ObjectPathUpdated(pathJoin(bucket, object))
-	disks := er.getDisks()
+	disks := globalMaintenance.writeDisks(er.getDisks())
 	g := errgroup.WithNErrs(len(disks))
 	for index := range disks {
 		index := index
@@ -866,7 +885,7 @@ func (er erasureObjects) deleteObject(ctx context.Context, bucket, object string
 	var err error
 	defer ObjectPathUpdated(pathJoin(bucket, object))
 
-	disks := er.getDisks()
+	disks := globalMaintenance.writeDisks(er.getDisks())
 	tmpObj := mustGetUUID()
 	if bucket == minioMetaTmpBucket {
 		tmpObj = object
@@ -904,7 +923,8 @@ func (er erasureObjects) DeleteObjects(ctx context.Context, bucket string, objec
 	dobjects := make([]DeletedObject, len(objects))
 	writeQuorums := make([]int, len(objects))
 
-	storageDisks := er.getDisks()
+	// The drives of nodes in maintenance are offline for new writes.
+	storageDisks := globalMaintenance.writeDisks(er.getDisks())
 
 	for i := range objects {
 		// Assume (N/2 + 1) quorums for all objects
@@ -927,6 +947,12 @@ func (er erasureObjects) DeleteObjects(ctx context.Context, bucket string, objec
 			if uuid == "" {
 				uuid = mustGetUUID()
 			}
+			// Objects excluded from versioning are
+			// deleted as if versioning is suspended.
+			versioned := opts.Versioned
+			if opts.PrefixEnabledFn != nil {
+				versioned = opts.PrefixEnabledFn(objects[i].ObjectName)
+			}
 			if opts.Versioned || opts.VersionSuspended {
 				versions[i] = FileInfo{
 					Name:                          objects[i].ObjectName,
@@ -935,7 +961,7 @@ func (er erasureObjects) DeleteObjects(ctx context.Context, bucket string, objec
 					DeleteMarkerReplicationStatus: objects[i].DeleteMarkerReplicationStatus,
 					VersionPurgeStatus:            objects[i].VersionPurgeStatus,
 				}
-				if opts.Versioned {
+				if versioned {
 					versions[i].VersionID = uuid
 				}
 				continue
@@ -1060,7 +1086,8 @@ func (er erasureObjects) DeleteObject(ctx context.Context, bucket, object string
 	}
 	defer lk.Unlock()
 
-	storageDisks := er.getDisks()
+	// The drives of nodes in maintenance are offline for new writes.
+	storageDisks := globalMaintenance.writeDisks(er.getDisks())
 	writeQuorum := len(storageDisks)/2 + 1
 	var markDelete bool
 	// Determine whether to mark object deleted for replication
@@ -1266,7 +1293,8 @@ func (er erasureObjects) updateObjectMeta(ctx context.Context, bucket, object st
 		return nil
 	}
 
-	disks := er.getDisks()
+	// The drives of nodes in maintenance are offline for new writes.
+	disks := globalMaintenance.writeDisks(er.getDisks())
 
 	g := errgroup.WithNErrs(len(disks))
 
@@ -1284,7 +1312,21 @@ func (er erasureObjects) updateObjectMeta(ctx context.Context, bucket, object st
 	// Wait for all the routines.
 	mErrs := g.Wait()
 
-	return reduceWriteQuorumErrs(ctx, mErrs, objectOpIgnoredErrs, getWriteQuorum(len(disks)))
+	if err := reduceWriteQuorumErrs(ctx, mErrs, objectOpIgnoredErrs, getWriteQuorum(len(disks))); err != nil {
+		return err
+	}
+
+	// Send the update to the MRF list if a disk was left out.
+	if !isMinioMetaBucketName(bucket) {
+		for i := range disks {
+			if mErrs[i] == nil && disks[i].IsOnline() {
+				continue
+			}
+			er.addPartial(bucket, object, fi.VersionID)
+			break
+		}
+	}
+	return nil
 }
 
 // DeleteObjectTags - delete object tags from an existing object
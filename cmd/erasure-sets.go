@@ -449,6 +449,8 @@ func newErasureSets(ctx context.Context, endpoints Endpoints, storageDisks []Sto
 
 	// Start the disk monitoring and connect routine.
 	go s.monitorAndConnectEndpoints(ctx, defaultMonitorConnectEndpointInterval)
+	// Start the local drives health monitoring.
+	go s.monitorDisksHealth(ctx, diskHealthCheckInterval)
 	go s.maintainMRFList()
 	go s.healMRFRoutine()
 
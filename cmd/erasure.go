@@ -205,8 +205,11 @@ func getDisksInfo(disks []StorageAPI, endpoints []string) (disksInfo []madmin.Di
 				}
 			}
 			di.Metrics = &madmin.DiskMetrics{
-				APILatencies: make(map[string]string),
-				APICalls:     make(map[string]uint64),
+				APILatencies:  make(map[string]string),
+				APICalls:      make(map[string]uint64),
+				Health:        info.Metrics.Health,
+				TotalErrors:   info.Metrics.TotalErrors,
+				TotalTimeouts: info.Metrics.TotalTimeouts,
 			}
 			for k, v := range info.Metrics.APILatencies {
 				di.Metrics.APILatencies[k] = v
@@ -646,18 +646,23 @@ func (fs *FSObjects) CopyObject(ctx context.Context, srcBucket, srcObject, dstBu
 			fsMeta = fs.defaultFsJSON(srcObject)
 		}
 
-		fsMeta.Meta = cloneMSS(srcInfo.UserDefined)
-		fsMeta.Meta["etag"] = srcInfo.ETag
-		if _, err = fsMeta.WriteTo(wlk); err != nil {
-			return oi, toObjectErr(err, srcBucket, srcObject)
-		}
-
 		// Stat the file to get file size.
 		fi, err := fsStatFile(ctx, pathJoin(fs.fsPath, srcBucket, srcObject))
 		if err != nil {
 			return oi, toObjectErr(err, srcBucket, srcObject)
 		}
 
+		// Reject the update if the object has changed since srcInfo was read.
+		if srcOpts.CheckPrecondFn != nil && srcOpts.CheckPrecondFn(fsMeta.ToObjectInfo(srcBucket, srcObject, fi)) {
+			return oi, PreConditionFailed{}
+		}
+
+		fsMeta.Meta = cloneMSS(srcInfo.UserDefined)
+		fsMeta.Meta["etag"] = srcInfo.ETag
+		if _, err = fsMeta.WriteTo(wlk); err != nil {
+			return oi, toObjectErr(err, srcBucket, srcObject)
+		}
+
 		// Return the new object info.
 		return fsMeta.ToObjectInfo(srcBucket, srcObject, fi), nil
 	}
@@ -274,6 +274,7 @@ func StartGateway(ctx *cli.Context, gw Gateway) {
 
 	httpServer := xhttp.NewServer([]string{GlobalCLIContext.Addr},
 		criticalErrorHandler{corsHandler(router)}, getCert)
+	requestClientCerts(httpServer)
 	httpServer.BaseContext = func(listener net.Listener) context.Context {
 		return GlobalContext
 	}
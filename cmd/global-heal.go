@@ -169,6 +169,9 @@ func (er *erasureObjects) healErasureSet(ctx context.Context, buckets []BucketIn
 		if tracker.isHealed(bucket.Name) {
 			continue
 		}
+		if err := tracker.checkPaused(ctx); err != nil {
+			return err
+		}
 		var forwardTo string
 		// If we resume to the same bucket, forward to last known item.
 		if tracker.Bucket != "" {
@@ -202,6 +205,8 @@ func (er *erasureObjects) healErasureSet(ctx context.Context, buckets []BucketIn
 			disks = disks[:3]
 		}
 
+		listCtx, cancel := context.WithCancel(ctx)
+		var paused bool
 		healEntry := func(entry metaCacheEntry) {
 			if entry.isDir() {
 				return
@@ -225,6 +230,16 @@ func (er *erasureObjects) healErasureSet(ctx context.Context, buckets []BucketIn
 				logger.LogIf(ctx, err)
 				return
 			}
+			if paused {
+				return
+			}
+			if tracker.checkPaused(ctx) == errHealPaused {
+				// Stop listing, the bucket is resumed from
+				// the last healed object.
+				paused = true
+				cancel()
+				return
+			}
 			waitForLowHTTPReq(globalHealConfig.IOCount, globalHealConfig.Sleep)
 			for _, version := range fivs.Versions {
 				if _, err := er.HealObject(ctx, bucket.Name, version.Name, version.VersionID, madmin.HealOpts{
@@ -254,7 +269,7 @@ func (er *erasureObjects) healErasureSet(ctx context.Context, buckets []BucketIn
 			bucket:    bucket.Name,
 		}
 
-		err := listPathRaw(ctx, listPathRawOptions{
+		err := listPathRaw(listCtx, listPathRawOptions{
 			disks:          disks,
 			bucket:         bucket.Name,
 			recursive:      true,
@@ -270,6 +285,11 @@ func (er *erasureObjects) healErasureSet(ctx context.Context, buckets []BucketIn
 			},
 			finished: nil,
 		})
+		cancel()
+
+		if paused {
+			return errHealPaused
+		}
 
 		select {
 		// If context is canceled don't mark as done...
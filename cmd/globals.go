@@ -31,6 +31,8 @@ import (
 	"minio/cmd/config/compress"
 	xldap "minio/cmd/config/identity/ldap"
 	"minio/cmd/config/identity/openid"
+	idplugin "minio/cmd/config/identity/plugin"
+	xtls "minio/cmd/config/identity/tls"
 	"minio/cmd/config/policy/opa"
 	"minio/cmd/config/storageclass"
 	xhttp "minio/cmd/http"
@@ -156,16 +158,19 @@ var (
 	globalPolicySys         *PolicySys
 	GlobalIAMSys            *IAMSys
 
-	globalLifecycleSys       *LifecycleSys
-	globalBucketSSEConfigSys *BucketSSEConfigSys
-	globalBucketTargetSys    *BucketTargetSys
+	globalLifecycleSys          *LifecycleSys
+	globalBucketSSEConfigSys    *BucketSSEConfigSys
+	globalKMSKeyRotationSys     *KMSKeyRotationSys
+	globalKMSConfigMigrationSys *KMSConfigMigrationSys
+	globalBucketTargetSys       *BucketTargetSys
 	// globalAPIConfig controls S3 API requests throttling,
 	// healthcheck readiness deadlines and cors settings.
 	globalAPIConfig = apiConfig{listQuorum: 3}
 
-	globalStorageClass storageclass.Config
-	globalLDAPConfig   xldap.Config
-	globalOpenIDConfig openid.Config
+	globalStorageClass      storageclass.Config
+	globalLDAPConfig        xldap.Config
+	globalOpenIDConfig      openid.Config
+	globalTLSIdentityConfig xtls.Config
 
 	// CA root certificates, a nil value means system certs pool will be used
 	globalRootCAs *x509.CertPool
@@ -250,12 +255,15 @@ var (
 	// Some standard content-types which we strictly dis-allow for compression.
 	standardExcludeCompressContentTypes = []string{"video/*", "audio/*", "application/zip", "application/x-gzip", "application/x-zip-compressed", " application/x-compress", "application/x-spoon"}
 
-	// Authorization validators list.
-	globalOpenIDValidators *openid.Validators
+	// Enabled OpenID providers indexed by config target name.
+	globalOpenIDProviders openid.Providers
 
 	// OPA policy system.
 	GlobalPolicyOPA *opa.Opa
 
+	// Identity plugin validating custom tokens.
+	globalIdentityPlugin *idplugin.Plugin
+
 	// Deployment ID - unique per deployment
 	globalDeploymentID string
 
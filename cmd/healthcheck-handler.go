@@ -24,7 +24,10 @@ import (
 	xhttp "minio/cmd/http"
 )
 
-const unavailable = "offline"
+const (
+	unavailable      = "offline"
+	underMaintenance = "maintenance"
+)
 
 // ClusterCheckHandler returns if the server is ready for requests.
 func ClusterCheckHandler(w http.ResponseWriter, r *http.Request) {
@@ -87,13 +90,21 @@ func ClusterReadCheckHandler(w http.ResponseWriter, r *http.Request) {
 	writeResponse(w, http.StatusOK, nil, mimeNone)
 }
 
-// ReadinessCheckHandler Checks if the process is up. Always returns success.
+// ReadinessCheckHandler Checks if the process is up. Always returns success,
+// unless the server is in maintenance.
 func ReadinessCheckHandler(w http.ResponseWriter, r *http.Request) {
 	if shouldProxy() {
 		// Service not initialized yet
 		w.Header().Set(xhttp.MinIOServerStatus, unavailable)
 	}
 
+	// A server in maintenance is taken out of load balancing.
+	if globalMaintenance.localInMaintenance() {
+		w.Header().Set(xhttp.MinIOServerStatus, underMaintenance)
+		writeResponse(w, http.StatusServiceUnavailable, nil, mimeNone)
+		return
+	}
+
 	writeResponse(w, http.StatusOK, nil, mimeNone)
 }
 
@@ -0,0 +1,107 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"minio/cmd/logger"
+)
+
+const ldapSyncLockFile = minioConfigPrefix + "/iam-ldap-sync.lock"
+
+var ldapSyncLockTimeout = newDynamicTimeout(5*time.Second, time.Second)
+
+// periodicLDAPSync - removes the policy mappings of users and groups
+// deleted from the LDAP directory at the configured sync interval. Only
+// the server holding the sync lock performs a sync.
+func (sys *IAMSys) periodicLDAPSync(ctx context.Context, objAPI ObjectLayer) {
+	interval := globalLDAPConfig.GetSyncInterval()
+	if interval <= 0 {
+		return
+	}
+
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			locker := objAPI.NewNSLock(minioMetaBucket, ldapSyncLockFile)
+			if _, err := locker.GetLock(ctx, ldapSyncLockTimeout); err == nil {
+				logger.LogIf(ctx, sys.syncLDAPPolicyMappings(ctx))
+				locker.Unlock()
+			}
+			timer.Reset(interval)
+		}
+	}
+}
+
+// syncLDAPPolicyMappings - removes the policy mappings of all users and
+// groups that no longer exist in the LDAP directory and notifies the
+// peers about the removed mappings.
+func (sys *IAMSys) syncLDAPPolicyMappings(ctx context.Context) error {
+	if !sys.Initialized() {
+		return errServerNotInitialized
+	}
+
+	sys.store.rlock()
+	var users, groups []string
+	for name := range sys.iamUserPolicyMap {
+		if _, ok := sys.iamUsersMap[name]; ok {
+			// Policy mapping of an access key, not of an LDAP user.
+			continue
+		}
+		users = append(users, name)
+	}
+	for name := range sys.iamGroupPolicyMap {
+		groups = append(groups, name)
+	}
+	sys.store.runlock()
+
+	deletedUsers, err := globalLDAPConfig.GetNonExistentDNs(users)
+	if err != nil {
+		return err
+	}
+	deletedGroups, err := globalLDAPConfig.GetNonExistentDNs(groups)
+	if err != nil {
+		return err
+	}
+
+	removeMapping := func(name string, isGroup bool) {
+		if err := sys.PolicyDBSet(name, "", isGroup); err != nil {
+			logger.LogIf(ctx, err)
+			return
+		}
+		logger.Info("Removed policy mapping of %s deleted from LDAP directory", name)
+		for _, nerr := range GlobalNotificationSys.LoadPolicyMapping(name, isGroup) {
+			if nerr.Err != nil {
+				logger.GetReqInfo(ctx).SetTags("peerAddress", nerr.Host.String())
+				logger.LogIf(ctx, nerr.Err)
+			}
+		}
+	}
+	for _, user := range deletedUsers {
+		removeMapping(user, false)
+	}
+	for _, group := range deletedGroups {
+		removeMapping(group, true)
+	}
+	return nil
+}
@@ -17,7 +17,6 @@
 package cmd
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -25,14 +24,10 @@ import (
 	"strings"
 	"sync"
 	"time"
-	"unicode/utf8"
 
-	"minio/cmd/config"
 	"minio/cmd/logger"
 	"minio/pkg/auth"
 	iampolicy "minio/pkg/iam/policy"
-	"minio/pkg/kms"
-	"minio/pkg/madmin"
 )
 
 // IAMObjectStore implements IAMStorageAPI
@@ -209,13 +204,9 @@ func (iamOS *IAMObjectStore) saveIAMConfig(ctx context.Context, item interface{}
 	if err != nil {
 		return err
 	}
-	if GlobalKMS != nil {
-		data, err = config.EncryptBytes(GlobalKMS, data, kms.Context{
-			minioMetaBucket: path.Join(minioMetaBucket, objPath),
-		})
-		if err != nil {
-			return err
-		}
+	data, err = encryptConfig(objPath, data)
+	if err != nil {
+		return err
 	}
 	return saveConfig(ctx, iamOS.objAPI, objPath, data)
 }
@@ -225,23 +216,9 @@ func (iamOS *IAMObjectStore) loadIAMConfig(ctx context.Context, item interface{}
 	if err != nil {
 		return err
 	}
-	if !utf8.Valid(data) {
-		if GlobalKMS != nil {
-			data, err = config.DecryptBytes(GlobalKMS, data, kms.Context{
-				minioMetaBucket: path.Join(minioMetaBucket, objPath),
-			})
-			if err != nil {
-				data, err = madmin.DecryptData(globalActiveCred.String(), bytes.NewReader(data))
-				if err != nil {
-					return err
-				}
-			}
-		} else {
-			data, err = madmin.DecryptData(globalActiveCred.String(), bytes.NewReader(data))
-			if err != nil {
-				return err
-			}
-		}
+	data, err = decryptConfig(objPath, data)
+	if err != nil {
+		return err
 	}
 	return json.Unmarshal(data, item)
 }
@@ -611,6 +611,10 @@ func (sys *IAMSys) Init(ctx context.Context, objAPI ObjectLayer) {
 	globalOldCred = auth.Credentials{}
 	go sys.store.watch(ctx, sys)
 
+	if globalLDAPConfig.Enabled {
+		go sys.periodicLDAPSync(ctx, objAPI)
+	}
+
 	logger.Info("IAM initialization complete")
 }
 
@@ -2000,7 +2004,11 @@ func (sys *IAMSys) IsAllowedLDAPSTS(args iampolicy.Args, parentUser string) bool
 func (sys *IAMSys) IsAllowedSTS(args iampolicy.Args, parentUser string) bool {
 	// If it is an LDAP request, check that user and group
 	// policies allow the request.
-	if sys.usersSysType == LDAPUsersSysType {
+	// Certificate and custom token credentials carry their policy
+	// like OpenID credentials, also when LDAP is configured.
+	_, isCert := args.Claims[certCommonName]
+	_, isCustomToken := args.Claims[customTokenUser]
+	if !isCert && !isCustomToken && sys.usersSysType == LDAPUsersSysType {
 		return sys.IsAllowedLDAPSTS(args, parentUser)
 	}
 
@@ -0,0 +1,198 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"minio/cmd/logger"
+	"minio/pkg/madmin"
+)
+
+const (
+	kmsConfigMigrationFile     = "kms-config-migration.json"
+	kmsConfigMigrationLockFile = "kms-config-migration.lock"
+)
+
+var (
+	errKMSConfigMigrationInProgress = AdminError{
+		Code:       "XMinioKMSConfigMigrationInProgress",
+		Message:    "A migration of the config encryption is already in progress",
+		StatusCode: http.StatusConflict,
+	}
+	errKMSConfigMigrationNotFound = AdminError{
+		Code:       "XMinioKMSConfigMigrationNotFound",
+		Message:    "No migration of the config encryption has been started",
+		StatusCode: http.StatusNotFound,
+	}
+)
+
+// KMSConfigMigrationSys - re-encrypts the server config, IAM data and
+// bucket metadata stored in .minio.sys with DEKs generated by the KMS
+// while the servers keep running. Objects encrypted with the root
+// credentials or stored in plaintext are migrated, objects written in
+// the meantime are already encrypted with the KMS.
+//
+// A migration runs on the node it was started on while holding a
+// cluster wide lock. It can be restarted at any time since objects
+// already encrypted with the KMS are skipped.
+type KMSConfigMigrationSys struct {
+	mu     sync.Mutex
+	status *madmin.KMSConfigMigrationStatus
+}
+
+// NewKMSConfigMigrationSys - creates new KMS config migration system.
+func NewKMSConfigMigrationSys() *KMSConfigMigrationSys {
+	return &KMSConfigMigrationSys{}
+}
+
+func loadKMSConfigMigrationStatus(ctx context.Context, objAPI ObjectLayer) (status madmin.KMSConfigMigrationStatus, err error) {
+	data, err := readConfig(ctx, objAPI, kmsConfigMigrationFile)
+	if err != nil {
+		return status, err
+	}
+	if err = json.Unmarshal(data, &status); err != nil {
+		return status, err
+	}
+	status.Running = !status.Finished && time.Since(status.LastUpdate) < 2*kmsKeyRotationSaveInterval
+	return status, nil
+}
+
+func saveKMSConfigMigrationStatus(ctx context.Context, objAPI ObjectLayer, status madmin.KMSConfigMigrationStatus) error {
+	data, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	return saveConfig(ctx, objAPI, kmsConfigMigrationFile, data)
+}
+
+// Start - starts migrating all config objects to KMS encryption.
+func (sys *KMSConfigMigrationSys) Start(ctx context.Context, objAPI ObjectLayer) (madmin.KMSConfigMigrationStatus, error) {
+	sys.mu.Lock()
+	defer sys.mu.Unlock()
+
+	if sys.status != nil {
+		return madmin.KMSConfigMigrationStatus{}, errKMSConfigMigrationInProgress
+	}
+
+	locker := objAPI.NewNSLock(minioMetaBucket, kmsConfigMigrationLockFile)
+	lkctx, err := locker.GetLock(ctx, kmsKeyRotationLockTimeout)
+	if err != nil {
+		return madmin.KMSConfigMigrationStatus{}, errKMSConfigMigrationInProgress
+	}
+
+	status := madmin.KMSConfigMigrationStatus{
+		Running:    true,
+		Started:    UTCNow(),
+		LastUpdate: UTCNow(),
+	}
+	if err = saveKMSConfigMigrationStatus(lkctx, objAPI, status); err != nil {
+		locker.Unlock()
+		return madmin.KMSConfigMigrationStatus{}, err
+	}
+
+	sys.status = &status
+	go func() {
+		defer func() {
+			locker.Unlock()
+			sys.mu.Lock()
+			sys.status = nil
+			sys.mu.Unlock()
+		}()
+		sys.run(lkctx, objAPI)
+	}()
+	return status, nil
+}
+
+// Status - returns the progress of the latest migration.
+func (sys *KMSConfigMigrationSys) Status(ctx context.Context, objAPI ObjectLayer) (madmin.KMSConfigMigrationStatus, error) {
+	sys.mu.Lock()
+	if sys.status != nil {
+		status := *sys.status
+		sys.mu.Unlock()
+		return status, nil
+	}
+	sys.mu.Unlock()
+
+	status, err := loadKMSConfigMigrationStatus(ctx, objAPI)
+	if errors.Is(err, errConfigNotFound) {
+		return status, errKMSConfigMigrationNotFound
+	}
+	return status, err
+}
+
+func (sys *KMSConfigMigrationSys) run(ctx context.Context, objAPI ObjectLayer) {
+	save := func() {
+		sys.mu.Lock()
+		sys.status.LastUpdate = UTCNow()
+		status := *sys.status
+		sys.mu.Unlock()
+		logger.LogIf(ctx, saveKMSConfigMigrationStatus(ctx, objAPI, status))
+	}
+
+	lastSave := time.Now()
+	err := listConfigToMigrate(ctx, objAPI, func(objPath string, binary bool) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		migrated, err := migrateConfigEncryption(ctx, objAPI, objPath, binary)
+		if err != nil {
+			logger.LogIf(ctx, err)
+		}
+
+		sys.mu.Lock()
+		switch {
+		case err != nil:
+			sys.status.Failed++
+		case migrated:
+			sys.status.Migrated++
+		default:
+			sys.status.Skipped++
+		}
+		sys.mu.Unlock()
+
+		if time.Since(lastSave) > kmsKeyRotationSaveInterval {
+			save()
+			lastSave = time.Now()
+		}
+		return nil
+	})
+
+	sys.mu.Lock()
+	sys.status.Running = false
+	if err != nil {
+		sys.status.Error = err.Error()
+	} else {
+		sys.status.Finished = true
+	}
+	failed := sys.status.Failed
+	sys.mu.Unlock()
+	save()
+
+	// All config has been migrated, there is nothing
+	// left to migrate when the servers restart.
+	if err == nil && failed == 0 {
+		if err = deleteConfig(ctx, objAPI, backendEncryptedFile); err != nil && !errors.Is(err, errConfigNotFound) {
+			logger.LogIf(ctx, err)
+		}
+	}
+}
@@ -0,0 +1,342 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"path"
+	"sync"
+	"time"
+
+	"minio/cmd/crypto"
+	"minio/cmd/logger"
+	"minio/cmd/logger/message/audit"
+	"minio/pkg/madmin"
+)
+
+const (
+	kmsKeyRotationFile     = "kms-key-rotation.json"
+	kmsKeyRotationLockFile = "kms-key-rotation.lock"
+
+	// Progress is persisted at this interval, a rotation whose
+	// status has not been updated for twice as long is not running.
+	kmsKeyRotationSaveInterval = 30 * time.Second
+)
+
+var kmsKeyRotationLockTimeout = newDynamicTimeout(5*time.Second, time.Second)
+
+var (
+	errKMSKeyRotationInProgress = AdminError{
+		Code:       "XMinioKMSKeyRotationInProgress",
+		Message:    "A key rotation is already in progress for this bucket",
+		StatusCode: http.StatusConflict,
+	}
+	errKMSKeyRotationNotFound = AdminError{
+		Code:       "XMinioKMSKeyRotationNotFound",
+		Message:    "No key rotation has been started for this bucket",
+		StatusCode: http.StatusNotFound,
+	}
+)
+
+// KMSKeyRotationSys - re-seals the object keys of SSE-S3 and SSE-KMS
+// objects with the current version of their KMS master key, e.g. after
+// the master key has been rotated at the KMS. Only the sealed keys in
+// the object metadata are updated, the object data is not re-encrypted.
+//
+// A rotation runs on the node it was started on while holding a cluster
+// wide lock for the bucket. Its progress is persisted such that an
+// interrupted rotation is resumed on server startup.
+type KMSKeyRotationSys struct {
+	mu   sync.Mutex
+	jobs map[string]*kmsKeyRotationJob
+}
+
+type kmsKeyRotationJob struct {
+	mu     sync.Mutex
+	status madmin.KMSKeyRotationStatus
+}
+
+// NewKMSKeyRotationSys - creates new KMS key rotation system.
+func NewKMSKeyRotationSys() *KMSKeyRotationSys {
+	return &KMSKeyRotationSys{
+		jobs: make(map[string]*kmsKeyRotationJob),
+	}
+}
+
+func kmsKeyRotationConfigFile(bucket string) string {
+	return path.Join(bucketMetaPrefix, bucket, kmsKeyRotationFile)
+}
+
+func loadKMSKeyRotationStatus(ctx context.Context, objAPI ObjectLayer, bucket string) (status madmin.KMSKeyRotationStatus, err error) {
+	data, err := readConfig(ctx, objAPI, kmsKeyRotationConfigFile(bucket))
+	if err != nil {
+		return status, err
+	}
+	if err = json.Unmarshal(data, &status); err != nil {
+		return status, err
+	}
+	status.Running = !status.Finished && time.Since(status.LastUpdate) < 2*kmsKeyRotationSaveInterval
+	return status, nil
+}
+
+func saveKMSKeyRotationStatus(ctx context.Context, objAPI ObjectLayer, status madmin.KMSKeyRotationStatus) error {
+	data, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	return saveConfig(ctx, objAPI, kmsKeyRotationConfigFile(status.Bucket), data)
+}
+
+// Start - starts re-sealing the object keys of all object versions in
+// bucket under prefix. If keyID is not empty only objects encrypted with
+// this master key are updated. An unfinished rotation with the same
+// prefix and key ID is resumed from the last processed object.
+func (sys *KMSKeyRotationSys) Start(ctx context.Context, objAPI ObjectLayer, bucket, prefix, keyID string) (madmin.KMSKeyRotationStatus, error) {
+	sys.mu.Lock()
+	defer sys.mu.Unlock()
+
+	if _, ok := sys.jobs[bucket]; ok {
+		return madmin.KMSKeyRotationStatus{}, errKMSKeyRotationInProgress
+	}
+
+	locker := objAPI.NewNSLock(minioMetaBucket, path.Join(bucketMetaPrefix, bucket, kmsKeyRotationLockFile))
+	lkctx, err := locker.GetLock(ctx, kmsKeyRotationLockTimeout)
+	if err != nil {
+		return madmin.KMSKeyRotationStatus{}, errKMSKeyRotationInProgress
+	}
+
+	status, err := loadKMSKeyRotationStatus(lkctx, objAPI, bucket)
+	switch {
+	case errors.Is(err, errConfigNotFound):
+	case err != nil:
+		locker.Unlock()
+		return madmin.KMSKeyRotationStatus{}, err
+	}
+	if status.Finished || status.Prefix != prefix || status.KeyID != keyID {
+		status = madmin.KMSKeyRotationStatus{
+			Bucket:  bucket,
+			Prefix:  prefix,
+			KeyID:   keyID,
+			Started: UTCNow(),
+		}
+	}
+	status.Running = true
+	status.Error = ""
+	status.LastUpdate = UTCNow()
+	if err = saveKMSKeyRotationStatus(lkctx, objAPI, status); err != nil {
+		locker.Unlock()
+		return madmin.KMSKeyRotationStatus{}, err
+	}
+
+	job := &kmsKeyRotationJob{status: status}
+	sys.jobs[bucket] = job
+	go func() {
+		defer func() {
+			locker.Unlock()
+			sys.mu.Lock()
+			delete(sys.jobs, bucket)
+			sys.mu.Unlock()
+		}()
+		job.run(lkctx, objAPI)
+	}()
+	return status, nil
+}
+
+// Status - returns the progress of the latest rotation of bucket.
+func (sys *KMSKeyRotationSys) Status(ctx context.Context, objAPI ObjectLayer, bucket string) (madmin.KMSKeyRotationStatus, error) {
+	sys.mu.Lock()
+	job, ok := sys.jobs[bucket]
+	sys.mu.Unlock()
+	if ok {
+		return job.getStatus(), nil
+	}
+
+	status, err := loadKMSKeyRotationStatus(ctx, objAPI, bucket)
+	if errors.Is(err, errConfigNotFound) {
+		return status, errKMSKeyRotationNotFound
+	}
+	return status, err
+}
+
+func (job *kmsKeyRotationJob) getStatus() madmin.KMSKeyRotationStatus {
+	job.mu.Lock()
+	defer job.mu.Unlock()
+	return job.status
+}
+
+func (job *kmsKeyRotationJob) run(ctx context.Context, objAPI ObjectLayer) {
+	status := job.getStatus()
+	bucket, marker := status.Bucket, status.LastObject
+
+	save := func() {
+		job.mu.Lock()
+		job.status.LastUpdate = UTCNow()
+		status := job.status
+		job.mu.Unlock()
+		logger.LogIf(ctx, saveKMSKeyRotationStatus(ctx, objAPI, status))
+	}
+
+	objInfoCh := make(chan ObjectInfo)
+	if err := objAPI.Walk(ctx, bucket, status.Prefix, objInfoCh, ObjectOptions{WalkVersions: true}); err != nil {
+		job.mu.Lock()
+		job.status.Running = false
+		job.status.Error = err.Error()
+		job.mu.Unlock()
+		save()
+		return
+	}
+
+	lastSave := time.Now()
+	for objInfo := range objInfoCh {
+		if ctx.Err() != nil {
+			// Drain the channel to let the walker exit.
+			continue
+		}
+		if objInfo.DeleteMarker || objInfo.Name < marker {
+			continue
+		}
+
+		rotated, err := rotateObjectKMSKey(ctx, objAPI, bucket, objInfo, status.KeyID)
+		if err != nil {
+			logger.LogIf(ctx, err)
+		}
+
+		job.mu.Lock()
+		switch {
+		case err != nil:
+			job.status.Failed++
+		case rotated:
+			job.status.Rotated++
+		default:
+			job.status.Skipped++
+		}
+		job.status.LastObject = objInfo.Name
+		job.mu.Unlock()
+
+		if time.Since(lastSave) > kmsKeyRotationSaveInterval {
+			save()
+			lastSave = time.Now()
+		}
+	}
+
+	if ctx.Err() != nil {
+		// Either the server is shutting down or the lock was lost,
+		// the rotation is resumed from the last saved object.
+		return
+	}
+
+	job.mu.Lock()
+	job.status.Running = false
+	job.status.Finished = true
+	job.mu.Unlock()
+	save()
+}
+
+// rotateObjectKMSKey - re-seals the object key of one object version and
+// updates its metadata in place. Returns false if the object is not
+// encrypted with the KMS, not encrypted with keyID or has been modified
+// in the meantime.
+func rotateObjectKMSKey(ctx context.Context, objAPI ObjectLayer, bucket string, objInfo ObjectInfo, keyID string) (bool, error) {
+	opts := ObjectOptions{VersionID: objInfo.VersionID}
+	oi, err := objAPI.GetObjectInfo(ctx, bucket, objInfo.Name, opts)
+	if err != nil {
+		if isErrObjectNotFound(err) || isErrVersionNotFound(err) || errors.Is(err, errMethodNotAllowed) {
+			return false, nil
+		}
+		return false, err
+	}
+	if oi.DeleteMarker {
+		return false, nil
+	}
+	if keyID != "" && oi.UserDefined[crypto.MetaKeyID] != keyID {
+		return false, nil
+	}
+
+	metadata := cloneMSS(oi.UserDefined)
+	if err = rewrapObjectKey(bucket, oi.Name, metadata); err != nil {
+		if errors.Is(err, errKMSObjectNotEncrypted) {
+			return false, nil
+		}
+		auditLogKMSKeyRotation(ctx, bucket, oi, err)
+		return false, err
+	}
+
+	// Only update the metadata if the object version has not been
+	// overwritten after it was read.
+	srcOpts := ObjectOptions{
+		VersionID: oi.VersionID,
+		CheckPrecondFn: func(o ObjectInfo) bool {
+			return o.ETag != oi.ETag || !o.ModTime.Equal(oi.ModTime)
+		},
+	}
+	oi.UserDefined = metadata
+	oi.metadataOnly = true // Perform only metadata updates.
+	if _, err = objAPI.CopyObject(ctx, bucket, oi.Name, bucket, oi.Name, oi, srcOpts, ObjectOptions{VersionID: oi.VersionID}); err != nil {
+		if isErrPreconditionFailed(err) || isErrObjectNotFound(err) || isErrVersionNotFound(err) {
+			return false, nil
+		}
+		auditLogKMSKeyRotation(ctx, bucket, oi, err)
+		return false, err
+	}
+	auditLogKMSKeyRotation(ctx, bucket, oi, nil)
+	return true, nil
+}
+
+func auditLogKMSKeyRotation(ctx context.Context, bucket string, objInfo ObjectInfo, err error) {
+	entry := audit.NewEntry(globalDeploymentID)
+	entry.Trigger = "internal-kms-key-rotation"
+	entry.API.Name = "RotateObjectKey"
+	entry.API.Bucket = bucket
+	entry.API.Object = objInfo.Name
+	entry.Tags = map[string]interface{}{
+		"versionId": objInfo.VersionID,
+		"kmsKeyId":  objInfo.UserDefined[crypto.MetaKeyID],
+	}
+	if err != nil {
+		entry.Tags["error"] = err.Error()
+	}
+	ctx = logger.SetAuditEntry(ctx, &entry)
+	logger.AuditLog(ctx, nil, nil, nil)
+}
+
+// initKMSKeyRotation - resumes all unfinished key rotations.
+func initKMSKeyRotation(ctx context.Context, objAPI ObjectLayer) {
+	if GlobalKMS == nil {
+		return
+	}
+	go func() {
+		buckets, err := objAPI.ListBuckets(ctx)
+		if err != nil {
+			logger.LogIf(ctx, err)
+			return
+		}
+		for _, bucket := range buckets {
+			status, err := loadKMSKeyRotationStatus(ctx, objAPI, bucket.Name)
+			if err != nil || status.Finished {
+				continue
+			}
+			// The bucket lock lets only one node resume the rotation.
+			_, err = globalKMSKeyRotationSys.Start(ctx, objAPI, bucket.Name, status.Prefix, status.KeyID)
+			if err != nil && !errors.Is(err, errKMSKeyRotationInProgress) {
+				logger.LogIf(ctx, err)
+			}
+		}
+	}()
+}
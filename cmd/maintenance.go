@@ -0,0 +1,380 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"minio/cmd/logger"
+	"minio/pkg/madmin"
+)
+
+const (
+	// maintenanceConfigFile holds the nodes in maintenance,
+	// so that it survives restarts of the cluster.
+	maintenanceConfigFile = "maintenance.json"
+
+	// Maintenance actions of a node.
+	maintenanceStart = "start"
+	maintenanceStop  = "stop"
+
+	defaultMaintenanceDrainTimeout = time.Minute
+	maintenanceDrainCheckInterval  = 100 * time.Millisecond
+
+	// Number of times a maintenance action is retried on
+	// the peers which failed to apply it, and the delay
+	// between the retries.
+	maintenancePeerRetries    = 3
+	maintenancePeerRetryDelay = time.Second
+)
+
+var (
+	errMaintenanceWriteQuorum  = errors.New("taking the node down for maintenance would lose write quorum")
+	errMaintenanceDrainTimeout = errors.New("timed out waiting for in-flight requests to drain")
+	errMaintenancePeers        = errors.New("maintenance state could not be applied on all servers")
+)
+
+// inflightWrites is the number of write requests in progress
+// admitted by setMaintenanceHandler.
+var inflightWrites int64
+
+// maintenanceState holds the nodes in maintenance. A node in maintenance
+// is read-only and its drives are left out of new writes by all nodes.
+type maintenanceState struct {
+	mu    sync.RWMutex
+	nodes map[string]time.Time
+}
+
+var globalMaintenance = newMaintenanceState()
+
+func newMaintenanceState() *maintenanceState {
+	return &maintenanceState{nodes: make(map[string]time.Time)}
+}
+
+// start puts a node in maintenance, returns false if it already is.
+func (m *maintenanceState) start(node string, since time.Time) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.nodes[node]; ok {
+		return false
+	}
+	m.nodes[node] = since
+	return true
+}
+
+// stop takes a node out of maintenance, returns false if it was not.
+func (m *maintenanceState) stop(node string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.nodes[node]; !ok {
+		return false
+	}
+	delete(m.nodes, node)
+	return true
+}
+
+func (m *maintenanceState) inMaintenance(node string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, ok := m.nodes[node]
+	return ok
+}
+
+// localInMaintenance returns if this node is in maintenance.
+func (m *maintenanceState) localInMaintenance() bool {
+	return globalIsDistErasure && m.inMaintenance(globalLocalNodeName)
+}
+
+// nodesWith returns the nodes in maintenance along with node.
+func (m *maintenanceState) nodesWith(node string) map[string]bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	nodes := make(map[string]bool, len(m.nodes)+1)
+	for n := range m.nodes {
+		nodes[n] = true
+	}
+	nodes[node] = true
+	return nodes
+}
+
+// writeDisks returns the disks to write new data to, the disks of the
+// nodes in maintenance are treated as offline. Writes then proceed as
+// long as write quorum is met and the objects are healed by the MRF
+// once the nodes are back.
+func (m *maintenanceState) writeDisks(disks []StorageAPI) []StorageAPI {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if len(m.nodes) == 0 {
+		return disks
+	}
+	newDisks := make([]StorageAPI, len(disks))
+	for i, disk := range disks {
+		if disk == nil {
+			continue
+		}
+		if _, ok := m.nodes[disk.Endpoint().Host]; !ok {
+			newDisks[i] = disk
+		}
+	}
+	return newDisks
+}
+
+func (m *maintenanceState) status() madmin.MaintenanceStatus {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	status := madmin.MaintenanceStatus{Nodes: make([]madmin.MaintenanceNode, 0, len(m.nodes))}
+	for node, since := range m.nodes {
+		status.Nodes = append(status.Nodes, madmin.MaintenanceNode{Node: node, Since: since})
+	}
+	sort.Slice(status.Nodes, func(i, j int) bool {
+		return status.Nodes[i].Node < status.Nodes[j].Node
+	})
+	return status
+}
+
+// save persists the nodes in maintenance, encrypted
+// like all other objects under the config prefix.
+func (m *maintenanceState) save(ctx context.Context, objAPI ObjectLayer) error {
+	m.mu.RLock()
+	data, err := json.Marshal(m.nodes)
+	m.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+	configFile := pathJoin(minioConfigPrefix, maintenanceConfigFile)
+	if data, err = encryptConfig(configFile, data); err != nil {
+		return err
+	}
+	return saveConfig(ctx, objAPI, configFile, data)
+}
+
+// load restores the nodes in maintenance persisted by save.
+func (m *maintenanceState) load(ctx context.Context, objAPI ObjectLayer) error {
+	configFile := pathJoin(minioConfigPrefix, maintenanceConfigFile)
+	data, err := readConfig(ctx, objAPI, configFile)
+	if err != nil {
+		if errors.Is(err, errConfigNotFound) {
+			return nil
+		}
+		return err
+	}
+	if data, err = decryptConfig(configFile, data); err != nil {
+		return err
+	}
+	nodes := make(map[string]time.Time)
+	if err = json.Unmarshal(data, &nodes); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	m.nodes = nodes
+	m.mu.Unlock()
+	return nil
+}
+
+// drainWriteRequests waits for the write requests in progress to
+// complete, reads are still served by a node in maintenance.
+func drainWriteRequests(ctx context.Context, timeout time.Duration) error {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(maintenanceDrainCheckInterval)
+	defer ticker.Stop()
+
+	for atomic.LoadInt64(&inflightWrites) > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-deadline.C:
+			return errMaintenanceDrainTimeout
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// setLocalMaintenance applies a maintenance action for node on
+// this server. The node itself drains its in-flight requests when put
+// in maintenance, and all servers heal the partial writes they recorded
+// for the node when it is back.
+func setLocalMaintenance(ctx context.Context, node, action string, drainTimeout time.Duration) error {
+	switch action {
+	case maintenanceStart:
+		if globalMaintenance.start(node, UTCNow()) {
+			logger.Info(fmt.Sprintf("node %s is in maintenance, its drives are offline for new writes", node))
+		}
+		if node == globalLocalNodeName {
+			return drainWriteRequests(ctx, drainTimeout)
+		}
+	case maintenanceStop:
+		if !globalMaintenance.stop(node) {
+			return nil
+		}
+		logger.Info(fmt.Sprintf("node %s is back from maintenance, healing partial writes", node))
+		if z, ok := newObjectLayerFn().(*erasureServerPools); ok {
+			z.healNodeMRF(node)
+		}
+	default:
+		return errInvalidArgument
+	}
+	return nil
+}
+
+// maxWriteQuorum returns the highest write quorum of new objects of
+// all configured storage classes in sets of setDriveCount drives,
+// i.e. the write quorum of the storage class with the lowest parity.
+func maxWriteQuorum(setDriveCount, defaultParityCount int) int {
+	maxQuorum := 0
+	for sc, parityDrives := range storageClassParities(defaultParityCount) {
+		dataDrives := setDriveCount - parityDrives
+		writeQuorum := dataDrives
+		if dataDrives == parityDrives || erasureAlgoForSC(sc, dataDrives, parityDrives) == LocalReconstruction {
+			writeQuorum++
+		}
+		if writeQuorum > maxQuorum {
+			maxQuorum = writeQuorum
+		}
+	}
+	return maxQuorum
+}
+
+// checkMaintenanceWriteQuorum returns an error if any erasure set would
+// be left without write quorum for new objects of any storage class
+// with the drives of nodes offline.
+func (z *erasureServerPools) checkMaintenanceWriteQuorum(nodes map[string]bool) error {
+	for _, pool := range z.serverPools {
+		writeQuorum := maxWriteQuorum(pool.setDriveCount, pool.defaultParityCount)
+		for setIndex := range pool.sets {
+			offline := 0
+			for i := 0; i < pool.setDriveCount; i++ {
+				if nodes[pool.endpoints[setIndex*pool.setDriveCount+i].Host] {
+					offline++
+				}
+			}
+			if pool.setDriveCount-offline < writeQuorum {
+				return errMaintenanceWriteQuorum
+			}
+		}
+	}
+	return nil
+}
+
+// applyMaintenance applies a maintenance action for node on all peers,
+// retrying on the peers which failed. The error of the node itself is
+// returned as is, failures of other peers as errMaintenancePeers since
+// they keep writing to the drives of the node until they apply it.
+func applyMaintenance(ctx context.Context, node, action string, drainTimeout time.Duration) error {
+	var failed []string
+	for i := 0; ; i++ {
+		failed = failed[:0]
+		for _, nerr := range GlobalNotificationSys.Maintenance(ctx, node, action, drainTimeout) {
+			if nerr.Err == nil {
+				continue
+			}
+			if nerr.Host.String() == node {
+				return nerr.Err
+			}
+			logger.LogIf(ctx, fmt.Errorf("unable to apply maintenance %s of %s on %s: %w", action, node, nerr.Host, nerr.Err))
+			failed = append(failed, nerr.Host.String())
+		}
+		if len(failed) == 0 || i == maintenancePeerRetries {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(maintenancePeerRetryDelay):
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("%w: %s", errMaintenancePeers, strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// rollbackMaintenance takes node out of maintenance again on all
+// servers after it failed to drain its writes when put in maintenance.
+func rollbackMaintenance(ctx context.Context, objAPI ObjectLayer, node string) error {
+	if err := setLocalMaintenance(ctx, node, maintenanceStop, 0); err != nil {
+		return err
+	}
+	if err := globalMaintenance.save(ctx, objAPI); err != nil {
+		return err
+	}
+	return applyMaintenance(ctx, node, maintenanceStop, 0)
+}
+
+// healNodeMRF schedules healing of the partial writes recorded
+// by the MRF for the erasure sets with drives of node.
+func (z *erasureServerPools) healNodeMRF(node string) {
+	for _, pool := range z.serverPools {
+		for setIndex := range pool.sets {
+			for i := 0; i < pool.setDriveCount; i++ {
+				if pool.endpoints[setIndex*pool.setDriveCount+i].Host != node {
+					continue
+				}
+				go func(s *erasureSets, setIndex int) {
+					select {
+					case s.setReconnectEvent <- setIndex:
+					case <-GlobalContext.Done():
+					}
+				}(pool, setIndex)
+				break
+			}
+		}
+	}
+}
+
+// isWriteReq returns if r is a request which is rejected
+// by a server in maintenance.
+func isWriteReq(r *http.Request) bool {
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return false
+	}
+	return !guessIsRPCReq(r) && !guessIsHealthCheckReq(r) && !guessIsMetricsReq(r) && !isAdminReq(r)
+}
+
+// setMaintenanceHandler rejects writes to a server in maintenance
+// and counts the writes in progress to be drained.
+func setMaintenanceHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !isWriteReq(r) {
+			h.ServeHTTP(w, r)
+			return
+		}
+		// Count the write before checking the state, such that a
+		// write admitted while maintenance starts is drained.
+		atomic.AddInt64(&inflightWrites, 1)
+		defer atomic.AddInt64(&inflightWrites, -1)
+		if globalMaintenance.localInMaintenance() {
+			WriteErrorResponse(r.Context(), w, APIError{
+				Code:           "XMinioServerInMaintenance",
+				Description:    "Server is in maintenance and read-only, please try again.",
+				HTTPStatusCode: http.StatusServiceUnavailable,
+			}, r.URL, guessIsBrowserReq(r))
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,178 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"minio/cmd/config/storageclass"
+)
+
+func TestMaintenanceState(t *testing.T) {
+	m := newMaintenanceState()
+	now := time.Now().UTC()
+
+	if !m.start("node1:9000", now) {
+		t.Fatal("expected node1:9000 to enter maintenance")
+	}
+	if m.start("node1:9000", now) {
+		t.Fatal("expected node1:9000 to already be in maintenance")
+	}
+	if !m.inMaintenance("node1:9000") || m.inMaintenance("node2:9000") {
+		t.Fatal("unexpected maintenance state")
+	}
+
+	nodes := m.nodesWith("node2:9000")
+	if len(nodes) != 2 || !nodes["node1:9000"] || !nodes["node2:9000"] {
+		t.Fatalf("unexpected nodes %v", nodes)
+	}
+	if m.inMaintenance("node2:9000") {
+		t.Fatal("nodesWith must not put the node in maintenance")
+	}
+
+	status := m.status()
+	if len(status.Nodes) != 1 || status.Nodes[0].Node != "node1:9000" || !status.Nodes[0].Since.Equal(now) {
+		t.Fatalf("unexpected status %v", status)
+	}
+
+	if !m.stop("node1:9000") {
+		t.Fatal("expected node1:9000 to leave maintenance")
+	}
+	if m.stop("node1:9000") {
+		t.Fatal("expected node1:9000 to already be out of maintenance")
+	}
+	if len(m.status().Nodes) != 0 {
+		t.Fatal("expected no nodes in maintenance")
+	}
+}
+
+func TestCheckMaintenanceWriteQuorum(t *testing.T) {
+	// 2 sets of 4 drives, one drive per node and set.
+	var endpoints Endpoints
+	for set := 0; set < 2; set++ {
+		for node := 1; node <= 4; node++ {
+			endpoints = append(endpoints, Endpoint{
+				URL: &url.URL{
+					Scheme: "http",
+					Host:   fmt.Sprintf("node%d:9000", node),
+					Path:   fmt.Sprintf("/data%d", set),
+				},
+			})
+		}
+	}
+
+	testCases := []struct {
+		parity int
+		nodes  []string
+		err    error
+	}{
+		{2, []string{"node1:9000"}, nil},
+		// Data and parity drives are equal, an extra drive is needed.
+		{2, []string{"node1:9000", "node2:9000"}, errMaintenanceWriteQuorum},
+		{1, []string{"node1:9000"}, nil},
+		{1, []string{"node1:9000", "node2:9000"}, errMaintenanceWriteQuorum},
+		{2, []string{"node5:9000"}, nil},
+	}
+
+	for i, tc := range testCases {
+		z := &erasureServerPools{serverPools: []*erasureSets{{
+			sets:               make([]*erasureObjects, 2),
+			endpoints:          endpoints,
+			setDriveCount:      4,
+			defaultParityCount: tc.parity,
+		}}}
+		nodes := make(map[string]bool)
+		for _, node := range tc.nodes {
+			nodes[node] = true
+		}
+		if err := z.checkMaintenanceWriteQuorum(nodes); !errors.Is(err, tc.err) {
+			t.Errorf("Test %d: expected %v, got %v", i+1, tc.err, err)
+		}
+	}
+
+	// One set of 8 drives, one drive per node, with the default parity.
+	endpoints = nil
+	for node := 1; node <= 8; node++ {
+		endpoints = append(endpoints, Endpoint{
+			URL: &url.URL{Scheme: "http", Host: fmt.Sprintf("node%d:9000", node), Path: "/data"},
+		})
+	}
+	z := &erasureServerPools{serverPools: []*erasureSets{{
+		sets:               make([]*erasureObjects, 1),
+		endpoints:          endpoints,
+		setDriveCount:      8,
+		defaultParityCount: 4,
+	}}}
+	nodes := map[string]bool{"node1:9000": true, "node2:9000": true}
+
+	// A storage class with a lower parity than the default
+	// needs more drives online for new objects.
+	defer globalStorageClass.Update(storageclass.Config{})
+	globalStorageClass.Update(storageclass.Config{
+		Custom: map[string]storageclass.StorageClass{"COLD": {Parity: 2}},
+	})
+	if err := z.checkMaintenanceWriteQuorum(nodes); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// COLD objects need 7 of the 8 drives online.
+	globalStorageClass.Update(storageclass.Config{
+		Custom: map[string]storageclass.StorageClass{"COLD": {Parity: 1}},
+	})
+	if err := z.checkMaintenanceWriteQuorum(nodes); !errors.Is(err, errMaintenanceWriteQuorum) {
+		t.Fatalf("Expected %v, got %v", errMaintenanceWriteQuorum, err)
+	}
+}
+
+func TestDrainWriteRequests(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{}, 2)
+	handler := setMaintenanceHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-release
+	}))
+
+	// Reads in progress are not drained.
+	go handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/bucket/object", nil))
+	<-started
+	if err := drainWriteRequests(context.Background(), time.Second); err != nil {
+		t.Fatalf("Expected no write requests to drain, got %v", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPut, "/bucket/object", nil))
+		close(done)
+	}()
+	<-started
+	if err := drainWriteRequests(context.Background(), 200*time.Millisecond); err != errMaintenanceDrainTimeout {
+		t.Fatalf("Expected %v, got %v", errMaintenanceDrainTimeout, err)
+	}
+
+	close(release)
+	<-done
+	if err := drainWriteRequests(context.Background(), time.Second); err != nil {
+		t.Fatalf("Expected the write requests to drain, got %v", err)
+	}
+}
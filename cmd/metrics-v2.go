@@ -45,6 +45,7 @@ const (
 	healMetricNamespace      MetricNamespace = "minio_heal"
 	interNodeMetricNamespace MetricNamespace = "minio_inter_node"
 	nodeMetricNamespace      MetricNamespace = "minio_node"
+	notifyMetricNamespace    MetricNamespace = "minio_notify"
 	minioMetricNamespace     MetricNamespace = "minio"
 	s3MetricNamespace        MetricNamespace = "minio_s3"
 )
@@ -67,6 +68,7 @@ const (
 	trafficSubsystem          MetricSubsystem = "traffic"
 	softwareSubsystem         MetricSubsystem = "software"
 	sysCallSubsystem          MetricSubsystem = "syscall"
+	targetSubsystem           MetricSubsystem = "target"
 	usageSubsystem            MetricSubsystem = "usage"
 )
 
@@ -86,6 +88,7 @@ const (
 	missedTotal    MetricName = "missed_total"
 	waitingTotal   MetricName = "waiting_total"
 	objectTotal    MetricName = "object_total"
+	inlineObjTotal MetricName = "inline_object_total"
 	offlineTotal   MetricName = "offline_total"
 	onlineTotal    MetricName = "online_total"
 	openTotal      MetricName = "open_total"
@@ -93,6 +96,14 @@ const (
 	timestampTotal MetricName = "timestamp_total"
 	writeTotal     MetricName = "write_total"
 	total          MetricName = "total"
+	timeoutsTotal  MetricName = "timeouts_total"
+
+	droppedTotal MetricName = "dropped_total"
+	failedTotal  MetricName = "failed_total"
+	sentTotal    MetricName = "sent_total"
+
+	deadLetterEvents MetricName = "deadletter_events"
+	queuedEvents     MetricName = "queued_events"
 
 	failedCount   MetricName = "failed_count"
 	failedBytes   MetricName = "failed_bytes"
@@ -104,22 +115,31 @@ const (
 	receivedBytes MetricName = "received_bytes"
 	sentBytes     MetricName = "sent_bytes"
 	totalBytes    MetricName = "total_bytes"
+	inlineBytes   MetricName = "inline_total_bytes"
 	usedBytes     MetricName = "used_bytes"
 	writeBytes    MetricName = "write_bytes"
 	wcharBytes    MetricName = "wchar_bytes"
 
 	usagePercent MetricName = "update_percent"
 
+	healthState MetricName = "health_state"
 	commitInfo  MetricName = "commit_info"
 	usageInfo   MetricName = "usage_info"
 	versionInfo MetricName = "version_info"
 
+	smartTemperature        MetricName = "smart_temperature_celsius"
+	smartReallocatedSectors MetricName = "smart_reallocated_sectors"
+	smartMediaErrors        MetricName = "smart_media_errors"
+	smartWearLevel          MetricName = "smart_wear_level_percent"
+	smartAlertsTotal        MetricName = "smart_alerts"
+
 	sizeDistribution = "size_distribution"
 	ttfbDistribution = "ttfb_seconds_distribution"
 
 	lastActivityTime = "last_activity_nano_seconds"
 	startTime        = "starttime_seconds"
 	upTime           = "uptime_seconds"
+	oldestEventAge   = "oldest_event_age_seconds"
 )
 
 const (
@@ -244,9 +264,11 @@ func GetGeneratorsForPeer() []MetricsGenerator {
 		getGoMetrics,
 		getHTTPMetrics,
 		getLocalStorageMetrics,
+		getLocalDriveSmartMetrics,
 		getMinioProcMetrics,
 		getMinioVersionMetrics,
 		getNetworkMetrics,
+		getNotifyTargetMetrics,
 		getS3TTFBMetric,
 	}
 	return g
@@ -260,6 +282,7 @@ func GetSingleNodeGenerators() []MetricsGenerator {
 		getHTTPMetrics,
 		getNetworkMetrics,
 		getMinioVersionMetrics,
+		getNotifyTargetMetrics,
 		getS3TTFBMetric,
 	}
 	return g
@@ -320,6 +343,78 @@ func getNodeDiskFreeBytesMD() MetricDescription {
 		Type:      gaugeMetric,
 	}
 }
+func getNodeDiskHealthStateMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: diskSubsystem,
+		Name:      healthState,
+		Help:      "Health state of a disk, 0 for healthy, 1 for degraded and 2 for faulty.",
+		Type:      gaugeMetric,
+	}
+}
+func getNodeDiskSmartTemperatureMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: diskSubsystem,
+		Name:      smartTemperature,
+		Help:      "Temperature of a disk in degrees Celsius as reported by SMART.",
+		Type:      gaugeMetric,
+	}
+}
+func getNodeDiskSmartReallocatedSectorsMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: diskSubsystem,
+		Name:      smartReallocatedSectors,
+		Help:      "Number of reallocated sectors of a disk as reported by SMART.",
+		Type:      gaugeMetric,
+	}
+}
+func getNodeDiskSmartMediaErrorsMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: diskSubsystem,
+		Name:      smartMediaErrors,
+		Help:      "Number of unrecovered media errors of a disk as reported by SMART.",
+		Type:      gaugeMetric,
+	}
+}
+func getNodeDiskSmartWearLevelMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: diskSubsystem,
+		Name:      smartWearLevel,
+		Help:      "Percentage of the rated endurance of a disk used as reported by SMART.",
+		Type:      gaugeMetric,
+	}
+}
+func getNodeDiskSmartAlertsMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: diskSubsystem,
+		Name:      smartAlertsTotal,
+		Help:      "Number of SMART attributes of a disk above their alert thresholds.",
+		Type:      gaugeMetric,
+	}
+}
+func getNodeDiskErrorsTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: diskSubsystem,
+		Name:      errorsTotal,
+		Help:      "Total number of calls failed by a disk.",
+		Type:      counterMetric,
+	}
+}
+func getNodeDiskTimeoutsTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: nodeMetricNamespace,
+		Subsystem: diskSubsystem,
+		Name:      timeoutsTotal,
+		Help:      "Total number of calls timed out on a disk.",
+		Type:      counterMetric,
+	}
+}
 func getClusterDisksOfflineTotalMD() MetricDescription {
 	return MetricDescription{
 		Namespace: clusterMetricNamespace,
@@ -387,6 +482,24 @@ func getBucketUsageObjectsTotalMD() MetricDescription {
 		Type:      gaugeMetric,
 	}
 }
+func getBucketUsageInlineObjectsTotalMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: usageSubsystem,
+		Name:      inlineObjTotal,
+		Help:      "Total number of object versions with data inlined in xl.meta",
+		Type:      gaugeMetric,
+	}
+}
+func getBucketUsageInlineTotalBytesMD() MetricDescription {
+	return MetricDescription{
+		Namespace: bucketMetricNamespace,
+		Subsystem: usageSubsystem,
+		Name:      inlineBytes,
+		Help:      "Total size in bytes of object versions with data inlined in xl.meta",
+		Type:      gaugeMetric,
+	}
+}
 func getBucketRepPendingBytesMD() MetricDescription {
 	return MetricDescription{
 		Namespace: bucketMetricNamespace,
@@ -1230,6 +1343,95 @@ func getNetworkMetrics() MetricsGroup {
 	}
 }
 
+func getNotifyTargetQueuedEventsMD() MetricDescription {
+	return MetricDescription{
+		Namespace: notifyMetricNamespace,
+		Subsystem: targetSubsystem,
+		Name:      queuedEvents,
+		Help:      "Number of events waiting in the queue store of the target.",
+		Type:      gaugeMetric,
+	}
+}
+
+func getNotifyTargetDeadLetterEventsMD() MetricDescription {
+	return MetricDescription{
+		Namespace: notifyMetricNamespace,
+		Subsystem: targetSubsystem,
+		Name:      deadLetterEvents,
+		Help:      "Number of events moved to the dead-letter directory after exceeding their retry budget.",
+		Type:      gaugeMetric,
+	}
+}
+
+func getNotifyTargetOldestEventAgeMD() MetricDescription {
+	return MetricDescription{
+		Namespace: notifyMetricNamespace,
+		Subsystem: targetSubsystem,
+		Name:      oldestEventAge,
+		Help:      "Age of the oldest event waiting in the queue store of the target.",
+		Type:      gaugeMetric,
+	}
+}
+
+func getNotifyTargetSentMD() MetricDescription {
+	return MetricDescription{
+		Namespace: notifyMetricNamespace,
+		Subsystem: targetSubsystem,
+		Name:      sentTotal,
+		Help:      "Total number of events delivered to the target.",
+		Type:      counterMetric,
+	}
+}
+
+func getNotifyTargetFailedMD() MetricDescription {
+	return MetricDescription{
+		Namespace: notifyMetricNamespace,
+		Subsystem: targetSubsystem,
+		Name:      failedTotal,
+		Help:      "Total number of failed attempts to deliver events to the target.",
+		Type:      counterMetric,
+	}
+}
+
+func getNotifyTargetDroppedMD() MetricDescription {
+	return MetricDescription{
+		Namespace: notifyMetricNamespace,
+		Subsystem: targetSubsystem,
+		Name:      droppedTotal,
+		Help:      "Total number of events discarded without being delivered to the target.",
+		Type:      counterMetric,
+	}
+}
+
+func getNotifyTargetMetrics() MetricsGroup {
+	return MetricsGroup{
+		id:         "notifyTargetMetrics",
+		cachedRead: cachedRead,
+		read: func(ctx context.Context) (metrics []Metric) {
+			if GlobalNotificationSys == nil {
+				return
+			}
+			now := UTCNow()
+			for id, stats := range GlobalNotificationSys.targetList.Stats() {
+				labels := map[string]string{"target_id": id.ID, "target_name": id.Name}
+				var oldestAge float64
+				if !stats.OldestEvent.IsZero() {
+					oldestAge = now.Sub(stats.OldestEvent).Seconds()
+				}
+				metrics = append(metrics,
+					Metric{Description: getNotifyTargetQueuedEventsMD(), VariableLabels: labels, Value: float64(stats.Queued)},
+					Metric{Description: getNotifyTargetDeadLetterEventsMD(), VariableLabels: labels, Value: float64(stats.DeadLettered)},
+					Metric{Description: getNotifyTargetOldestEventAgeMD(), VariableLabels: labels, Value: oldestAge},
+					Metric{Description: getNotifyTargetSentMD(), VariableLabels: labels, Value: float64(stats.Sent)},
+					Metric{Description: getNotifyTargetFailedMD(), VariableLabels: labels, Value: float64(stats.Failed)},
+					Metric{Description: getNotifyTargetDroppedMD(), VariableLabels: labels, Value: float64(stats.Dropped)},
+				)
+			}
+			return
+		},
+	}
+}
+
 func getBucketUsageMetrics() MetricsGroup {
 	return MetricsGroup{
 		id:         "BucketUsageMetrics",
@@ -1276,6 +1478,18 @@ func getBucketUsageMetrics() MetricsGroup {
 					VariableLabels: map[string]string{"bucket": bucket},
 				})
 
+				metrics = append(metrics, Metric{
+					Description:    getBucketUsageInlineObjectsTotalMD(),
+					Value:          float64(usage.InlineCount),
+					VariableLabels: map[string]string{"bucket": bucket},
+				})
+
+				metrics = append(metrics, Metric{
+					Description:    getBucketUsageInlineTotalBytesMD(),
+					Value:          float64(usage.InlineSize),
+					VariableLabels: map[string]string{"bucket": bucket},
+				})
+
 				if stat.hasReplicationUsage() {
 					metrics = append(metrics, Metric{
 						Description:    getBucketRepPendingBytesMD(),
@@ -1356,6 +1570,84 @@ func getLocalStorageMetrics() MetricsGroup {
 					Value:          float64(disk.TotalSpace),
 					VariableLabels: map[string]string{"disk": disk.DrivePath},
 				})
+
+				if disk.Metrics == nil || disk.Metrics.Health == "" {
+					continue
+				}
+
+				var state diskHealthState
+				switch disk.Metrics.Health {
+				case diskHealthDegraded.String():
+					state = diskHealthDegraded
+				case diskHealthFaulty.String():
+					state = diskHealthFaulty
+				}
+				metrics = append(metrics, Metric{
+					Description:    getNodeDiskHealthStateMD(),
+					Value:          float64(state),
+					VariableLabels: map[string]string{"disk": disk.DrivePath},
+				})
+
+				metrics = append(metrics, Metric{
+					Description:    getNodeDiskErrorsTotalMD(),
+					Value:          float64(disk.Metrics.TotalErrors),
+					VariableLabels: map[string]string{"disk": disk.DrivePath},
+				})
+
+				metrics = append(metrics, Metric{
+					Description:    getNodeDiskTimeoutsTotalMD(),
+					Value:          float64(disk.Metrics.TotalTimeouts),
+					VariableLabels: map[string]string{"disk": disk.DrivePath},
+				})
+			}
+			return
+		},
+	}
+}
+func getLocalDriveSmartMetrics() MetricsGroup {
+	return MetricsGroup{
+		id:         "localDriveSmartMetrics",
+		cachedRead: cachedRead,
+		read: func(ctx context.Context) (metrics []Metric) {
+			if GlobalIsGateway {
+				return
+			}
+
+			for _, d := range globalDriveSmart.list() {
+				labels := map[string]string{"disk": d.Drive, "device": d.Health.Device}
+				if d.Health.Temperature != nil {
+					metrics = append(metrics, Metric{
+						Description:    getNodeDiskSmartTemperatureMD(),
+						Value:          float64(*d.Health.Temperature),
+						VariableLabels: labels,
+					})
+				}
+				if d.Health.ReallocatedSectors != nil {
+					metrics = append(metrics, Metric{
+						Description:    getNodeDiskSmartReallocatedSectorsMD(),
+						Value:          float64(*d.Health.ReallocatedSectors),
+						VariableLabels: labels,
+					})
+				}
+				if d.Health.MediaErrors != nil {
+					metrics = append(metrics, Metric{
+						Description:    getNodeDiskSmartMediaErrorsMD(),
+						Value:          float64(*d.Health.MediaErrors),
+						VariableLabels: labels,
+					})
+				}
+				if d.Health.WearLevel != nil {
+					metrics = append(metrics, Metric{
+						Description:    getNodeDiskSmartWearLevelMD(),
+						Value:          float64(*d.Health.WearLevel),
+						VariableLabels: labels,
+					})
+				}
+				metrics = append(metrics, Metric{
+					Description:    getNodeDiskSmartAlertsMD(),
+					Value:          float64(len(d.Alerts)),
+					VariableLabels: labels,
+				})
 			}
 			return
 		},
@@ -284,6 +284,55 @@ func (sys *NotificationSys) BackgroundHealStatus() ([]madmin.BgHealState, []Noti
 	return states, ng.Wait()
 }
 
+// BitrotScrubStatus - returns the bitrot scrub report of all peers
+func (sys *NotificationSys) BitrotScrubStatus() ([]madmin.BitrotScrubStatus, []NotificationPeerErr) {
+	ng := WithNPeers(len(sys.peerClients))
+	statuses := make([]madmin.BitrotScrubStatus, len(sys.peerClients))
+	for idx, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		idx := idx
+		client := client
+		ng.Go(GlobalContext, func() error {
+			st, err := client.BitrotScrubStatus()
+			if err != nil {
+				return err
+			}
+			statuses[idx] = st
+			return nil
+		}, idx, *client.host)
+	}
+
+	return statuses, ng.Wait()
+}
+
+// HealDrive - performs the heal op on the drive at the remote endpoint,
+// by calling the peer hosting it.
+func (sys *NotificationSys) HealDrive(ep Endpoint, op string, buckets []string) error {
+	for _, client := range sys.peerClients {
+		if client != nil && client.host.String() == ep.Host {
+			return client.HealDrive(ep.String(), op, buckets)
+		}
+	}
+	return errPeerNotReachable
+}
+
+// Maintenance - applies a maintenance action for node on all peers.
+func (sys *NotificationSys) Maintenance(ctx context.Context, node, action string, drainTimeout time.Duration) []NotificationPeerErr {
+	ng := WithNPeers(len(sys.peerClients))
+	for idx, client := range sys.peerClients {
+		if client == nil {
+			continue
+		}
+		client := client
+		ng.Go(ctx, func() error {
+			return client.Maintenance(ctx, node, action, drainTimeout)
+		}, idx, *client.host)
+	}
+	return ng.Wait()
+}
+
 // StartProfiling - start profiling on remote peers, by initiating a remote RPC.
 func (sys *NotificationSys) StartProfiling(profiler string) []NotificationPeerErr {
 	ng := WithNPeers(len(sys.peerClients))
@@ -653,6 +702,109 @@ func (sys *NotificationSys) GetLocks(ctx context.Context, r *http.Request) []*Pe
 	return locksResp
 }
 
+// Operations on the queue store of a notification target.
+const (
+	notifyTargetQueueList   = "list"
+	notifyTargetQueueReplay = "replay"
+	notifyTargetQueuePurge  = "purge"
+)
+
+// errNotifyTargetNoQueueStore - the target has no queue store configured.
+var errNotifyTargetNoQueueStore = errors.New("queue store is not configured for the notification target")
+
+// localNotifyTargetQueue - performs op on the queue store of the target
+// on this server.
+func (sys *NotificationSys) localNotifyTargetQueue(targetID event.TargetID, op string, deadLetter bool, max int) (queue madmin.NotifyTargetQueue, err error) {
+	target, ok := sys.targetList.TargetMap()[targetID]
+	if !ok {
+		return queue, errInvalidArgument
+	}
+	store := target.Store()
+	if store == nil {
+		return queue, errNotifyTargetNoQueueStore
+	}
+
+	switch op {
+	case notifyTargetQueueList:
+		var events []event.QueuedEvent
+		if events, err = store.Events(deadLetter, max); err != nil {
+			return queue, err
+		}
+		for _, e := range events {
+			data, err := json.Marshal(e.Event)
+			if err != nil {
+				return queue, err
+			}
+			queue.Events = append(queue.Events, madmin.NotifyQueuedEvent{
+				Key:      e.Key,
+				QueuedAt: e.QueuedAt,
+				Event:    data,
+			})
+		}
+	case notifyTargetQueueReplay:
+		queue.Count, err = store.Replay()
+	case notifyTargetQueuePurge:
+		queue.Count, err = store.Purge(deadLetter)
+	default:
+		return queue, errInvalidArgument
+	}
+	if err != nil {
+		return queue, err
+	}
+
+	stats := store.Stats()
+	queue.Stats = madmin.NotifyTargetStats{
+		Queued:       stats.Queued,
+		DeadLettered: stats.DeadLettered,
+		OldestEvent:  stats.OldestEvent,
+		Sent:         stats.Sent,
+		Failed:       stats.Failed,
+		Dropped:      stats.Dropped,
+	}
+	return queue, nil
+}
+
+// NotifyTargetQueue - performs op on the queue store of the target on
+// all servers.
+func (sys *NotificationSys) NotifyTargetQueue(ctx context.Context, r *http.Request, targetID event.TargetID, op string, deadLetter bool, max int) ([]madmin.NotifyTargetQueue, error) {
+	local, err := sys.localNotifyTargetQueue(targetID, op, deadLetter, max)
+	if err == errInvalidArgument {
+		// Targets are configured alike on all servers.
+		return nil, err
+	}
+	local.Endpoint = getHostName(r)
+	if err != nil {
+		local.Error = err.Error()
+	}
+
+	queues := make([]madmin.NotifyTargetQueue, len(sys.peerClients))
+	g := errgroup.WithNErrs(len(sys.peerClients))
+	for index, client := range sys.peerClients {
+		index := index
+		client := client
+		g.Go(func() error {
+			if client == nil {
+				return errPeerNotReachable
+			}
+			queue, err := client.NotifyTargetQueue(targetID, op, deadLetter, max)
+			if err != nil {
+				return err
+			}
+			queues[index] = queue
+			return nil
+		}, index)
+	}
+	for index, err := range g.Wait() {
+		if client := sys.peerClients[index]; client != nil {
+			queues[index].Endpoint = client.host.String()
+		}
+		if err != nil {
+			queues[index].Error = err.Error()
+		}
+	}
+	return append(queues, local), nil
+}
+
 // LoadBucketMetadata - calls LoadBucketMetadata call on all peers
 func (sys *NotificationSys) LoadBucketMetadata(ctx context.Context, bucketName string) {
 	ng := WithNPeers(len(sys.peerClients))
@@ -870,7 +1022,13 @@ func (sys *NotificationSys) RemoveAllRemoteTargets() {
 // Send - sends event data to all matching targets.
 func (sys *NotificationSys) Send(args eventArgs) {
 	sys.RLock()
-	targetIDSet := sys.bucketRulesMap[args.BucketName].Match(args.EventName, args.Object.Name)
+	targetIDSet := sys.bucketRulesMap[args.BucketName].MatchObject(args.EventName, event.ObjectProperties{
+		Name:         args.Object.Name,
+		Size:         args.Object.Size,
+		ContentType:  args.Object.ContentType,
+		UserMetadata: args.Object.UserDefined,
+		UserTags:     args.Object.UserTags,
+	})
 	sys.RUnlock()
 
 	if len(targetIDSet) == 0 {
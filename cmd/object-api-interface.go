@@ -48,7 +48,7 @@ type ObjectOptions struct {
 	DeleteMarker                  bool                                                  // Is only set in DELETE operations for delete marker replication
 	UserDefined                   map[string]string                                     // only set in case of POST/PUT operations
 	PartNumber                    int                                                   // only useful in case of GetObject/HeadObject
-	CheckPrecondFn                CheckPreconditionFn                                   // only set during GetObject/HeadObject/CopyObjectPart preconditional valuation
+	CheckPrecondFn                CheckPreconditionFn                                   // only set during GetObject/HeadObject/CopyObjectPart preconditional valuation and metadata-only CopyObject
 	DeleteMarkerReplicationStatus string                                                // Is only set in DELETE operations
 	VersionPurgeStatus            VersionPurgeStatusType                                // Is only set in DELETE operations for delete marker version to be permanently deleted.
 	TransitionStatus              string                                                // status of the transition
@@ -56,6 +56,7 @@ type ObjectOptions struct {
 	ProxyRequest                  bool                                                  // only set for GET/HEAD in active-active replication scenario
 	ProxyHeaderSet                bool                                                  // only set for GET/HEAD in active-active replication scenario
 	ParentIsObject                func(ctx context.Context, bucket, parent string) bool // Used to verify if parent is an object.
+	PrefixEnabledFn               func(prefix string) bool                              // Only set for DeleteObjects, returns true if versioning is enabled for the object.
 
 	// Use the maximum parity (N/2), used when
 	// saving server configuration files
@@ -38,9 +38,11 @@ import (
 
 	humanize "github.com/dustin/go-humanize"
 
+	"minio/cmd/crypto"
 	xhttp "minio/cmd/http"
 	"minio/pkg/auth"
 	ioutilx "minio/pkg/ioutil"
+	"minio/pkg/kms"
 )
 
 // Type to capture different modifications to API request to simulate failure cases.
@@ -4064,3 +4066,234 @@ func testAPIListObjectPartsHandler(obj ObjectLayer, instanceType, bucketName str
 	// `ExecObjectLayerAPINilTest` sets the Object Layer to `nil` and calls the handler.
 	ExecObjectLayerAPINilTest(t, nilBucket, nilObject, instanceType, apiRouter, nilReq)
 }
+
+// Wrapper for calling Copy Object and Copy Object Part API handlers between
+// SSE-C, SSE-S3 and SSE-KMS encrypted multipart objects.
+func TestAPICopyObjectSSETransitions(t *testing.T) {
+	resetCompressEncryption()
+	defer resetCompressEncryption()
+
+	var err error
+	GlobalKMS, err = kms.New("my-key", make([]byte, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ExecObjectLayerAPITest(t, testAPICopyObjectSSETransitions,
+		[]string{"NewMultipart", "CopyObjectPart", "PutObjectPart", "CompleteMultipart", "CopyObject", "GetObject"})
+}
+
+func testAPICopyObjectSSETransitions(obj ObjectLayer, instanceType, bucketName string, apiRouter http.Handler,
+	credentials auth.Credentials, t *testing.T) {
+	ssecHeaders := func(key []byte, copySource bool) map[string]string {
+		sum := md5.Sum(key)
+		if copySource {
+			return map[string]string{
+				xhttp.AmzServerSideEncryptionCopyCustomerAlgorithm: xhttp.AmzEncryptionAES,
+				xhttp.AmzServerSideEncryptionCopyCustomerKey:       base64.StdEncoding.EncodeToString(key),
+				xhttp.AmzServerSideEncryptionCopyCustomerKeyMD5:    base64.StdEncoding.EncodeToString(sum[:]),
+			}
+		}
+		return map[string]string{
+			xhttp.AmzServerSideEncryptionCustomerAlgorithm: xhttp.AmzEncryptionAES,
+			xhttp.AmzServerSideEncryptionCustomerKey:       base64.StdEncoding.EncodeToString(key),
+			xhttp.AmzServerSideEncryptionCustomerKeyMD5:    base64.StdEncoding.EncodeToString(sum[:]),
+		}
+	}
+	headers := func(hs ...map[string]string) map[string]string {
+		h := map[string]string{}
+		for _, m := range hs {
+			for k, v := range m {
+				h[k] = v
+			}
+		}
+		return h
+	}
+	execRequest := func(method, urlStr string, body []byte, h map[string]string) *httptest.ResponseRecorder {
+		req, err := newTestSignedRequestV4(method, urlStr, int64(len(body)), bytes.NewReader(body),
+			credentials.AccessKey, credentials.SecretKey, h)
+		if err != nil {
+			t.Fatalf("MinIO %s: Failed to create HTTP request: <ERROR> %v", instanceType, err)
+		}
+		rec := httptest.NewRecorder()
+		apiRouter.ServeHTTP(rec, req)
+		return rec
+	}
+
+	var (
+		keyA   = bytes.Repeat([]byte{'a'}, 32)
+		keyB   = bytes.Repeat([]byte{'b'}, 32)
+		sseS3  = map[string]string{xhttp.AmzServerSideEncryption: xhttp.AmzEncryptionAES}
+		sseKMS = map[string]string{xhttp.AmzServerSideEncryption: xhttp.AmzEncryptionKMS}
+		part1  = bytes.Repeat([]byte{'1'}, 5*humanize.MiByte)
+		part2  = bytes.Repeat([]byte{'2'}, humanize.KiByte)
+		data   = append(append([]byte{}, part1...), part2...)
+	)
+
+	// uploadParts uploads a multipart object, the parts are either
+	// uploaded directly or copied from the byte ranges of src.
+	uploadParts := func(object string, h map[string]string, src string, srcH map[string]string, ranges []string) {
+		rec := execRequest(http.MethodPost, getNewMultipartURL("", bucketName, object), nil, h)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("MinIO %s: %s: Expected the response status to be `%d`, but instead found `%d`: %s", instanceType, object, http.StatusOK, rec.Code, rec.Body.String())
+		}
+		var upload InitiateMultipartUploadResponse
+		if err := xml.Unmarshal(rec.Body.Bytes(), &upload); err != nil {
+			t.Fatal(err)
+		}
+
+		// Only SSE-C headers are sent with the parts, SSE-S3 and SSE-KMS
+		// are specified when initiating the upload.
+		partH := h
+		if _, ok := h[xhttp.AmzServerSideEncryption]; ok {
+			partH = nil
+		}
+
+		var parts []CompletePart
+		if src == "" {
+			for i, p := range [][]byte{part1, part2} {
+				rec = execRequest(http.MethodPut, getPutObjectPartURL("", bucketName, object, upload.UploadID, strconv.Itoa(i+1)), p, partH)
+				if rec.Code != http.StatusOK {
+					t.Fatalf("MinIO %s: %s: Expected the response status to be `%d`, but instead found `%d`: %s", instanceType, object, http.StatusOK, rec.Code, rec.Body.String())
+				}
+				parts = append(parts, CompletePart{PartNumber: i + 1, ETag: rec.Header()["ETag"][0]})
+			}
+		}
+		for i, rng := range ranges {
+			rec = execRequest(http.MethodPut, getCopyObjectPartURL("", bucketName, object, upload.UploadID, strconv.Itoa(i+1)), nil,
+				headers(partH, srcH, map[string]string{xhttp.AmzCopySource: "/" + bucketName + "/" + src, xhttp.AmzCopySourceRange: rng}))
+			if rec.Code != http.StatusOK {
+				t.Fatalf("MinIO %s: %s: Expected the response status to be `%d`, but instead found `%d`: %s", instanceType, object, http.StatusOK, rec.Code, rec.Body.String())
+			}
+			var part CopyObjectPartResponse
+			if err := xml.Unmarshal(rec.Body.Bytes(), &part); err != nil {
+				t.Fatal(err)
+			}
+			parts = append(parts, CompletePart{PartNumber: i + 1, ETag: part.ETag})
+		}
+
+		body, err := xml.Marshal(CompleteMultipartUpload{Parts: parts})
+		if err != nil {
+			t.Fatal(err)
+		}
+		rec = execRequest(http.MethodPost, getCompleteMultipartUploadURL("", bucketName, object, upload.UploadID), body, partH)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("MinIO %s: %s: Expected the response status to be `%d`, but instead found `%d`: %s", instanceType, object, http.StatusOK, rec.Code, rec.Body.String())
+		}
+	}
+	copyObject := func(dst, src string, h map[string]string) int {
+		rec := execRequest(http.MethodPut, getCopyObjectURL("", bucketName, dst), nil,
+			headers(h, map[string]string{xhttp.AmzCopySource: "/" + bucketName + "/" + src}))
+		return rec.Code
+	}
+	checkObject := func(object string, h map[string]string) {
+		rec := execRequest(http.MethodGet, getGetObjectURL("", bucketName, object), nil, h)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("MinIO %s: %s: Expected the response status to be `%d`, but instead found `%d`: %s", instanceType, object, http.StatusOK, rec.Code, rec.Body.String())
+		}
+		if !bytes.Equal(rec.Body.Bytes(), data) {
+			t.Errorf("MinIO %s: %s: Object content differs from the uploaded content", instanceType, object)
+		}
+	}
+	// checkResealed verifies that an in-place copy only replaced the
+	// sealed object key and kept the multipart data as it is.
+	checkResealed := func(object string, kind crypto.Type) {
+		oi, err := obj.GetObjectInfo(context.Background(), bucketName, object, ObjectOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if typ, _ := crypto.IsEncrypted(oi.UserDefined); typ != kind {
+			t.Errorf("MinIO %s: %s: Expected the object to be encrypted with %v, but found %v", instanceType, object, kind, typ)
+		}
+		if kind != crypto.SSEC && crypto.SSEC.IsEncrypted(oi.UserDefined) {
+			t.Errorf("MinIO %s: %s: Expected the SSE-C sealed key to be removed", instanceType, object)
+		}
+		if !crypto.IsMultiPart(oi.UserDefined) || len(oi.Parts) != 2 {
+			t.Errorf("MinIO %s: %s: Expected the object data to be unchanged, but found %d parts", instanceType, object, len(oi.Parts))
+		}
+	}
+
+	alignedRanges := []string{
+		fmt.Sprintf("bytes=0-%d", len(part1)-1),
+		fmt.Sprintf("bytes=%d-%d", len(part1), len(data)-1),
+	}
+	// Ranges crossing the boundary between the parts of the source.
+	crossRanges := []string{
+		fmt.Sprintf("bytes=0-%d", len(part1)+99),
+		fmt.Sprintf("bytes=%d-%d", len(part1)+100, len(data)-1),
+	}
+
+	uploadParts("ssec", ssecHeaders(keyA, false), "", nil, nil)
+	checkObject("ssec", ssecHeaders(keyA, false))
+
+	// Copy Object Part transitions.
+	testCases := []struct {
+		object, src string
+		dstH, srcH  map[string]string
+		ranges      []string
+		getH        map[string]string
+	}{
+		{"ssec-ssec", "ssec", ssecHeaders(keyB, false), ssecHeaders(keyA, true), alignedRanges, ssecHeaders(keyB, false)},
+		{"ssec-sses3", "ssec", sseS3, ssecHeaders(keyA, true), alignedRanges, nil},
+		{"sses3-ssekms", "ssec-sses3", sseKMS, nil, alignedRanges, nil},
+		{"ssec-ssec-cross", "ssec-ssec", ssecHeaders(keyA, false), ssecHeaders(keyB, true), crossRanges, ssecHeaders(keyA, false)},
+		{"ssekms-ssec-cross", "sses3-ssekms", ssecHeaders(keyA, false), nil, crossRanges, ssecHeaders(keyA, false)},
+		{"ssec-ssekms-cross", "ssekms-ssec-cross", sseKMS, ssecHeaders(keyA, true), crossRanges, nil},
+	}
+	for _, testCase := range testCases {
+		uploadParts(testCase.object, testCase.dstH, testCase.src, testCase.srcH, testCase.ranges)
+		checkObject(testCase.object, testCase.getH)
+	}
+
+	// An SSE-C source must not be decrypted with the key of the destination
+	// and its copy source key must be correct.
+	rec := execRequest(http.MethodPost, getNewMultipartURL("", bucketName, "invalid"), nil, ssecHeaders(keyA, false))
+	var upload InitiateMultipartUploadResponse
+	if err := xml.Unmarshal(rec.Body.Bytes(), &upload); err != nil {
+		t.Fatal(err)
+	}
+	for i, srcH := range []map[string]string{nil, ssecHeaders(keyB, true)} {
+		rec = execRequest(http.MethodPut, getCopyObjectPartURL("", bucketName, "invalid", upload.UploadID, "1"), nil,
+			headers(ssecHeaders(keyA, false), srcH, map[string]string{xhttp.AmzCopySource: "/" + bucketName + "/ssec"}))
+		if rec.Code == http.StatusOK {
+			t.Errorf("MinIO %s: Test %d: Expected copying an SSE-C part with an invalid copy source key to fail", instanceType, i+1)
+		}
+	}
+
+	// Copy Object transitions of the whole object in place.
+	if code := copyObject("ssec", "ssec", headers(ssecHeaders(keyB, false), ssecHeaders(keyA, true))); code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, code)
+	}
+	checkObject("ssec", ssecHeaders(keyB, false))
+	checkResealed("ssec", crypto.SSEC)
+
+	if code := copyObject("ssec", "ssec", headers(sseS3, ssecHeaders(keyA, true))); code != http.StatusForbidden {
+		t.Errorf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusForbidden, code)
+	}
+	if code := copyObject("ssec", "ssec", headers(sseS3, ssecHeaders(keyB, true))); code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, code)
+	}
+	checkObject("ssec", nil)
+	checkResealed("ssec", crypto.S3)
+
+	if code := copyObject("ssec", "ssec", sseKMS); code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, code)
+	}
+	checkObject("ssec", nil)
+	checkResealed("ssec", crypto.S3KMS)
+
+	if code := copyObject("ssec", "ssec", ssecHeaders(keyA, false)); code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, code)
+	}
+	checkObject("ssec", ssecHeaders(keyA, false))
+	checkResealed("ssec", crypto.SSEC)
+
+	// Copy Object transitions to a different object.
+	if code := copyObject("copy-sses3", "ssec", headers(sseS3, ssecHeaders(keyA, true))); code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, code)
+	}
+	checkObject("copy-sses3", nil)
+	if code := copyObject("copy-ssekms", "copy-sses3", sseKMS); code != http.StatusOK {
+		t.Fatalf("MinIO %s: Expected the response status to be `%d`, but instead found `%d`", instanceType, http.StatusOK, code)
+	}
+	checkObject("copy-ssekms", nil)
+}
@@ -449,6 +449,22 @@ func (client *peerRESTClient) GetBucketStats(bucket string) (BucketStats, error)
 	return bs, msgp.Decode(respBody, &bs)
 }
 
+// NotifyTargetQueue - performs op on the queue store of a notification target.
+func (client *peerRESTClient) NotifyTargetQueue(targetID event.TargetID, op string, deadLetter bool, max int) (queue madmin.NotifyTargetQueue, err error) {
+	values := make(url.Values)
+	values.Set(peerRESTNotifyTarget, targetID.String())
+	values.Set(peerRESTNotifyQueueOp, op)
+	values.Set(peerRESTNotifyDeadLetter, strconv.FormatBool(deadLetter))
+	values.Set(peerRESTNotifyMax, strconv.Itoa(max))
+	respBody, err := client.call(peerRESTMethodNotifyTargetQueue, values, nil, -1)
+	if err != nil {
+		return queue, err
+	}
+	defer http.DrainBody(respBody)
+	err = gob.NewDecoder(respBody).Decode(&queue)
+	return queue, err
+}
+
 // LoadBucketMetadata - load bucket metadata
 func (client *peerRESTClient) LoadBucketMetadata(bucket string) error {
 	values := make(url.Values)
@@ -650,6 +666,53 @@ func (client *peerRESTClient) BackgroundHealStatus() (madmin.BgHealState, error)
 	return state, err
 }
 
+// BitrotScrubStatus - returns the bitrot scrub report of the peer's local disks.
+func (client *peerRESTClient) BitrotScrubStatus() (madmin.BitrotScrubStatus, error) {
+	respBody, err := client.call(peerRESTMethodBitrotScrubStatus, nil, nil, -1)
+	if err != nil {
+		return madmin.BitrotScrubStatus{}, err
+	}
+	defer http.DrainBody(respBody)
+
+	status := madmin.BitrotScrubStatus{}
+	err = gob.NewDecoder(respBody).Decode(&status)
+	return status, err
+}
+
+// HealDrive - performs the heal op on the peer's local drive.
+func (client *peerRESTClient) HealDrive(endpoint, op string, buckets []string) error {
+	values := make(url.Values)
+	values.Set(peerRESTHealDriveEndpoint, endpoint)
+	values.Set(peerRESTHealDriveOp, op)
+	values.Set(peerRESTBuckets, strings.Join(buckets, ","))
+	respBody, err := client.call(peerRESTMethodHealDrive, values, nil, -1)
+	if err != nil {
+		if err.Error() == errHealDriveNotHealing.Error() {
+			return errHealDriveNotHealing
+		}
+		return err
+	}
+	defer http.DrainBody(respBody)
+	return nil
+}
+
+// Maintenance - applies a maintenance action for node on the peer.
+func (client *peerRESTClient) Maintenance(ctx context.Context, node, action string, drainTimeout time.Duration) error {
+	values := make(url.Values)
+	values.Set(peerRESTMaintenanceNode, node)
+	values.Set(peerRESTMaintenanceAction, action)
+	values.Set(peerRESTMaintenanceDrainTimeout, drainTimeout.String())
+	respBody, err := client.callWithContext(ctx, peerRESTMethodMaintenance, values, nil, -1)
+	if err != nil {
+		if err.Error() == errMaintenanceDrainTimeout.Error() {
+			return errMaintenanceDrainTimeout
+		}
+		return err
+	}
+	defer http.DrainBody(respBody)
+	return nil
+}
+
 // GetLocalDiskIDs - get a peer's local disks' IDs.
 func (client *peerRESTClient) GetLocalDiskIDs(ctx context.Context) (diskIDs []string) {
 	respBody, err := client.callWithContext(ctx, peerRESTMethodGetLocalDiskIDs, nil, nil, -1)
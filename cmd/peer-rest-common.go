@@ -17,7 +17,7 @@
 package cmd
 
 const (
-	peerRESTVersion       = "v14" // Add GetBucketStats API
+	peerRESTVersion       = "v18" // Add Maintenance API
 	peerRESTVersionPrefix = SlashSeparator + peerRESTVersion
 	peerRESTPrefix        = minioReservedBucketPath + "/peer"
 	peerRESTPath          = peerRESTPrefix + peerRESTVersionPrefix
@@ -40,6 +40,9 @@ const (
 	peerRESTMethodServerUpdate           = "/serverupdate"
 	peerRESTMethodSignalService          = "/signalservice"
 	peerRESTMethodBackgroundHealStatus   = "/backgroundhealstatus"
+	peerRESTMethodBitrotScrubStatus      = "/bitrotscrubstatus"
+	peerRESTMethodHealDrive              = "/healdrive"
+	peerRESTMethodMaintenance            = "/maintenance"
 	peerRESTMethodGetLocks               = "/getlocks"
 	peerRESTMethodLoadUser               = "/loaduser"
 	peerRESTMethodLoadServiceAccount     = "/loadserviceaccount"
@@ -60,6 +63,7 @@ const (
 	peerRESTMethodGetMetacacheListing    = "/getmetacache"
 	peerRESTMethodUpdateMetacacheListing = "/updatemetacache"
 	peerRESTMethodGetPeerMetrics         = "/peermetrics"
+	peerRESTMethodNotifyTargetQueue      = "/notifytargetqueue"
 )
 
 const (
@@ -84,4 +88,16 @@ const (
 	peerRESTListenPrefix = "prefix"
 	peerRESTListenSuffix = "suffix"
 	peerRESTListenEvents = "events"
+
+	peerRESTNotifyTarget     = "target"
+	peerRESTNotifyQueueOp    = "op"
+	peerRESTNotifyDeadLetter = "deadletter"
+	peerRESTNotifyMax        = "max"
+
+	peerRESTHealDriveEndpoint = "endpoint"
+	peerRESTHealDriveOp       = "op"
+
+	peerRESTMaintenanceNode         = "node"
+	peerRESTMaintenanceAction       = "action"
+	peerRESTMaintenanceDrainTimeout = "drain-timeout"
 )
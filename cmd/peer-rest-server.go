@@ -562,6 +562,42 @@ func (s *peerRESTServer) GetBucketStatsHandler(w http.ResponseWriter, r *http.Re
 	logger.LogIf(r.Context(), msgp.Encode(w, &bs))
 }
 
+// NotifyTargetQueueHandler - performs an operation on the queue store
+// of a notification target.
+func (s *peerRESTServer) NotifyTargetQueueHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.WriteErrorResponse(w, errors.New("Invalid request"))
+		return
+	}
+
+	vars := mux.Vars(r)
+	targetID, err := event.ParseTargetID(vars[peerRESTNotifyTarget])
+	if err != nil {
+		s.WriteErrorResponse(w, err)
+		return
+	}
+	deadLetter, err := strconv.ParseBool(vars[peerRESTNotifyDeadLetter])
+	if err != nil {
+		s.WriteErrorResponse(w, err)
+		return
+	}
+	max, err := strconv.Atoi(vars[peerRESTNotifyMax])
+	if err != nil {
+		s.WriteErrorResponse(w, err)
+		return
+	}
+
+	queue, err := GlobalNotificationSys.localNotifyTargetQueue(*targetID, vars[peerRESTNotifyQueueOp], deadLetter, max)
+	if err != nil {
+		s.WriteErrorResponse(w, err)
+		return
+	}
+
+	ctx := NewContext(r, w, "NotifyTargetQueue")
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(queue))
+	w.(http.Flusher).Flush()
+}
+
 // LoadBucketMetadataHandler - reloads in memory bucket metadata
 func (s *peerRESTServer) LoadBucketMetadataHandler(w http.ResponseWriter, r *http.Request) {
 	if !s.IsValid(w, r) {
@@ -1006,6 +1042,74 @@ func (s *peerRESTServer) BackgroundHealStatusHandler(w http.ResponseWriter, r *h
 	logger.LogIf(ctx, gob.NewEncoder(w).Encode(state))
 }
 
+// BitrotScrubStatusHandler - returns the bitrot scrub report of the local disks.
+func (s *peerRESTServer) BitrotScrubStatusHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.WriteErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+	ctx := NewContext(r, w, "BitrotScrubStatus")
+
+	objAPI := newObjectLayerFn()
+	if objAPI == nil {
+		s.WriteErrorResponse(w, errServerNotInitialized)
+		return
+	}
+
+	defer w.(http.Flusher).Flush()
+	logger.LogIf(ctx, gob.NewEncoder(w).Encode(getLocalBitrotScrubStatus(ctx, objAPI)))
+}
+
+// HealDriveHandler - performs the heal op on a local drive.
+func (s *peerRESTServer) HealDriveHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.WriteErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+	ctx := NewContext(r, w, "HealDrive")
+
+	vars := mux.Vars(r)
+	ep, ok := globalEndpoints.FindEndpoint(vars[peerRESTHealDriveEndpoint])
+	if !ok {
+		s.WriteErrorResponse(w, errDiskNotFound)
+		return
+	}
+	var buckets []string
+	if b := vars[peerRESTBuckets]; b != "" {
+		buckets = strings.Split(b, ",")
+	}
+
+	if err := healLocalDrive(ctx, ep, vars[peerRESTHealDriveOp], buckets); err != nil {
+		s.WriteErrorResponse(w, err)
+		return
+	}
+
+	w.(http.Flusher).Flush()
+}
+
+// MaintenanceHandler - applies a maintenance action for a node on this server.
+func (s *peerRESTServer) MaintenanceHandler(w http.ResponseWriter, r *http.Request) {
+	if !s.IsValid(w, r) {
+		s.WriteErrorResponse(w, errors.New("invalid request"))
+		return
+	}
+	ctx := NewContext(r, w, "Maintenance")
+
+	vars := mux.Vars(r)
+	drainTimeout, err := time.ParseDuration(vars[peerRESTMaintenanceDrainTimeout])
+	if err != nil {
+		s.WriteErrorResponse(w, err)
+		return
+	}
+
+	if err = setLocalMaintenance(ctx, vars[peerRESTMaintenanceNode], vars[peerRESTMaintenanceAction], drainTimeout); err != nil {
+		s.WriteErrorResponse(w, err)
+		return
+	}
+
+	w.(http.Flusher).Flush()
+}
+
 // ConsoleLogHandler sends console logs of this node back to peer rest client
 func (s *peerRESTServer) ConsoleLogHandler(w http.ResponseWriter, r *http.Request) {
 	if !s.IsValid(w, r) {
@@ -1117,6 +1221,7 @@ func registerPeerRESTHandlers(router *mux.Router) {
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodDeleteBucketMetadata).HandlerFunc(HTTPTraceHdrs(server.DeleteBucketMetadataHandler)).Queries(restQueries(peerRESTBucket)...)
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodLoadBucketMetadata).HandlerFunc(HTTPTraceHdrs(server.LoadBucketMetadataHandler)).Queries(restQueries(peerRESTBucket)...)
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetBucketStats).HandlerFunc(HTTPTraceHdrs(server.GetBucketStatsHandler)).Queries(restQueries(peerRESTBucket)...)
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodNotifyTargetQueue).HandlerFunc(HTTPTraceHdrs(server.NotifyTargetQueueHandler)).Queries(restQueries(peerRESTNotifyTarget, peerRESTNotifyQueueOp, peerRESTNotifyDeadLetter, peerRESTNotifyMax)...)
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodSignalService).HandlerFunc(HTTPTraceHdrs(server.SignalServiceHandler)).Queries(restQueries(peerRESTSignal)...)
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodServerUpdate).HandlerFunc(HTTPTraceHdrs(server.ServerUpdateHandler))
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodDeletePolicy).HandlerFunc(HTTPTraceAll(server.DeletePolicyHandler)).Queries(restQueries(peerRESTPolicy)...)
@@ -1133,6 +1238,9 @@ func registerPeerRESTHandlers(router *mux.Router) {
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodTrace).HandlerFunc(server.TraceHandler)
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodListen).HandlerFunc(HTTPTraceHdrs(server.ListenHandler))
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodBackgroundHealStatus).HandlerFunc(server.BackgroundHealStatusHandler)
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodBitrotScrubStatus).HandlerFunc(server.BitrotScrubStatusHandler)
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodHealDrive).HandlerFunc(HTTPTraceHdrs(server.HealDriveHandler)).Queries(restQueries(peerRESTHealDriveEndpoint, peerRESTHealDriveOp, peerRESTBuckets)...)
+	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodMaintenance).HandlerFunc(HTTPTraceHdrs(server.MaintenanceHandler)).Queries(restQueries(peerRESTMaintenanceNode, peerRESTMaintenanceAction, peerRESTMaintenanceDrainTimeout)...)
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodLog).HandlerFunc(server.ConsoleLogHandler)
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetLocalDiskIDs).HandlerFunc(HTTPTraceHdrs(server.GetLocalDiskIDs))
 	subrouter.Methods(http.MethodPost).Path(peerRESTVersionPrefix + peerRESTMethodGetBandwidth).HandlerFunc(HTTPTraceHdrs(server.GetBandwidth))
@@ -66,6 +66,8 @@ var GlobalHandlers = []mux.MiddlewareFunc{
 	setHTTPStatsHandler,
 	// Validate all the incoming requests.
 	setRequestValidityHandler,
+	// Reject writes to a server in maintenance.
+	setMaintenanceHandler,
 	// set HTTP security headers such as Content-Security-Policy.
 	addSecurityHeaders,
 	// set x-amz-request-id header.
@@ -243,6 +243,10 @@ func newAllSubsystems() {
 
 	// Create new bucket replication subsytem
 	globalBucketTargetSys = NewBucketTargetSys()
+
+	// Create new KMS key rotation subsystem
+	globalKMSKeyRotationSys = NewKMSKeyRotationSys()
+	globalKMSConfigMigrationSys = NewKMSConfigMigrationSys()
 }
 
 func configRetriableErrors(err error) bool {
@@ -386,6 +390,11 @@ func initAllSubsystems(ctx context.Context, newObject ObjectLayer) (err error) {
 	// Initialize bucket targets sub-system.
 	globalBucketTargetSys.Init(ctx, buckets, newObject)
 
+	// Restore the nodes in maintenance.
+	if globalIsDistErasure {
+		logger.LogIf(ctx, globalMaintenance.load(ctx, newObject))
+	}
+
 	return nil
 }
 
@@ -466,6 +475,7 @@ func serverMain(ctx *cli.Context) {
 	}
 
 	httpServer := xhttp.NewServer([]string{globalMinioAddr}, criticalErrorHandler{corsHandler(handler)}, getCert)
+	requestClientCerts(httpServer)
 	httpServer.BaseContext = func(listener net.Listener) context.Context {
 		return GlobalContext
 	}
@@ -524,6 +534,8 @@ func serverMain(ctx *cli.Context) {
 	if globalIsErasure { // to be done after config init
 		initBackgroundReplication(GlobalContext, newObject)
 	}
+
+	initKMSKeyRotation(GlobalContext, newObject)
 	if globalCacheConfig.Enabled {
 		// initialize the new disk cache objects.
 		var cacheAPI CacheObjectLayer
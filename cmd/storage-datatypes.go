@@ -43,10 +43,13 @@ type DiskInfo struct {
 
 // DiskMetrics has the information about XL Storage APIs
 // the number of calls of each API and the moving average of
-// the duration of each API.
+// the duration of each API, along with the health of the disk.
 type DiskMetrics struct {
-	APILatencies map[string]string `json:"apiLatencies,omitempty"`
-	APICalls     map[string]uint64 `json:"apiCalls,omitempty"`
+	APILatencies  map[string]string `json:"apiLatencies,omitempty"`
+	APICalls      map[string]uint64 `json:"apiCalls,omitempty"`
+	Health        string            `json:"health,omitempty"`
+	TotalErrors   uint64            `json:"totalErrors,omitempty"`
+	TotalTimeouts uint64            `json:"totalTimeouts,omitempty"`
 }
 
 // VolsInfo is a collection of volume(bucket) information
@@ -335,6 +335,24 @@ func (z *DiskMetrics) DecodeMsg(dc *msgp.Reader) (err error) {
 				}
 				z.APICalls[za0003] = za0004
 			}
+		case "Health":
+			z.Health, err = dc.ReadString()
+			if err != nil {
+				err = msgp.WrapError(err, "Health")
+				return
+			}
+		case "TotalErrors":
+			z.TotalErrors, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "TotalErrors")
+				return
+			}
+		case "TotalTimeouts":
+			z.TotalTimeouts, err = dc.ReadUint64()
+			if err != nil {
+				err = msgp.WrapError(err, "TotalTimeouts")
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -348,9 +366,9 @@ func (z *DiskMetrics) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *DiskMetrics) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 2
+	// map header, size 5
 	// write "APILatencies"
-	err = en.Append(0x82, 0xac, 0x41, 0x50, 0x49, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73)
+	err = en.Append(0x85, 0xac, 0x41, 0x50, 0x49, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73)
 	if err != nil {
 		return
 	}
@@ -393,15 +411,45 @@ func (z *DiskMetrics) EncodeMsg(en *msgp.Writer) (err error) {
 			return
 		}
 	}
+	// write "Health"
+	err = en.Append(0xa6, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Health)
+	if err != nil {
+		err = msgp.WrapError(err, "Health")
+		return
+	}
+	// write "TotalErrors"
+	err = en.Append(0xab, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.TotalErrors)
+	if err != nil {
+		err = msgp.WrapError(err, "TotalErrors")
+		return
+	}
+	// write "TotalTimeouts"
+	err = en.Append(0xad, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.TotalTimeouts)
+	if err != nil {
+		err = msgp.WrapError(err, "TotalTimeouts")
+		return
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *DiskMetrics) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 2
+	// map header, size 5
 	// string "APILatencies"
-	o = append(o, 0x82, 0xac, 0x41, 0x50, 0x49, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73)
+	o = append(o, 0x85, 0xac, 0x41, 0x50, 0x49, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73)
 	o = msgp.AppendMapHeader(o, uint32(len(z.APILatencies)))
 	for za0001, za0002 := range z.APILatencies {
 		o = msgp.AppendString(o, za0001)
@@ -414,6 +462,15 @@ func (z *DiskMetrics) MarshalMsg(b []byte) (o []byte, err error) {
 		o = msgp.AppendString(o, za0003)
 		o = msgp.AppendUint64(o, za0004)
 	}
+	// string "Health"
+	o = append(o, 0xa6, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68)
+	o = msgp.AppendString(o, z.Health)
+	// string "TotalErrors"
+	o = append(o, 0xab, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73)
+	o = msgp.AppendUint64(o, z.TotalErrors)
+	// string "TotalTimeouts"
+	o = append(o, 0xad, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73)
+	o = msgp.AppendUint64(o, z.TotalTimeouts)
 	return
 }
 
@@ -495,6 +552,24 @@ func (z *DiskMetrics) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				}
 				z.APICalls[za0003] = za0004
 			}
+		case "Health":
+			z.Health, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Health")
+				return
+			}
+		case "TotalErrors":
+			z.TotalErrors, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "TotalErrors")
+				return
+			}
+		case "TotalTimeouts":
+			z.TotalTimeouts, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "TotalTimeouts")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -523,6 +598,7 @@ func (z *DiskMetrics) Msgsize() (s int) {
 			s += msgp.StringPrefixSize + len(za0003) + msgp.Uint64Size
 		}
 	}
+	s += 7 + msgp.StringPrefixSize + len(z.Health) + 12 + msgp.Uint64Size + 14 + msgp.Uint64Size
 	return
 }
 
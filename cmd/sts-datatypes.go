@@ -190,3 +190,36 @@ type AssumeRoleWithLDAPResponse struct {
 type LDAPIdentityResult struct {
 	Credentials auth.Credentials `xml:",omitempty"`
 }
+
+// AssumeRoleWithCertificateResponse contains the result of successful
+// AssumeRoleWithCertificate request
+type AssumeRoleWithCertificateResponse struct {
+	XMLName          xml.Name          `xml:"https://sts.amazonaws.com/doc/2011-06-15/ AssumeRoleWithCertificateResponse" json:"-"`
+	Result           CertificateResult `xml:"AssumeRoleWithCertificateResult"`
+	ResponseMetadata struct {
+		RequestID string `xml:"RequestId,omitempty"`
+	} `xml:"ResponseMetadata,omitempty"`
+}
+
+// CertificateResult - contains credentials for a successful
+// AssumeRoleWithCertificate request.
+type CertificateResult struct {
+	Credentials auth.Credentials `xml:",omitempty"`
+}
+
+// AssumeRoleWithCustomTokenResponse contains the result of successful
+// AssumeRoleWithCustomToken request
+type AssumeRoleWithCustomTokenResponse struct {
+	XMLName          xml.Name          `xml:"https://sts.amazonaws.com/doc/2011-06-15/ AssumeRoleWithCustomTokenResponse" json:"-"`
+	Result           CustomTokenResult `xml:"AssumeRoleWithCustomTokenResult"`
+	ResponseMetadata struct {
+		RequestID string `xml:"RequestId,omitempty"`
+	} `xml:"ResponseMetadata,omitempty"`
+}
+
+// CustomTokenResult - contains credentials for a successful
+// AssumeRoleWithCustomToken request.
+type CustomTokenResult struct {
+	Credentials auth.Credentials `xml:",omitempty"`
+	User        string           `xml:",omitempty"`
+}
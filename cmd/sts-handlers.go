@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -27,6 +28,8 @@ import (
 	"github.com/gorilla/mux"
 
 	"minio/cmd/config/identity/openid"
+	idplugin "minio/cmd/config/identity/plugin"
+	xtls "minio/cmd/config/identity/tls"
 	xhttp "minio/cmd/http"
 	"minio/cmd/logger"
 	"minio/pkg/auth"
@@ -42,6 +45,7 @@ const (
 	stsPolicy           = "Policy"
 	stsToken            = "Token"
 	stsWebIdentityToken = "WebIdentityToken"
+	stsRoleArn          = "RoleArn"
 	stsDurationSeconds  = "DurationSeconds"
 	stsLDAPUsername     = "LDAPUsername"
 	stsLDAPPassword     = "LDAPPassword"
@@ -51,6 +55,8 @@ const (
 	webIdentity  = "AssumeRoleWithWebIdentity"
 	ldapIdentity = "AssumeRoleWithLDAPIdentity"
 	assumeRole   = "AssumeRole"
+	certIdentity = "AssumeRoleWithCertificate"
+	customToken  = "AssumeRoleWithCustomToken"
 
 	stsRequestBodyLimit = 10 * (1 << 20) // 10 MiB
 
@@ -63,6 +69,12 @@ const (
 
 	// LDAP claim keys
 	ldapUser = "ldapUser"
+
+	// TLS client certificate claim keys
+	certCommonName = "certCommonName"
+
+	// Identity plugin claim keys
+	customTokenUser = "customTokenUser"
 )
 
 // stsAPIHandlers implements and provides http handlers for AWS STS API.
@@ -109,6 +121,17 @@ func registerSTSRouter(router *mux.Router) {
 		Queries(stsVersion, stsAPIVersion).
 		Queries(stsLDAPUsername, "{LDAPUsername:.*}").
 		Queries(stsLDAPPassword, "{LDAPPassword:.*}")
+
+	// AssumeRoleWithCertificate
+	stsRouter.Methods(http.MethodPost).HandlerFunc(HTTPTraceAll(sts.AssumeRoleWithCertificate)).
+		Queries(stsAction, certIdentity).
+		Queries(stsVersion, stsAPIVersion)
+
+	// AssumeRoleWithCustomToken
+	stsRouter.Methods(http.MethodPost).HandlerFunc(HTTPTraceAll(sts.AssumeRoleWithCustomToken)).
+		Queries(stsAction, customToken).
+		Queries(stsVersion, stsAPIVersion).
+		Queries(stsToken, "{Token:.*}")
 }
 
 func checkAssumeRoleAuth(ctx context.Context, r *http.Request) (user auth.Credentials, isErrCodeSTS bool, stsErr STSErrorCode) {
@@ -278,6 +301,12 @@ func (sts *stsAPIHandlers) AssumeRoleWithSSO(w http.ResponseWriter, r *http.Requ
 	case ldapIdentity:
 		sts.AssumeRoleWithLDAPIdentity(w, r)
 		return
+	case certIdentity:
+		sts.AssumeRoleWithCertificate(w, r)
+		return
+	case customToken:
+		sts.AssumeRoleWithCustomToken(w, r)
+		return
 	case clientGrants, webIdentity:
 	default:
 		writeSTSErrorResponse(ctx, w, true, ErrSTSInvalidParameterValue, fmt.Errorf("Unsupported action %s", action))
@@ -287,23 +316,31 @@ func (sts *stsAPIHandlers) AssumeRoleWithSSO(w http.ResponseWriter, r *http.Requ
 	ctx = NewContext(r, w, action)
 	defer logger.AuditLog(ctx, w, r, nil)
 
-	if globalOpenIDValidators == nil {
+	if globalOpenIDProviders == nil {
 		writeSTSErrorResponse(ctx, w, true, ErrSTSNotInitialized, errServerNotInitialized)
 		return
 	}
 
-	v, err := globalOpenIDValidators.Get("jwt")
-	if err != nil {
-		writeSTSErrorResponse(ctx, w, true, ErrSTSInvalidParameterValue, err)
-		return
-	}
-
 	token := r.Form.Get(stsToken)
 	if token == "" {
 		token = r.Form.Get(stsWebIdentityToken)
 	}
 
-	m, err := v.Validate(token, r.Form.Get(stsDurationSeconds))
+	// The provider is selected by the RoleArn parameter when
+	// present, otherwise by the issuer of the token.
+	var provider *openid.JWT
+	var err error
+	if roleArn := r.Form.Get(stsRoleArn); roleArn != "" {
+		provider, err = globalOpenIDProviders.ForRoleArn(roleArn)
+	} else {
+		provider, err = globalOpenIDProviders.ForToken(token)
+	}
+	if err != nil {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSInvalidParameterValue, err)
+		return
+	}
+
+	m, err := provider.Validate(token, r.Form.Get(stsDurationSeconds))
 	if err != nil {
 		switch err {
 		case openid.ErrTokenExpired:
@@ -322,19 +359,9 @@ func (sts *stsAPIHandlers) AssumeRoleWithSSO(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	// JWT has requested a custom claim with policy value set.
-	// This is a MinIO STS API specific value, this value should
-	// be set and configured on your identity provider as part of
-	// JWT custom claims.
-	var policyName string
-	policySet, ok := iampolicy.GetPoliciesFromClaims(m, iamPolicyClaimNameOpenID())
-	if ok {
-		policyName = GlobalIAMSys.CurrentPolicies(strings.Join(policySet.ToSlice(), ","))
-	}
-
-	if policyName == "" && GlobalPolicyOPA == nil {
-		writeSTSErrorResponse(ctx, w, true, ErrSTSInvalidParameterValue,
-			fmt.Errorf("%s claim missing from the JWT token, credentials will not be generated", iamPolicyClaimNameOpenID()))
+	policyName, err := openIDPolicyName(provider.Config, m)
+	if err != nil {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSInvalidParameterValue, err)
 		return
 	}
 	m[iamPolicyClaimNameOpenID()] = policyName
@@ -415,6 +442,34 @@ func (sts *stsAPIHandlers) AssumeRoleWithSSO(w http.ResponseWriter, r *http.Requ
 	WriteSuccessResponseXML(w, encodedSuccessResponse)
 }
 
+// openIDPolicyName - returns the canned policies for credentials issued
+// by an OpenID provider, the role policy of the provider when configured,
+// otherwise the policies in its policy claim. The policies are always
+// stored under the default provider claim name in the credentials.
+func openIDPolicyName(cfg openid.Config, claims map[string]interface{}) (string, error) {
+	var policyName string
+	if cfg.RolePolicy != "" {
+		policyName = GlobalIAMSys.CurrentPolicies(cfg.RolePolicy)
+		if policyName == "" && GlobalPolicyOPA == nil {
+			return "", fmt.Errorf("role policy %s of %s does not exist, credentials will not be generated", cfg.RolePolicy, cfg.RoleArn())
+		}
+		return policyName, nil
+	}
+
+	// JWT has requested a custom claim with policy value set.
+	// This is a MinIO STS API specific value, this value should
+	// be set and configured on your identity provider as part of
+	// JWT custom claims.
+	policySet, ok := iampolicy.GetPoliciesFromClaims(claims, cfg.PolicyClaimName())
+	if ok {
+		policyName = GlobalIAMSys.CurrentPolicies(strings.Join(policySet.ToSlice(), ","))
+	}
+	if policyName == "" && GlobalPolicyOPA == nil {
+		return "", fmt.Errorf("%s claim missing from the JWT token, credentials will not be generated", cfg.PolicyClaimName())
+	}
+	return policyName, nil
+}
+
 // AssumeRoleWithWebIdentity - implementation of AWS STS API supporting OAuth2.0
 // users from web identity provider such as Facebook, Google, or any OpenID
 // Connect-compatible identity provider.
@@ -560,3 +615,265 @@ func (sts *stsAPIHandlers) AssumeRoleWithLDAPIdentity(w http.ResponseWriter, r *
 
 	WriteSuccessResponseXML(w, encodedSuccessResponse)
 }
+
+// AssumeRoleWithCertificate - exchanges the TLS client certificate of
+// the request for temporary credentials. The certificate must be issued
+// by a trusted CA, its subject common name is the canned policy of the
+// credentials and the credentials never outlive the certificate.
+func (sts *stsAPIHandlers) AssumeRoleWithCertificate(w http.ResponseWriter, r *http.Request) {
+	ctx := NewContext(r, w, certIdentity)
+
+	defer logger.AuditLog(ctx, w, r, nil)
+
+	// Parse the incoming form data.
+	if err := r.ParseForm(); err != nil {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSInvalidParameterValue, err)
+		return
+	}
+
+	if r.Form.Get(stsVersion) != stsAPIVersion {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSMissingParameter,
+			fmt.Errorf("Invalid STS API version %s, expecting %s", r.Form.Get(stsVersion), stsAPIVersion))
+		return
+	}
+
+	action := r.Form.Get(stsAction)
+	switch action {
+	case certIdentity:
+	default:
+		writeSTSErrorResponse(ctx, w, true, ErrSTSInvalidParameterValue, fmt.Errorf("Unsupported action %s", action))
+		return
+	}
+
+	if !globalTLSIdentityConfig.Enabled {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSNotInitialized,
+			fmt.Errorf("%s is not enabled, please set %s=on", certIdentity, xtls.EnvIdentityTLSEnabled))
+		return
+	}
+
+	if r.TLS == nil {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSAccessDenied, xtls.ErrNoCertificate)
+		return
+	}
+
+	cert, err := globalTLSIdentityConfig.Verify(r.TLS.PeerCertificates, UTCNow())
+	if err != nil {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSAccessDenied, err)
+		return
+	}
+	commonName := cert.Subject.CommonName
+
+	sessionPolicyStr := r.Form.Get(stsPolicy)
+	// https://docs.aws.amazon.com/STS/latest/APIReference/API_AssumeRole.html
+	// The plain text that you use for both inline and managed session
+	// policies shouldn't exceed 2048 characters.
+	if len(sessionPolicyStr) > 2048 {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSInvalidParameterValue, fmt.Errorf("Session policy should not exceed 2048 characters"))
+		return
+	}
+
+	if len(sessionPolicyStr) > 0 {
+		sessionPolicy, err := iampolicy.ParseConfig(bytes.NewReader([]byte(sessionPolicyStr)))
+		if err != nil {
+			writeSTSErrorResponse(ctx, w, true, ErrSTSInvalidParameterValue, err)
+			return
+		}
+
+		// Version in policy must not be empty
+		if sessionPolicy.Version == "" {
+			writeSTSErrorResponse(ctx, w, true, ErrSTSInvalidParameterValue, fmt.Errorf("Version needs to be specified in session policy"))
+			return
+		}
+	}
+
+	// The common name must name exactly one canned policy.
+	policyName := GlobalIAMSys.CurrentPolicies(commonName)
+	if policyName != commonName && GlobalPolicyOPA == nil {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSInvalidParameterValue,
+			fmt.Errorf("no policy named after the certificate common name `%s` - rejecting this request", commonName))
+		return
+	}
+
+	expiryDur, err := openid.GetDefaultExpiration(r.Form.Get(stsDurationSeconds))
+	if err != nil {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSInvalidParameterValue, err)
+		return
+	}
+	expiry := UTCNow().Add(expiryDur)
+	if cert.NotAfter.Before(expiry) {
+		expiry = cert.NotAfter
+	}
+
+	m := map[string]interface{}{
+		expClaim:                   expiry.Unix(),
+		subClaim:                   commonName,
+		certCommonName:             commonName,
+		iamPolicyClaimNameOpenID(): policyName,
+	}
+
+	if len(sessionPolicyStr) > 0 {
+		m[iampolicy.SessionPolicyName] = base64.StdEncoding.EncodeToString([]byte(sessionPolicyStr))
+	}
+
+	secret := globalActiveCred.SecretKey
+	cred, err := auth.GetNewCredentialsWithMetadata(m, secret)
+	if err != nil {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSInternalError, err)
+		return
+	}
+
+	// Set the newly generated credentials.
+	if err = GlobalIAMSys.SetTempUser(cred.AccessKey, cred, policyName); err != nil {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSInternalError, err)
+		return
+	}
+
+	// Notify all other MinIO peers to reload temp users
+	for _, nerr := range GlobalNotificationSys.LoadUser(cred.AccessKey, true) {
+		if nerr.Err != nil {
+			logger.GetReqInfo(ctx).SetTags("peerAddress", nerr.Host.String())
+			logger.LogIf(ctx, nerr.Err)
+		}
+	}
+
+	certIdentityResponse := &AssumeRoleWithCertificateResponse{
+		Result: CertificateResult{
+			Credentials: cred,
+		},
+	}
+	certIdentityResponse.ResponseMetadata.RequestID = w.Header().Get(xhttp.AmzRequestID)
+	WriteSuccessResponseXML(w, EncodeResponse(certIdentityResponse))
+}
+
+// AssumeRoleWithCustomToken - exchanges an opaque token for temporary
+// credentials. The token is validated by the configured identity plugin
+// which returns the user, the canned policies of the credentials and
+// the maximum duration of the session.
+func (sts *stsAPIHandlers) AssumeRoleWithCustomToken(w http.ResponseWriter, r *http.Request) {
+	ctx := NewContext(r, w, customToken)
+
+	defer logger.AuditLog(ctx, w, r, nil, stsToken)
+
+	// Parse the incoming form data.
+	if err := r.ParseForm(); err != nil {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSInvalidParameterValue, err)
+		return
+	}
+
+	if r.Form.Get(stsVersion) != stsAPIVersion {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSMissingParameter,
+			fmt.Errorf("Invalid STS API version %s, expecting %s", r.Form.Get(stsVersion), stsAPIVersion))
+		return
+	}
+
+	action := r.Form.Get(stsAction)
+	switch action {
+	case customToken:
+	default:
+		writeSTSErrorResponse(ctx, w, true, ErrSTSInvalidParameterValue, fmt.Errorf("Unsupported action %s", action))
+		return
+	}
+
+	if globalIdentityPlugin == nil {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSNotInitialized, errors.New("identity plugin is not configured"))
+		return
+	}
+
+	token := r.Form.Get(stsToken)
+	if token == "" {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSMissingParameter, fmt.Errorf("Token cannot be empty"))
+		return
+	}
+
+	sessionPolicyStr := r.Form.Get(stsPolicy)
+	// https://docs.aws.amazon.com/STS/latest/APIReference/API_AssumeRole.html
+	// The plain text that you use for both inline and managed session
+	// policies shouldn't exceed 2048 characters.
+	if len(sessionPolicyStr) > 2048 {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSInvalidParameterValue, fmt.Errorf("Session policy should not exceed 2048 characters"))
+		return
+	}
+
+	if len(sessionPolicyStr) > 0 {
+		sessionPolicy, err := iampolicy.ParseConfig(bytes.NewReader([]byte(sessionPolicyStr)))
+		if err != nil {
+			writeSTSErrorResponse(ctx, w, true, ErrSTSInvalidParameterValue, err)
+			return
+		}
+
+		// Version in policy must not be empty
+		if sessionPolicy.Version == "" {
+			writeSTSErrorResponse(ctx, w, true, ErrSTSInvalidParameterValue, fmt.Errorf("Version needs to be specified in session policy"))
+			return
+		}
+	}
+
+	expiryDur, err := openid.GetDefaultExpiration(r.Form.Get(stsDurationSeconds))
+	if err != nil {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSInvalidParameterValue, err)
+		return
+	}
+
+	id, err := globalIdentityPlugin.Authenticate(token)
+	if err != nil {
+		if errors.Is(err, idplugin.ErrInvalidToken) {
+			writeSTSErrorResponse(ctx, w, true, ErrSTSAccessDenied, err)
+			return
+		}
+		writeSTSErrorResponse(ctx, w, true, ErrSTSInternalError, err)
+		return
+	}
+
+	// The session never outlives the duration granted by the plugin.
+	if maxDur := id.MaxDuration(); maxDur > 0 && maxDur < expiryDur {
+		expiryDur = maxDur
+	}
+
+	policyName := GlobalIAMSys.CurrentPolicies(strings.Join(id.Policies, ","))
+	if policyName == "" && GlobalPolicyOPA == nil {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSInvalidParameterValue,
+			fmt.Errorf("expecting a policy to be returned by the identity plugin for user `%s` - rejecting this request", id.User))
+		return
+	}
+
+	m := map[string]interface{}{
+		expClaim:                   UTCNow().Add(expiryDur).Unix(),
+		subClaim:                   id.User,
+		customTokenUser:            id.User,
+		iamPolicyClaimNameOpenID(): policyName,
+	}
+
+	if len(sessionPolicyStr) > 0 {
+		m[iampolicy.SessionPolicyName] = base64.StdEncoding.EncodeToString([]byte(sessionPolicyStr))
+	}
+
+	secret := globalActiveCred.SecretKey
+	cred, err := auth.GetNewCredentialsWithMetadata(m, secret)
+	if err != nil {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSInternalError, err)
+		return
+	}
+
+	// Set the newly generated credentials.
+	if err = GlobalIAMSys.SetTempUser(cred.AccessKey, cred, policyName); err != nil {
+		writeSTSErrorResponse(ctx, w, true, ErrSTSInternalError, err)
+		return
+	}
+
+	// Notify all other MinIO peers to reload temp users
+	for _, nerr := range GlobalNotificationSys.LoadUser(cred.AccessKey, true) {
+		if nerr.Err != nil {
+			logger.GetReqInfo(ctx).SetTags("peerAddress", nerr.Host.String())
+			logger.LogIf(ctx, nerr.Err)
+		}
+	}
+
+	customTokenResponse := &AssumeRoleWithCustomTokenResponse{
+		Result: CustomTokenResult{
+			Credentials: cred,
+			User:        id.User,
+		},
+	}
+	customTokenResponse.ResponseMetadata.RequestID = w.Header().Get(xhttp.AmzRequestID)
+	WriteSuccessResponseXML(w, EncodeResponse(customTokenResponse))
+}
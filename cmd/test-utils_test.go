@@ -1435,6 +1435,13 @@ func getBucketLifecycleURL(endPoint, bucketName string) (ret string) {
 	return makeTestTargetURL(endPoint, bucketName, "", queryValue)
 }
 
+// return URL for bucket versioning.
+func getBucketVersioningURL(endPoint, bucketName string) (ret string) {
+	queryValue := url.Values{}
+	queryValue.Set("versioning", "")
+	return makeTestTargetURL(endPoint, bucketName, "", queryValue)
+}
+
 // return URL for listing objects in the bucket with V1 legacy API.
 func getListObjectsV1URL(endPoint, bucketName, prefix, maxKeys, encodingType string) string {
 	queryValue := url.Values{}
@@ -2046,6 +2053,8 @@ func registerBucketLevelFunc(bucket *mux.Router, api ObjectAPIHandlers, apiFunct
 			bucket.Methods(http.MethodPut).HandlerFunc(api.PutBucketLifecycleHandler).Queries("lifecycle", "")
 		case "DeleteBucketLifecycle":
 			bucket.Methods(http.MethodDelete).HandlerFunc(api.DeleteBucketLifecycleHandler).Queries("lifecycle", "")
+		case "PutBucketVersioning":
+			bucket.Methods(http.MethodPut).HandlerFunc(api.PutBucketVersioningHandler).Queries("versioning", "")
 		case "GetBucketLocation":
 			// Register GetBucketLocation handler.
 			bucket.Methods(http.MethodGet).HandlerFunc(api.GetBucketLocationHandler).Queries("location", "")
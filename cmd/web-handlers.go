@@ -558,6 +558,9 @@ func (web *webAPIHandlers) RemoveObject(r *http.Request, args *RemoveObjectArgs,
 	opts := ObjectOptions{
 		Versioned:        globalBucketVersioningSys.Enabled(args.BucketName),
 		VersionSuspended: globalBucketVersioningSys.Suspended(args.BucketName),
+		PrefixEnabledFn: func(prefix string) bool {
+			return globalBucketVersioningSys.PrefixEnabled(args.BucketName, prefix)
+		},
 	}
 	var (
 		err           error
@@ -623,7 +626,10 @@ next:
 				deleteObject = web.CacheAPI().DeleteObject
 			}
 
-			oi, err := deleteObject(ctx, args.BucketName, objectName, opts)
+			objOpts := opts
+			objOpts.Versioned = globalBucketVersioningSys.PrefixEnabled(args.BucketName, objectName)
+			objOpts.VersionSuspended = globalBucketVersioningSys.PrefixSuspended(args.BucketName, objectName)
+			oi, err := deleteObject(ctx, args.BucketName, objectName, objOpts)
 			if err != nil {
 				switch err.(type) {
 				case BucketNotFound:
@@ -1046,11 +1052,8 @@ func (web *webAPIHandlers) Upload(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Check if bucket encryption is enabled
-	_, err = globalBucketSSEConfigSys.Get(bucket)
-	if (globalAutoEncryption || err == nil) && !crypto.SSEC.IsRequested(r.Header) {
-		r.Header.Set(xhttp.AmzServerSideEncryption, xhttp.AmzEncryptionAES)
-	}
+	// Apply the default encryption of the bucket, if any
+	setBucketEncryptionHeaders(r, bucket)
 
 	// Require Content-Length to be set in the request
 	size := r.ContentLength
@@ -1070,6 +1073,7 @@ func (web *webAPIHandlers) Upload(w http.ResponseWriter, r *http.Request) {
 		WriteErrorResponse(ctx, w, ToAPIError(ctx, err), r.URL, guessIsBrowserReq(r))
 		return
 	}
+	setDefaultStorageClass(ctx, bucket, object, metadata)
 
 	var pReader *PutObjReader
 	var reader io.Reader = r.Body
@@ -1172,6 +1176,9 @@ func (web *webAPIHandlers) Upload(w http.ResponseWriter, r *http.Request) {
 		switch kind, _ := crypto.IsEncrypted(objInfo.UserDefined); kind {
 		case crypto.S3:
 			w.Header().Set(xhttp.AmzServerSideEncryption, xhttp.AmzEncryptionAES)
+		case crypto.S3KMS:
+			w.Header().Set(xhttp.AmzServerSideEncryption, xhttp.AmzEncryptionKMS)
+			w.Header().Set(xhttp.AmzServerSideEncryptionKmsID, objInfo.UserDefined[crypto.MetaKeyID])
 		case crypto.SSEC:
 			w.Header().Set(xhttp.AmzServerSideEncryptionCustomerAlgorithm, r.Header.Get(xhttp.AmzServerSideEncryptionCustomerAlgorithm))
 			w.Header().Set(xhttp.AmzServerSideEncryptionCustomerKeyMD5, r.Header.Get(xhttp.AmzServerSideEncryptionCustomerKeyMD5))
@@ -1332,6 +1339,9 @@ func (web *webAPIHandlers) Download(w http.ResponseWriter, r *http.Request) {
 		switch kind, _ := crypto.IsEncrypted(objInfo.UserDefined); kind {
 		case crypto.S3:
 			w.Header().Set(xhttp.AmzServerSideEncryption, xhttp.AmzEncryptionAES)
+		case crypto.S3KMS:
+			w.Header().Set(xhttp.AmzServerSideEncryption, xhttp.AmzEncryptionKMS)
+			w.Header().Set(xhttp.AmzServerSideEncryptionKmsID, objInfo.UserDefined[crypto.MetaKeyID])
 		case crypto.SSEC:
 			w.Header().Set(xhttp.AmzServerSideEncryptionCustomerAlgorithm, r.Header.Get(xhttp.AmzServerSideEncryptionCustomerAlgorithm))
 			w.Header().Set(xhttp.AmzServerSideEncryptionCustomerKeyMD5, r.Header.Get(xhttp.AmzServerSideEncryptionCustomerKeyMD5))
@@ -1975,11 +1985,11 @@ var errSTSNotInitialized = errors.New("STS API not initialized, please configure
 func (web *webAPIHandlers) LoginSTS(r *http.Request, args *LoginSTSArgs, reply *LoginRep) error {
 	ctx := newWebContext(r, args, "WebLoginSTS")
 
-	if globalOpenIDValidators == nil {
+	if globalOpenIDProviders == nil {
 		return toJSONError(ctx, errSTSNotInitialized)
 	}
 
-	v, err := globalOpenIDValidators.Get("jwt")
+	v, err := globalOpenIDProviders.ForToken(args.Token)
 	if err != nil {
 		logger.LogIf(ctx, err)
 		return toJSONError(ctx, errSTSNotInitialized)
@@ -1990,17 +2000,9 @@ func (web *webAPIHandlers) LoginSTS(r *http.Request, args *LoginSTSArgs, reply *
 		return toJSONError(ctx, err)
 	}
 
-	// JWT has requested a custom claim with policy value set.
-	// This is a MinIO STS API specific value, this value should
-	// be set and configured on your identity provider as part of
-	// JWT custom claims.
-	var policyName string
-	policySet, ok := iampolicy.GetPoliciesFromClaims(m, iamPolicyClaimNameOpenID())
-	if ok {
-		policyName = GlobalIAMSys.CurrentPolicies(strings.Join(policySet.ToSlice(), ","))
-	}
-	if policyName == "" && GlobalPolicyOPA == nil {
-		return toJSONError(ctx, fmt.Errorf("%s claim missing from the JWT token, credentials will not be generated", iamPolicyClaimNameOpenID()))
+	policyName, err := openIDPolicyName(v.Config, m)
+	if err != nil {
+		return toJSONError(ctx, err)
 	}
 	m[iamPolicyClaimNameOpenID()] = policyName
 
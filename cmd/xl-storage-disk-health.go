@@ -0,0 +1,528 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"minio/cmd/config"
+	"minio/cmd/logger"
+	"minio/pkg/env"
+)
+
+// diskHealthState is the health of a local drive. A drive is degraded
+// when it shows errors, timeouts or latencies well above its peers in
+// the erasure set, and faulty when it keeps doing so. Faulty drives are
+// taken offline for reads and writes until they pass periodic probes.
+type diskHealthState int32
+
+const (
+	diskHealthOK diskHealthState = iota
+	diskHealthDegraded
+	diskHealthFaulty
+)
+
+func (s diskHealthState) String() string {
+	switch s {
+	case diskHealthOK:
+		return "healthy"
+	case diskHealthDegraded:
+		return "degraded"
+	case diskHealthFaulty:
+		return "faulty"
+	}
+	return "unknown"
+}
+
+const (
+	// Duration after which a call with a bounded
+	// amount of work counts as timed out.
+	diskMaxTimeout = 30 * time.Second
+
+	// Number of consecutive timeouts after which
+	// a drive is considered faulty.
+	diskMaxConsecutiveTimeouts = 3
+
+	// Minimum number of calls an error rate is computed over, fewer
+	// calls in a health window weigh as if this many were made.
+	diskHealthMinCalls = 10
+
+	// Error rates of a health window above which a
+	// drive is considered degraded or faulty.
+	diskDegradedErrorRate = 0.05
+	diskFaultyErrorRate   = 0.5
+
+	// A drive is a latency outlier when its average latency of a call
+	// is this many times the median of its peers in the set.
+	diskLatencyOutlierFactor = 5
+
+	// Average latencies below this are never outliers.
+	diskLatencyOutlierMin = 50 * time.Millisecond
+
+	// Minimum number of local drives of a set needed to compare
+	// their latencies. Only local drives are compared, so latency
+	// outliers are not detected in distributed setups with fewer
+	// local drives per set, errors and timeouts are still tracked.
+	diskLatencyMinPeers = 3
+
+	// Number of consecutive health windows after which
+	// a latency outlier is considered faulty.
+	diskMaxOutlierChecks = 6
+
+	// Interval of the health windows.
+	diskHealthCheckInterval = 10 * time.Second
+
+	// Timeout of the health of the drives of other nodes.
+	diskRemoteHealthTimeout = 5 * time.Second
+
+	// Faulty drives are probed at this interval and are taken
+	// back online after this many consecutive successful probes.
+	diskProbeInterval  = 5 * time.Second
+	diskProbeTimeout   = 5 * time.Second
+	diskProbeSuccesses = 3
+)
+
+// Timeout of the lock serializing the health
+// evaluation of the drives of a set by all nodes.
+var diskHealthLockTimeout = newDynamicTimeout(5*time.Second, time.Second)
+
+// Drive quarantine can be turned off, health states are still
+// tracked and reported but drives are never taken offline.
+var diskQuarantineEnabled = env.Get("_MINIO_DRIVE_QUARANTINE", config.EnableOn) == config.EnableOn
+
+// hasTimeout returns whether calls of the metric perform a bounded
+// amount of work, calls streaming or walking data may legitimately
+// take long and never time out.
+func (s storageMetric) hasTimeout() bool {
+	switch s {
+	case storageMetricWalkDir, storageMetricCreateFile, storageMetricReadFileStream,
+		storageMetricVerifyFile, storageMetricDeleteVol, storageMetricDelete:
+		return false
+	}
+	return true
+}
+
+// isDiskHealthErr returns whether the error points
+// to a problem of the drive rather than of the call.
+// Timeouts of the context of the caller, e.g. of a
+// client giving up, say nothing about the drive.
+func isDiskHealthErr(err error) bool {
+	return errors.Is(err, errFaultyDisk)
+}
+
+// diskHealthTracker tracks errors and timeouts of the calls to a drive
+// in health windows and derives the health state of the drive.
+type diskHealthTracker struct {
+	// Counters of the current health window.
+	calls    uint64
+	errors   uint64
+	timeouts uint64
+
+	totalErrors   uint64
+	totalTimeouts uint64
+
+	consecutiveTimeouts int32
+	outlierChecks       int32
+	state               int32
+	probing             int32
+	closed              int32
+}
+
+func newDiskHealthTracker() *diskHealthTracker {
+	return &diskHealthTracker{}
+}
+
+func (h *diskHealthTracker) getState() diskHealthState {
+	return diskHealthState(atomic.LoadInt32(&h.state))
+}
+
+func (h *diskHealthTracker) isFaulty() bool {
+	return diskQuarantineEnabled && h.getState() == diskHealthFaulty
+}
+
+// setState sets the health state, returns the previous state.
+func (h *diskHealthTracker) setState(s diskHealthState) diskHealthState {
+	return diskHealthState(atomic.SwapInt32(&h.state, int32(s)))
+}
+
+// record accounts a call of the metric which took d and returned err,
+// returns true when the drive has timed out repeatedly. The drive is
+// taken offline by the next evaluation of the health of its set.
+func (h *diskHealthTracker) record(s storageMetric, d time.Duration, err error) bool {
+	if h.getState() == diskHealthFaulty {
+		// Calls are rejected until the drive passes the probes.
+		return false
+	}
+
+	atomic.AddUint64(&h.calls, 1)
+	if s.hasTimeout() && d > diskMaxTimeout {
+		atomic.AddUint64(&h.timeouts, 1)
+		atomic.AddUint64(&h.totalTimeouts, 1)
+		atomic.AddUint64(&h.errors, 1)
+		atomic.AddUint64(&h.totalErrors, 1)
+		return atomic.AddInt32(&h.consecutiveTimeouts, 1) == diskMaxConsecutiveTimeouts
+	}
+
+	atomic.StoreInt32(&h.consecutiveTimeouts, 0)
+	if isDiskHealthErr(err) {
+		atomic.AddUint64(&h.errors, 1)
+		atomic.AddUint64(&h.totalErrors, 1)
+	}
+	return false
+}
+
+// evaluate closes the current health window and returns the health
+// state of the drive, outlier is whether the drive is a latency
+// outlier among its peers.
+func (h *diskHealthTracker) evaluate(outlier bool) diskHealthState {
+	calls := atomic.SwapUint64(&h.calls, 0)
+	errs := atomic.SwapUint64(&h.errors, 0)
+	timeouts := atomic.SwapUint64(&h.timeouts, 0)
+
+	if h.getState() == diskHealthFaulty {
+		return diskHealthFaulty
+	}
+
+	outlierChecks := int32(0)
+	if outlier {
+		outlierChecks = atomic.AddInt32(&h.outlierChecks, 1)
+	} else {
+		atomic.StoreInt32(&h.outlierChecks, 0)
+	}
+
+	if calls < diskHealthMinCalls {
+		calls = diskHealthMinCalls
+	}
+	errRate := float64(errs) / float64(calls)
+
+	switch {
+	case errRate >= diskFaultyErrorRate:
+		return diskHealthFaulty
+	case atomic.LoadInt32(&h.consecutiveTimeouts) >= diskMaxConsecutiveTimeouts:
+		return diskHealthFaulty
+	case outlierChecks >= diskMaxOutlierChecks:
+		return diskHealthFaulty
+	case errRate >= diskDegradedErrorRate, timeouts > 0, outlier:
+		return diskHealthDegraded
+	}
+	return diskHealthOK
+}
+
+// reset clears the health of a drive which passed the probes.
+func (h *diskHealthTracker) reset() {
+	atomic.StoreUint64(&h.calls, 0)
+	atomic.StoreUint64(&h.errors, 0)
+	atomic.StoreUint64(&h.timeouts, 0)
+	atomic.StoreInt32(&h.consecutiveTimeouts, 0)
+	atomic.StoreInt32(&h.outlierChecks, 0)
+	h.setState(diskHealthOK)
+}
+
+// setHealth updates the health state of the drive, drives which have
+// become faulty are logged and probed until they are healthy again.
+func (p *xlStorageDiskIDCheck) setHealth(s diskHealthState) {
+	prev := p.health.setState(s)
+	if prev == s {
+		return
+	}
+
+	switch s {
+	case diskHealthFaulty:
+		if diskQuarantineEnabled {
+			logger.LogIf(GlobalContext, fmt.Errorf("drive %s is faulty, taking it offline until it responds again: %w", p, errFaultyDisk))
+		} else {
+			logger.LogIf(GlobalContext, fmt.Errorf("drive %s is faulty: %w", p, errFaultyDisk))
+		}
+		if atomic.CompareAndSwapInt32(&p.health.probing, 0, 1) {
+			go p.monitorFaultyDisk(GlobalContext, diskProbeInterval)
+		}
+	case diskHealthDegraded:
+		if prev == diskHealthOK {
+			logger.Info(fmt.Sprintf("drive %s is degraded", p))
+		}
+	}
+}
+
+// setDegraded marks a healthy drive degraded, the state of drives
+// which are already degraded or faulty is kept.
+func (p *xlStorageDiskIDCheck) setDegraded() {
+	if atomic.CompareAndSwapInt32(&p.health.state, int32(diskHealthOK), int32(diskHealthDegraded)) {
+		logger.Info(fmt.Sprintf("drive %s is degraded", p))
+	}
+}
+
+// monitorFaultyDisk probes a faulty drive at the given interval, the
+// drive is healthy again after diskProbeSuccesses consecutive probes.
+func (p *xlStorageDiskIDCheck) monitorFaultyDisk(ctx context.Context, probeInterval time.Duration) {
+	defer atomic.StoreInt32(&p.health.probing, 0)
+
+	ticker := time.NewTicker(probeInterval)
+	defer ticker.Stop()
+
+	successes := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if atomic.LoadInt32(&p.health.closed) == 1 {
+			return
+		}
+
+		if err := p.probeDisk(ctx); err != nil {
+			successes = 0
+			continue
+		}
+
+		successes++
+		if successes >= diskProbeSuccesses {
+			p.health.reset()
+			logger.Info(fmt.Sprintf("drive %s responds again, taking it back online", p))
+			return
+		}
+	}
+}
+
+// probeDisk writes, reads back and removes a small file on the
+// drive, a drive hanging for longer than diskProbeTimeout fails.
+func (p *xlStorageDiskIDCheck) probeDisk(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, diskProbeTimeout)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- func() error {
+			if _, err := p.storage.DiskInfo(ctx); err != nil {
+				return err
+			}
+
+			probeFile := pathJoin("disk-probe", mustGetUUID())
+			data := []byte(probeFile)
+			if err := p.storage.WriteAll(ctx, minioMetaTmpBucket, probeFile, data); err != nil {
+				return err
+			}
+			defer p.storage.Delete(ctx, minioMetaTmpBucket, probeFile, false)
+
+			b, err := p.storage.ReadAll(ctx, minioMetaTmpBucket, probeFile)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(b, data) {
+				return errFaultyDisk
+			}
+			return nil
+		}()
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// latencyOutliers returns the drives whose average latencies are
+// outliers compared to their peers for at least half of the calls
+// both have been serving.
+func latencyOutliers(disks []*xlStorageDiskIDCheck) []bool {
+	outliers := make([]bool, len(disks))
+	if len(disks) < diskLatencyMinPeers {
+		return outliers
+	}
+
+	compared := make([]int, len(disks))
+	slow := make([]int, len(disks))
+	latencies := make([]float64, 0, len(disks))
+	for m := storageMetric(0); m < storageMetricLast; m++ {
+		if !m.hasTimeout() {
+			continue
+		}
+
+		latencies = latencies[:0]
+		for _, disk := range disks {
+			if atomic.LoadUint64(&disk.apiCalls[m]) > 0 {
+				latencies = append(latencies, disk.apiLatencies[m].Value())
+			}
+		}
+		if len(latencies) < diskLatencyMinPeers {
+			continue
+		}
+		sort.Float64s(latencies)
+		median := latencies[len(latencies)/2]
+
+		for i, disk := range disks {
+			if atomic.LoadUint64(&disk.apiCalls[m]) == 0 {
+				continue
+			}
+			compared[i]++
+			latency := disk.apiLatencies[m].Value()
+			if latency > float64(diskLatencyOutlierMin) && latency > median*diskLatencyOutlierFactor {
+				slow[i]++
+			}
+		}
+	}
+
+	for i := range disks {
+		outliers[i] = compared[i] > 0 && slow[i]*2 >= compared[i]
+	}
+	return outliers
+}
+
+// evaluateDisksHealth closes the health window of the local drives of
+// an erasure set. At most maxFaulty local drives are taken offline,
+// further faulty drives are reported as degraded, so that a failure
+// affecting all drives, e.g. of their controller, does not take the
+// set offline.
+func evaluateDisksHealth(disks []*xlStorageDiskIDCheck, maxFaulty int) {
+	faulty := 0
+	for _, disk := range disks {
+		if disk.health.getState() == diskHealthFaulty {
+			faulty++
+		}
+	}
+
+	outliers := latencyOutliers(disks)
+	for i, disk := range disks {
+		if disk.health.getState() == diskHealthFaulty {
+			continue
+		}
+		s := disk.health.evaluate(outliers[i])
+		if s == diskHealthFaulty {
+			if faulty >= maxFaulty {
+				s = diskHealthDegraded
+			} else {
+				faulty++
+			}
+		}
+		disk.setHealth(s)
+	}
+}
+
+// maxTolerableFailures returns the number of drives of a set of
+// setDriveCount drives which may be offline without objects of any
+// configured storage class becoming unreadable.
+func maxTolerableFailures(setDriveCount, defaultParityCount int) int {
+	tolerable := setDriveCount
+	for sc, parity := range storageClassParities(defaultParityCount) {
+		failures := parity
+		if erasureAlgoForSC(sc, setDriveCount-parity, parity) == LocalReconstruction {
+			// Only one loss more than the global parities
+			// is recoverable for any pattern of losses.
+			failures = parity - lrcLocalGroups + 1
+		}
+		if failures < tolerable {
+			tolerable = failures
+		}
+	}
+	return tolerable
+}
+
+// monitorDisksHealth evaluates the health of the
+// local drives of each set at the given interval.
+func (s *erasureSets) monitorDisksHealth(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for setIndex := range s.sets {
+			s.evaluateSetHealth(ctx, setIndex)
+		}
+	}
+}
+
+// evaluateSetHealth evaluates the health of the local drives of a
+// set. Drives are only taken offline as long as all drives of the set
+// which are offline or faulty, on any node, can be tolerated. Nodes
+// sharing the set evaluate it one at a time under a lock, so that they
+// see the drives taken offline by each other.
+func (s *erasureSets) evaluateSetHealth(ctx context.Context, setIndex int) {
+	var local []*xlStorageDiskIDCheck
+	var remote []StorageAPI
+	offline := 0
+	for _, disk := range s.GetDisks(setIndex)() {
+		switch disk := disk.(type) {
+		case nil:
+			offline++
+		case *xlStorageDiskIDCheck:
+			local = append(local, disk)
+			if disk.health.getState() != diskHealthFaulty && !disk.IsOnline() {
+				offline++
+			}
+		default:
+			remote = append(remote, disk)
+		}
+	}
+	if len(local) == 0 {
+		return
+	}
+
+	maxFaulty := maxTolerableFailures(s.setDriveCount, s.defaultParityCount)
+	if len(remote) > 0 {
+		lk := s.sets[setIndex].NewNSLock(minioMetaBucket, fmt.Sprintf("disk-health/%d/%d", s.poolIndex, setIndex))
+		lkctx, err := lk.GetLock(ctx, diskHealthLockTimeout)
+		if err != nil {
+			// Close the health window without
+			// taking any further drive offline.
+			evaluateDisksHealth(local, 0)
+			return
+		}
+		defer lk.Unlock()
+		offline += countUnavailableDisks(lkctx, remote)
+	}
+	evaluateDisksHealth(local, maxFaulty-offline)
+}
+
+// countUnavailableDisks returns the number of drives of other
+// nodes which are offline, faulty or do not respond in time.
+func countUnavailableDisks(ctx context.Context, disks []StorageAPI) int {
+	ctx, cancel := context.WithTimeout(ctx, diskRemoteHealthTimeout)
+	defer cancel()
+
+	var unavailable int32
+	var wg sync.WaitGroup
+	for _, disk := range disks {
+		wg.Add(1)
+		go func(disk StorageAPI) {
+			defer wg.Done()
+			if !disk.IsOnline() {
+				atomic.AddInt32(&unavailable, 1)
+				return
+			}
+			if _, err := disk.DiskInfo(ctx); err != nil {
+				atomic.AddInt32(&unavailable, 1)
+			}
+		}(disk)
+	}
+	wg.Wait()
+	return int(unavailable)
+}
@@ -0,0 +1,299 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"minio/cmd/config/storageclass"
+)
+
+func TestDiskHealthTrackerRecord(t *testing.T) {
+	h := newDiskHealthTracker()
+
+	// Slow calls streaming data never time out.
+	for i := 0; i < diskMaxConsecutiveTimeouts; i++ {
+		if h.record(storageMetricCreateFile, 2*diskMaxTimeout, nil) {
+			t.Fatal("Expected streaming calls not to time out")
+		}
+	}
+
+	// A successful call resets the consecutive timeouts.
+	for i := 0; i < diskMaxConsecutiveTimeouts-1; i++ {
+		if h.record(storageMetricReadVersion, 2*diskMaxTimeout, nil) {
+			t.Fatalf("Expected drive not to be faulty after %d timeouts", i+1)
+		}
+	}
+	h.record(storageMetricReadVersion, time.Millisecond, nil)
+	for i := 0; i < diskMaxConsecutiveTimeouts-1; i++ {
+		if h.record(storageMetricReadAll, 2*diskMaxTimeout, nil) {
+			t.Fatalf("Expected drive not to be faulty after %d timeouts", i+1)
+		}
+	}
+	if !h.record(storageMetricReadAll, 2*diskMaxTimeout, nil) {
+		t.Fatal("Expected drive to be faulty after consecutive timeouts")
+	}
+
+	if h.totalTimeouts != 2*diskMaxConsecutiveTimeouts-1 {
+		t.Fatalf("Expected %d timeouts, got %d", 2*diskMaxConsecutiveTimeouts-1, h.totalTimeouts)
+	}
+
+	// Errors of the call are not errors of the drive, neither
+	// are timeouts of the context of the caller.
+	h = newDiskHealthTracker()
+	h.record(storageMetricReadVersion, time.Millisecond, errFileNotFound)
+	h.record(storageMetricReadVersion, time.Millisecond, errFaultyDisk)
+	for i := 0; i < diskMaxConsecutiveTimeouts; i++ {
+		if h.record(storageMetricReadVersion, time.Millisecond, context.DeadlineExceeded) {
+			t.Fatal("Expected timeouts of the caller not to make the drive faulty")
+		}
+	}
+	if h.totalErrors != 1 || h.totalTimeouts != 0 {
+		t.Fatalf("Expected 1 error and no timeouts, got %d errors and %d timeouts", h.totalErrors, h.totalTimeouts)
+	}
+}
+
+func TestDiskHealthTrackerEvaluate(t *testing.T) {
+	testCases := []struct {
+		calls    int
+		errs     int
+		timeouts int
+		outlier  bool
+		expected diskHealthState
+	}{
+		{100, 0, 0, false, diskHealthOK},
+		{100, 2, 0, false, diskHealthOK},
+		{100, 5, 0, false, diskHealthDegraded},
+		{100, 50, 0, false, diskHealthFaulty},
+		// Few calls do not make a drive faulty.
+		{2, 2, 0, false, diskHealthDegraded},
+		{100, 0, 1, false, diskHealthDegraded},
+		{100, 0, 0, true, diskHealthDegraded},
+	}
+
+	for i, testCase := range testCases {
+		h := newDiskHealthTracker()
+		for j := 0; j < testCase.calls; j++ {
+			var err error
+			if j < testCase.errs {
+				err = errFaultyDisk
+			}
+			d := time.Millisecond
+			if j >= testCase.errs && j < testCase.errs+testCase.timeouts {
+				d = 2 * diskMaxTimeout
+			}
+			h.record(storageMetricReadVersion, d, err)
+		}
+		if s := h.evaluate(testCase.outlier); s != testCase.expected {
+			t.Errorf("Test %d: expected %s, got %s", i+1, testCase.expected, s)
+		}
+		// The health window is closed.
+		if s := h.evaluate(false); s != diskHealthOK {
+			t.Errorf("Test %d: expected %s in new window, got %s", i+1, diskHealthOK, s)
+		}
+	}
+
+	// Latency outliers become faulty after consecutive checks.
+	h := newDiskHealthTracker()
+	for i := 1; i < diskMaxOutlierChecks; i++ {
+		if s := h.evaluate(true); s != diskHealthDegraded {
+			t.Fatalf("Expected %s after %d outlier checks, got %s", diskHealthDegraded, i, s)
+		}
+	}
+	if s := h.evaluate(true); s != diskHealthFaulty {
+		t.Fatalf("Expected %s, got %s", diskHealthFaulty, s)
+	}
+
+	// Consecutive timeouts make a drive faulty.
+	h = newDiskHealthTracker()
+	for i := 0; i < diskMaxConsecutiveTimeouts; i++ {
+		h.record(storageMetricReadVersion, 2*diskMaxTimeout, nil)
+	}
+	if s := h.evaluate(false); s != diskHealthFaulty {
+		t.Fatalf("Expected %s, got %s", diskHealthFaulty, s)
+	}
+}
+
+func TestEvaluateDisksHealth(t *testing.T) {
+	var disks []*xlStorageDiskIDCheck
+	for i := 0; i < 4; i++ {
+		disk, diskPath, err := newXLStorageTestSetup()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(diskPath)
+		// Faulty drives are not probed by the test.
+		disk.health.probing = 1
+		disks = append(disks, disk)
+	}
+
+	// A failure reaching all drives takes at most
+	// maxFaulty of them offline, e.g. timeouts or errors.
+	for _, disk := range disks[:2] {
+		for i := 0; i < diskMaxConsecutiveTimeouts; i++ {
+			disk.health.record(storageMetricReadVersion, 2*diskMaxTimeout, nil)
+		}
+	}
+	for _, disk := range disks[2:] {
+		for i := 0; i < diskHealthMinCalls; i++ {
+			disk.health.record(storageMetricReadVersion, time.Millisecond, errFaultyDisk)
+		}
+	}
+	evaluateDisksHealth(disks, 1)
+
+	faulty, degraded := 0, 0
+	for _, disk := range disks {
+		switch disk.health.getState() {
+		case diskHealthFaulty:
+			faulty++
+		case diskHealthDegraded:
+			degraded++
+		}
+	}
+	if faulty != 1 || degraded != 3 {
+		t.Fatalf("Expected 1 faulty and 3 degraded drives, got %d faulty and %d degraded", faulty, degraded)
+	}
+}
+
+func TestMaxTolerableFailures(t *testing.T) {
+	defer globalStorageClass.Update(storageclass.Config{})
+
+	testCases := []struct {
+		cfg      storageclass.Config
+		expected int
+	}{
+		{storageclass.Config{RRS: storageclass.StorageClass{Parity: 4}}, 4},
+		{storageclass.Config{RRS: storageclass.StorageClass{Parity: 2}}, 2},
+		{storageclass.Config{
+			RRS:    storageclass.StorageClass{Parity: 4},
+			Custom: map[string]storageclass.StorageClass{"COLD": {Parity: 1}},
+		}, 1},
+		// Locally repairable codes tolerate any loss of one
+		// drive more than their global parities.
+		{storageclass.Config{
+			RRS:   storageclass.StorageClass{Parity: 4},
+			Codec: map[string]string{storageclass.STANDARD: storageclass.CodecLRC},
+		}, 3},
+	}
+	for i, testCase := range testCases {
+		globalStorageClass.Update(testCase.cfg)
+		if n := maxTolerableFailures(16, 4); n != testCase.expected {
+			t.Errorf("Test %d: expected %d, got %d", i+1, testCase.expected, n)
+		}
+	}
+}
+
+func TestLatencyOutliers(t *testing.T) {
+	newDisk := func(latency time.Duration) *xlStorageDiskIDCheck {
+		disk := newXLStorageDiskIDCheck(nil)
+		for _, m := range []storageMetric{storageMetricReadVersion, storageMetricWriteMetadata} {
+			disk.apiCalls[m] = 1
+			disk.apiLatencies[m].Set(float64(latency))
+		}
+		// Streaming calls are not compared.
+		disk.apiCalls[storageMetricCreateFile] = 1
+		disk.apiLatencies[storageMetricCreateFile].Set(float64(time.Hour))
+		return disk
+	}
+
+	testCases := []struct {
+		latencies []time.Duration
+		expected  []bool
+	}{
+		{
+			[]time.Duration{time.Millisecond, time.Millisecond, time.Millisecond, time.Millisecond},
+			[]bool{false, false, false, false},
+		},
+		{
+			[]time.Duration{20 * time.Millisecond, 20 * time.Millisecond, time.Second, 30 * time.Millisecond},
+			[]bool{false, false, true, false},
+		},
+		// Slow but below the minimum outlier latency.
+		{
+			[]time.Duration{time.Millisecond, time.Millisecond, 40 * time.Millisecond},
+			[]bool{false, false, false},
+		},
+		// Too few peers, e.g. the one or two local drives per
+		// set of distributed setups, are never outliers.
+		{
+			[]time.Duration{time.Millisecond, time.Second},
+			[]bool{false, false},
+		},
+	}
+
+	for i, testCase := range testCases {
+		var disks []*xlStorageDiskIDCheck
+		for _, latency := range testCase.latencies {
+			disks = append(disks, newDisk(latency))
+		}
+		outliers := latencyOutliers(disks)
+		for j := range outliers {
+			if outliers[j] != testCase.expected[j] {
+				t.Errorf("Test %d: expected drive %d outlier %v, got %v", i+1, j, testCase.expected[j], outliers[j])
+			}
+		}
+	}
+}
+
+func TestDiskHealthQuarantine(t *testing.T) {
+	disk, diskPath, err := newXLStorageTestSetup()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(diskPath)
+
+	ctx := context.Background()
+	if err = disk.MakeVol(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+
+	disk.health.setState(diskHealthFaulty)
+	if _, err = disk.StatVol(ctx, "bucket"); !errors.Is(err, errFaultyDisk) {
+		t.Fatalf("Expected %v, got %v", errFaultyDisk, err)
+	}
+	info, err := disk.DiskInfo(ctx)
+	if !errors.Is(err, errFaultyDisk) {
+		t.Fatalf("Expected %v, got %v", errFaultyDisk, err)
+	}
+	if info.Metrics.Health != diskHealthFaulty.String() || info.MountPath != diskPath {
+		t.Fatalf("Unexpected disk info of faulty drive %#v", info)
+	}
+
+	// Probes keep failing while the drive fails.
+	healthy := disk.storage
+	disk.storage = newNaughtyDisk(healthy, nil, errFaultyDisk)
+	probeCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	disk.monitorFaultyDisk(probeCtx, 10*time.Millisecond)
+	cancel()
+	if disk.health.getState() != diskHealthFaulty {
+		t.Fatalf("Expected drive to stay faulty, got %s", disk.health.getState())
+	}
+
+	// The drive is back online after passing the probes.
+	disk.storage = healthy
+	disk.monitorFaultyDisk(ctx, 10*time.Millisecond)
+	if disk.health.getState() != diskHealthOK {
+		t.Fatalf("Expected drive to be healthy, got %s", disk.health.getState())
+	}
+	if _, err = disk.StatVol(ctx, "bucket"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -71,6 +71,7 @@ type xlStorageDiskIDCheck struct {
 	// please use `fieldalignment ./...` to check
 	// if your changes are not causing any problems.
 	storage      StorageAPI
+	health       *diskHealthTracker
 	apiLatencies [storageMetricLast]ewma.MovingAverage
 	diskID       string
 	apiCalls     [storageMetricLast]uint64
@@ -78,8 +79,11 @@ type xlStorageDiskIDCheck struct {
 
 func (p *xlStorageDiskIDCheck) getMetrics() DiskMetrics {
 	diskMetric := DiskMetrics{
-		APILatencies: make(map[string]string),
-		APICalls:     make(map[string]uint64),
+		APILatencies:  make(map[string]string),
+		APICalls:      make(map[string]uint64),
+		Health:        p.health.getState().String(),
+		TotalErrors:   atomic.LoadUint64(&p.health.totalErrors),
+		TotalTimeouts: atomic.LoadUint64(&p.health.totalTimeouts),
 	}
 	for i, v := range p.apiLatencies {
 		diskMetric.APILatencies[storageMetric(i).String()] = time.Duration(v.Value()).String()
@@ -117,6 +121,7 @@ func (e *lockedSimpleEWMA) Value() float64 {
 func newXLStorageDiskIDCheck(storage *xlStorage) *xlStorageDiskIDCheck {
 	xl := xlStorageDiskIDCheck{
 		storage: storage,
+		health:  newDiskHealthTracker(),
 	}
 	for i := range xl.apiLatencies[:] {
 		xl.apiLatencies[i] = &lockedSimpleEWMA{
@@ -176,6 +181,7 @@ func (p *xlStorageDiskIDCheck) SetDiskLoc(poolIdx, setIdx, diskIdx int) {
 }
 
 func (p *xlStorageDiskIDCheck) Close() error {
+	atomic.StoreInt32(&p.health.closed, 1)
 	return p.storage.Close()
 }
 
@@ -188,6 +194,10 @@ func (p *xlStorageDiskIDCheck) SetDiskID(id string) {
 }
 
 func (p *xlStorageDiskIDCheck) checkDiskStale() error {
+	if p.health.isFaulty() {
+		// Faulty drives are offline until they respond again.
+		return errFaultyDisk
+	}
 	if p.diskID == "" {
 		// For empty disk-id we allow the call as the server might be
 		// coming up and trying to read format.json or create format.json
@@ -212,6 +222,18 @@ func (p *xlStorageDiskIDCheck) DiskInfo(ctx context.Context) (info DiskInfo, err
 	default:
 	}
 
+	if p.health.isFaulty() {
+		// Do not wait for a faulty drive, report
+		// its health and the drive as faulty.
+		endpoint := p.storage.Endpoint()
+		return DiskInfo{
+			Endpoint:  endpoint.String(),
+			MountPath: endpoint.Path,
+			ID:        p.diskID,
+			Metrics:   p.getMetrics(),
+		}, errFaultyDisk
+	}
+
 	info, err = p.storage.DiskInfo(ctx)
 	if err != nil {
 		return info, err
@@ -229,7 +251,7 @@ func (p *xlStorageDiskIDCheck) DiskInfo(ctx context.Context) (info DiskInfo, err
 }
 
 func (p *xlStorageDiskIDCheck) MakeVolBulk(ctx context.Context, volumes ...string) (err error) {
-	defer p.updateStorageMetrics(storageMetricMakeVolBulk, volumes...)()
+	defer p.updateStorageMetrics(storageMetricMakeVolBulk, volumes...)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -244,7 +266,7 @@ func (p *xlStorageDiskIDCheck) MakeVolBulk(ctx context.Context, volumes ...strin
 }
 
 func (p *xlStorageDiskIDCheck) MakeVol(ctx context.Context, volume string) (err error) {
-	defer p.updateStorageMetrics(storageMetricMakeVol, volume)()
+	defer p.updateStorageMetrics(storageMetricMakeVol, volume)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -258,8 +280,8 @@ func (p *xlStorageDiskIDCheck) MakeVol(ctx context.Context, volume string) (err
 	return p.storage.MakeVol(ctx, volume)
 }
 
-func (p *xlStorageDiskIDCheck) ListVols(ctx context.Context) ([]VolInfo, error) {
-	defer p.updateStorageMetrics(storageMetricListVols, "/")()
+func (p *xlStorageDiskIDCheck) ListVols(ctx context.Context) (vi []VolInfo, err error) {
+	defer p.updateStorageMetrics(storageMetricListVols, "/")(&err)
 
 	select {
 	case <-ctx.Done():
@@ -274,7 +296,7 @@ func (p *xlStorageDiskIDCheck) ListVols(ctx context.Context) ([]VolInfo, error)
 }
 
 func (p *xlStorageDiskIDCheck) StatVol(ctx context.Context, volume string) (vol VolInfo, err error) {
-	defer p.updateStorageMetrics(storageMetricStatVol, volume)()
+	defer p.updateStorageMetrics(storageMetricStatVol, volume)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -289,7 +311,7 @@ func (p *xlStorageDiskIDCheck) StatVol(ctx context.Context, volume string) (vol
 }
 
 func (p *xlStorageDiskIDCheck) DeleteVol(ctx context.Context, volume string, forceDelete bool) (err error) {
-	defer p.updateStorageMetrics(storageMetricDeleteVol, volume)()
+	defer p.updateStorageMetrics(storageMetricDeleteVol, volume)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -303,8 +325,8 @@ func (p *xlStorageDiskIDCheck) DeleteVol(ctx context.Context, volume string, for
 	return p.storage.DeleteVol(ctx, volume, forceDelete)
 }
 
-func (p *xlStorageDiskIDCheck) ListDir(ctx context.Context, volume, dirPath string, count int) ([]string, error) {
-	defer p.updateStorageMetrics(storageMetricListDir, volume, dirPath)()
+func (p *xlStorageDiskIDCheck) ListDir(ctx context.Context, volume, dirPath string, count int) (s []string, err error) {
+	defer p.updateStorageMetrics(storageMetricListDir, volume, dirPath)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -320,7 +342,7 @@ func (p *xlStorageDiskIDCheck) ListDir(ctx context.Context, volume, dirPath stri
 }
 
 func (p *xlStorageDiskIDCheck) ReadFile(ctx context.Context, volume string, path string, offset int64, buf []byte, verifier *BitrotVerifier) (n int64, err error) {
-	defer p.updateStorageMetrics(storageMetricReadFile, volume, path)()
+	defer p.updateStorageMetrics(storageMetricReadFile, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -336,7 +358,7 @@ func (p *xlStorageDiskIDCheck) ReadFile(ctx context.Context, volume string, path
 }
 
 func (p *xlStorageDiskIDCheck) AppendFile(ctx context.Context, volume string, path string, buf []byte) (err error) {
-	defer p.updateStorageMetrics(storageMetricAppendFile, volume, path)()
+	defer p.updateStorageMetrics(storageMetricAppendFile, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -351,8 +373,8 @@ func (p *xlStorageDiskIDCheck) AppendFile(ctx context.Context, volume string, pa
 	return p.storage.AppendFile(ctx, volume, path, buf)
 }
 
-func (p *xlStorageDiskIDCheck) CreateFile(ctx context.Context, volume, path string, size int64, reader io.Reader) error {
-	defer p.updateStorageMetrics(storageMetricCreateFile, volume, path)()
+func (p *xlStorageDiskIDCheck) CreateFile(ctx context.Context, volume, path string, size int64, reader io.Reader) (err error) {
+	defer p.updateStorageMetrics(storageMetricCreateFile, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -367,8 +389,8 @@ func (p *xlStorageDiskIDCheck) CreateFile(ctx context.Context, volume, path stri
 	return p.storage.CreateFile(ctx, volume, path, size, reader)
 }
 
-func (p *xlStorageDiskIDCheck) ReadFileStream(ctx context.Context, volume, path string, offset, length int64) (io.ReadCloser, error) {
-	defer p.updateStorageMetrics(storageMetricReadFileStream, volume, path)()
+func (p *xlStorageDiskIDCheck) ReadFileStream(ctx context.Context, volume, path string, offset, length int64) (rc io.ReadCloser, err error) {
+	defer p.updateStorageMetrics(storageMetricReadFileStream, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -383,8 +405,8 @@ func (p *xlStorageDiskIDCheck) ReadFileStream(ctx context.Context, volume, path
 	return p.storage.ReadFileStream(ctx, volume, path, offset, length)
 }
 
-func (p *xlStorageDiskIDCheck) RenameFile(ctx context.Context, srcVolume, srcPath, dstVolume, dstPath string) error {
-	defer p.updateStorageMetrics(storageMetricRenameFile, srcVolume, srcPath, dstVolume, dstPath)()
+func (p *xlStorageDiskIDCheck) RenameFile(ctx context.Context, srcVolume, srcPath, dstVolume, dstPath string) (err error) {
+	defer p.updateStorageMetrics(storageMetricRenameFile, srcVolume, srcPath, dstVolume, dstPath)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -399,8 +421,8 @@ func (p *xlStorageDiskIDCheck) RenameFile(ctx context.Context, srcVolume, srcPat
 	return p.storage.RenameFile(ctx, srcVolume, srcPath, dstVolume, dstPath)
 }
 
-func (p *xlStorageDiskIDCheck) RenameData(ctx context.Context, srcVolume, srcPath string, fi FileInfo, dstVolume, dstPath string) error {
-	defer p.updateStorageMetrics(storageMetricRenameData, srcPath, fi.DataDir, dstVolume, dstPath)()
+func (p *xlStorageDiskIDCheck) RenameData(ctx context.Context, srcVolume, srcPath string, fi FileInfo, dstVolume, dstPath string) (err error) {
+	defer p.updateStorageMetrics(storageMetricRenameData, srcPath, fi.DataDir, dstVolume, dstPath)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -416,7 +438,7 @@ func (p *xlStorageDiskIDCheck) RenameData(ctx context.Context, srcVolume, srcPat
 }
 
 func (p *xlStorageDiskIDCheck) CheckParts(ctx context.Context, volume string, path string, fi FileInfo) (err error) {
-	defer p.updateStorageMetrics(storageMetricCheckParts, volume, path)()
+	defer p.updateStorageMetrics(storageMetricCheckParts, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -432,7 +454,7 @@ func (p *xlStorageDiskIDCheck) CheckParts(ctx context.Context, volume string, pa
 }
 
 func (p *xlStorageDiskIDCheck) CheckFile(ctx context.Context, volume string, path string) (err error) {
-	defer p.updateStorageMetrics(storageMetricCheckFile, volume, path)()
+	defer p.updateStorageMetrics(storageMetricCheckFile, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -448,7 +470,7 @@ func (p *xlStorageDiskIDCheck) CheckFile(ctx context.Context, volume string, pat
 }
 
 func (p *xlStorageDiskIDCheck) Delete(ctx context.Context, volume string, path string, recursive bool) (err error) {
-	defer p.updateStorageMetrics(storageMetricDelete, volume, path)()
+	defer p.updateStorageMetrics(storageMetricDelete, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -472,7 +494,8 @@ func (p *xlStorageDiskIDCheck) DeleteVersions(ctx context.Context, volume string
 		path = versions[0].Name
 	}
 
-	defer p.updateStorageMetrics(storageMetricDeleteVersions, volume, path)()
+	var err error
+	defer p.updateStorageMetrics(storageMetricDeleteVersions, volume, path)(&err)
 
 	errs = make([]error, len(versions))
 
@@ -485,18 +508,25 @@ func (p *xlStorageDiskIDCheck) DeleteVersions(ctx context.Context, volume string
 	default:
 	}
 
-	if err := p.checkDiskStale(); err != nil {
+	if err = p.checkDiskStale(); err != nil {
 		for i := range errs {
 			errs[i] = err
 		}
 		return errs
 	}
 
-	return p.storage.DeleteVersions(ctx, volume, versions)
+	errs = p.storage.DeleteVersions(ctx, volume, versions)
+	for _, verr := range errs {
+		if isDiskHealthErr(verr) {
+			err = verr
+			break
+		}
+	}
+	return errs
 }
 
-func (p *xlStorageDiskIDCheck) VerifyFile(ctx context.Context, volume, path string, fi FileInfo) error {
-	defer p.updateStorageMetrics(storageMetricVerifyFile, volume, path)()
+func (p *xlStorageDiskIDCheck) VerifyFile(ctx context.Context, volume, path string, fi FileInfo) (err error) {
+	defer p.updateStorageMetrics(storageMetricVerifyFile, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -512,7 +542,7 @@ func (p *xlStorageDiskIDCheck) VerifyFile(ctx context.Context, volume, path stri
 }
 
 func (p *xlStorageDiskIDCheck) WriteAll(ctx context.Context, volume string, path string, b []byte) (err error) {
-	defer p.updateStorageMetrics(storageMetricWriteAll, volume, path)()
+	defer p.updateStorageMetrics(storageMetricWriteAll, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -528,7 +558,7 @@ func (p *xlStorageDiskIDCheck) WriteAll(ctx context.Context, volume string, path
 }
 
 func (p *xlStorageDiskIDCheck) DeleteVersion(ctx context.Context, volume, path string, fi FileInfo, forceDelMarker bool) (err error) {
-	defer p.updateStorageMetrics(storageMetricDeleteVersion, volume, path)()
+	defer p.updateStorageMetrics(storageMetricDeleteVersion, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -544,7 +574,7 @@ func (p *xlStorageDiskIDCheck) DeleteVersion(ctx context.Context, volume, path s
 }
 
 func (p *xlStorageDiskIDCheck) UpdateMetadata(ctx context.Context, volume, path string, fi FileInfo) (err error) {
-	defer p.updateStorageMetrics(storageMetricUpdateMetadata, volume, path)()
+	defer p.updateStorageMetrics(storageMetricUpdateMetadata, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -560,7 +590,7 @@ func (p *xlStorageDiskIDCheck) UpdateMetadata(ctx context.Context, volume, path
 }
 
 func (p *xlStorageDiskIDCheck) WriteMetadata(ctx context.Context, volume, path string, fi FileInfo) (err error) {
-	defer p.updateStorageMetrics(storageMetricWriteMetadata, volume, path)()
+	defer p.updateStorageMetrics(storageMetricWriteMetadata, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -576,7 +606,7 @@ func (p *xlStorageDiskIDCheck) WriteMetadata(ctx context.Context, volume, path s
 }
 
 func (p *xlStorageDiskIDCheck) ReadVersion(ctx context.Context, volume, path, versionID string, readData bool) (fi FileInfo, err error) {
-	defer p.updateStorageMetrics(storageMetricReadVersion, volume, path)()
+	defer p.updateStorageMetrics(storageMetricReadVersion, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -592,7 +622,7 @@ func (p *xlStorageDiskIDCheck) ReadVersion(ctx context.Context, volume, path, ve
 }
 
 func (p *xlStorageDiskIDCheck) ReadAll(ctx context.Context, volume string, path string) (buf []byte, err error) {
-	defer p.updateStorageMetrics(storageMetricReadAll, volume, path)()
+	defer p.updateStorageMetrics(storageMetricReadAll, volume, path)(&err)
 
 	select {
 	case <-ctx.Done():
@@ -620,16 +650,21 @@ func storageTrace(s storageMetric, startTime time.Time, duration time.Duration,
 	}
 }
 
-// Update storage metrics
-func (p *xlStorageDiskIDCheck) updateStorageMetrics(s storageMetric, paths ...string) func() {
+// Update storage metrics and the health of the drive
+// with the outcome of the call returning *err.
+func (p *xlStorageDiskIDCheck) updateStorageMetrics(s storageMetric, paths ...string) func(err *error) {
 	startTime := time.Now()
 	trace := globalTrace.NumSubscribers() > 0
-	return func() {
+	return func(err *error) {
 		duration := time.Since(startTime)
 
 		atomic.AddUint64(&p.apiCalls[s], 1)
 		p.apiLatencies[s].Add(float64(duration))
 
+		if p.health.record(s, duration, *err) {
+			p.setDegraded()
+		}
+
 		if trace {
 			globalTrace.Publish(storageTrace(s, startTime, duration, strings.Join(paths, " ")))
 		}
@@ -44,6 +44,9 @@ func getFileInfoVersions(xlMetaBuf []byte, volume, path string) (FileInfoVersion
 		if err != nil {
 			return FileInfoVersions{}, err
 		}
+		for i := range versions {
+			markInlineData(xlMeta, &versions[i])
+		}
 		return FileInfoVersions{
 			Volume:        volume,
 			Name:          path,
@@ -80,9 +83,13 @@ func getFileInfo(xlMetaBuf []byte, volume, path, versionID string, data bool) (F
 			return FileInfo{}, err
 		}
 		fi, err := xlMeta.ToFileInfo(volume, path, versionID)
-		if !data || err != nil {
+		if err != nil {
 			return fi, err
 		}
+		markInlineData(xlMeta, &fi)
+		if !data {
+			return fi, nil
+		}
 		versionID := fi.VersionID
 		if versionID == "" {
 			versionID = nullVersionID
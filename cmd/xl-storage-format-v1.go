@@ -113,6 +113,10 @@ const (
 	HighwayHash256S
 	// BLAKE2b512 represents the BLAKE2b-512 hash function
 	BLAKE2b512
+	// BLAKE3 represents the Streaming BLAKE3-256 hash function
+	BLAKE3
+	// XXH3 represents the Streaming non-cryptographic XXH3-128 hash function
+	XXH3
 )
 
 // DefaultBitrotAlgorithm is the default algorithm used for bitrot protection.
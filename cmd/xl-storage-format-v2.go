@@ -137,9 +137,11 @@ type ErasureAlgo uint8
 
 // List of currently supported erasure coding algorithms
 const (
-	invalidErasureAlgo ErasureAlgo = 0
-	ReedSolomon        ErasureAlgo = 1
-	lastErasureAlgo    ErasureAlgo = 2
+	invalidErasureAlgo  ErasureAlgo = 0
+	ReedSolomon         ErasureAlgo = 1
+	ReedSolomonCauchy   ErasureAlgo = 2
+	LocalReconstruction ErasureAlgo = 3
+	lastErasureAlgo     ErasureAlgo = 4
 )
 
 func (e ErasureAlgo) valid() bool {
@@ -150,10 +152,29 @@ func (e ErasureAlgo) String() string {
 	switch e {
 	case ReedSolomon:
 		return "reedsolomon"
+	case ReedSolomonCauchy:
+		return "reedsolomon-cauchy"
+	case LocalReconstruction:
+		return "lrc"
 	}
 	return ""
 }
 
+// erasureAlgoFromString returns the erasure algorithm of the
+// Algorithm of ErasureInfo, objects written before the algorithm
+// was recorded are Reed-Solomon with a Vandermonde matrix.
+func erasureAlgoFromString(s string) ErasureAlgo {
+	switch s {
+	case "", erasureAlgorithm, ReedSolomon.String():
+		return ReedSolomon
+	case ReedSolomonCauchy.String():
+		return ReedSolomonCauchy
+	case LocalReconstruction.String():
+		return LocalReconstruction
+	}
+	return invalidErasureAlgo
+}
+
 // ChecksumAlgo defines common type of different checksum algorithms
 type ChecksumAlgo uint8
 
@@ -161,13 +182,41 @@ type ChecksumAlgo uint8
 const (
 	invalidChecksumAlgo ChecksumAlgo = 0
 	HighwayHash         ChecksumAlgo = 1
-	lastChecksumAlgo    ChecksumAlgo = 2
+	BLAKE3Hash          ChecksumAlgo = 2
+	XXH3Hash            ChecksumAlgo = 3
+	lastChecksumAlgo    ChecksumAlgo = 4
 )
 
 func (e ChecksumAlgo) valid() bool {
 	return e > invalidChecksumAlgo && e < lastChecksumAlgo
 }
 
+// bitrotAlgorithm returns the streaming bitrot algorithm of the checksum algorithm.
+func (e ChecksumAlgo) bitrotAlgorithm() BitrotAlgorithm {
+	switch e {
+	case HighwayHash:
+		return HighwayHash256S
+	case BLAKE3Hash:
+		return BLAKE3
+	case XXH3Hash:
+		return XXH3
+	}
+	return 0
+}
+
+// checksumAlgoFromBitrot returns the checksum algorithm recorded in
+// xl.meta for the bitrot algorithm, parts are always checksummed
+// with a streaming algorithm.
+func checksumAlgoFromBitrot(a BitrotAlgorithm) ChecksumAlgo {
+	switch a {
+	case BLAKE3:
+		return BLAKE3Hash
+	case XXH3:
+		return XXH3Hash
+	}
+	return HighwayHash
+}
+
 // xlMetaV2DeleteMarker defines the data struct for the delete marker journal type
 type xlMetaV2DeleteMarker struct {
 	VersionID [16]byte          `json:"ID" msg:"ID"`                               // Version ID for delete marker
@@ -795,18 +844,31 @@ func (z *xlMetaV2) AddVersion(fi FileInfo) error {
 			MetaSys:   make(map[string][]byte),
 		}
 	} else {
+		algo := erasureAlgoFromString(fi.Erasure.Algorithm)
+		if !algo.valid() {
+			return fmt.Errorf("unknown erasure algorithm %q", fi.Erasure.Algorithm)
+		}
+		checksumAlgo := HighwayHash
+		for i, checksum := range fi.Erasure.Checksums {
+			// xl.meta records a single checksum algorithm for all parts.
+			if algo := checksumAlgoFromBitrot(checksum.Algorithm); i == 0 {
+				checksumAlgo = algo
+			} else if algo != checksumAlgo {
+				return errors.New("parts with different bitrot algorithms")
+			}
+		}
 		ventry.Type = ObjectType
 		ventry.ObjectV2 = &xlMetaV2Object{
 			VersionID:          uv,
 			DataDir:            dd,
 			Size:               fi.Size,
 			ModTime:            fi.ModTime.UnixNano(),
-			ErasureAlgorithm:   ReedSolomon,
+			ErasureAlgorithm:   algo,
 			ErasureM:           fi.Erasure.DataBlocks,
 			ErasureN:           fi.Erasure.ParityBlocks,
 			ErasureBlockSize:   fi.Erasure.BlockSize,
 			ErasureIndex:       fi.Erasure.Index,
-			BitrotChecksumAlgo: HighwayHash,
+			BitrotChecksumAlgo: checksumAlgo,
 			ErasureDist:        make([]uint8, len(fi.Erasure.Distribution)),
 			PartNumbers:        make([]int, len(fi.Parts)),
 			PartETags:          make([]string, len(fi.Parts)),
@@ -935,8 +997,8 @@ func (j xlMetaV2Object) ToFileInfo(volume, path string) (FileInfo, error) {
 	for i := range fi.Parts {
 		fi.Erasure.Checksums[i].PartNumber = fi.Parts[i].Number
 		switch j.BitrotChecksumAlgo {
-		case HighwayHash:
-			fi.Erasure.Checksums[i].Algorithm = HighwayHash256S
+		case HighwayHash, BLAKE3Hash, XXH3Hash:
+			fi.Erasure.Checksums[i].Algorithm = j.BitrotChecksumAlgo.bitrotAlgorithm()
 			fi.Erasure.Checksums[i].Hash = []byte{}
 		default:
 			return FileInfo{}, fmt.Errorf("unknown BitrotChecksumAlgo: %v", j.BitrotChecksumAlgo)
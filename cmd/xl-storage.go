@@ -432,11 +432,14 @@ func (s *xlStorage) NSScanner(ctx context.Context, cache dataUsageCache) (dataUs
 		for _, version := range fivs.Versions {
 			oi := version.ToObjectInfo(item.bucket, item.objectPath())
 			if objAPI != nil {
-				totalSize += item.applyActions(ctx, objAPI, actionMeta{
+				size := item.applyActions(ctx, objAPI, actionMeta{
 					oi:         oi,
 					bitRotScan: healOpts.Bitrot,
 				})
+				totalSize += size
+				sizeS.addDataLayout(version, size)
 				item.healReplication(ctx, objAPI, oi.Clone(), &sizeS)
+				item.applyInlineMigration(ctx, objAPI, version)
 			}
 		}
 		sizeS.totalSize = totalSize
@@ -2010,16 +2013,20 @@ func (s *xlStorage) RenameData(ctx context.Context, srcVolume, srcPath string, f
 		}
 	}
 
+	versionID := fi.VersionID
+	if versionID == "" {
+		versionID = nullVersionID
+	}
+
 	var oldDstDataPath string
-	if fi.VersionID == "" {
-		// return the latest "null" versionId info
-		ofi, err := xlMeta.ToFileInfo(dstVolume, dstPath, nullVersionID)
-		if err == nil && !ofi.Deleted {
-			if xlMeta.SharedDataDirCountStr(nullVersionID, ofi.DataDir) == 0 {
-				// Purge the destination path as we are not preserving anything
-				// versioned object was not requested.
-				oldDstDataPath = pathJoin(dstVolumeDir, dstPath, ofi.DataDir)
-			}
+	// return the version info of the "null" versionId or of the
+	// version being rewritten, which may leave its data dir behind.
+	ofi, err := xlMeta.ToFileInfo(dstVolume, dstPath, versionID)
+	if err == nil && !ofi.Deleted && ofi.DataDir != fi.DataDir {
+		if xlMeta.SharedDataDirCountStr(versionID, ofi.DataDir) == 0 {
+			// Purge the destination path as we are not preserving anything
+			// versioned object was not requested.
+			oldDstDataPath = pathJoin(dstVolumeDir, dstPath, ofi.DataDir)
 		}
 	}
 
@@ -2027,6 +2034,11 @@ func (s *xlStorage) RenameData(ctx context.Context, srcVolume, srcPath string, f
 		return err
 	}
 
+	// Data which moved from xl.meta into part files is no longer inlined.
+	if len(fi.Data) == 0 && fi.Size > 0 {
+		xlMeta.data.remove(versionID)
+	}
+
 	dstBuf, err = xlMeta.AppendTo(nil)
 	if err != nil {
 		logger.LogIf(ctx, err)
@@ -109,3 +109,20 @@ func ParseBucketSSEConfig(r io.Reader) (*BucketSSEConfig, error) {
 
 	return &config, nil
 }
+
+// Algo returns the default SSE algorithm of the bucket.
+func (b *BucketSSEConfig) Algo() SSEAlgorithm {
+	for _, rule := range b.Rules {
+		return rule.DefaultEncryptionAction.Algorithm
+	}
+	return ""
+}
+
+// KeyID returns the KMS master key ID of the default SSE-KMS
+// encryption. It returns an empty key ID for SSE-S3.
+func (b *BucketSSEConfig) KeyID() string {
+	for _, rule := range b.Rules {
+		return rule.DefaultEncryptionAction.MasterKeyID
+	}
+	return ""
+}
@@ -358,6 +358,7 @@ var actionConditionKeyMap = map[Action]condition.KeySet{
 			condition.S3XAmzCopySource,
 			condition.S3XAmzServerSideEncryption,
 			condition.S3XAmzServerSideEncryptionCustomerAlgorithm,
+			condition.S3XAmzServerSideEncryptionAwsKMSKeyID,
 			condition.S3XAmzMetadataDirective,
 			condition.S3XAmzStorageClass,
 			condition.S3ObjectLockRetainUntilDate,
@@ -39,6 +39,11 @@ const (
 	// x-amz-server-side-encryption-customer-algorithm HTTP header applicable to PutObject API only.
 	S3XAmzServerSideEncryptionCustomerAlgorithm Key = "s3:x-amz-server-side-encryption-customer-algorithm"
 
+	// S3XAmzServerSideEncryptionAwsKMSKeyID - key representing
+	// x-amz-server-side-encryption-aws-kms-key-id HTTP header applicable to PutObject API only,
+	// and the KMS master key ID of an SSE-KMS encrypted object for the kms:* actions.
+	S3XAmzServerSideEncryptionAwsKMSKeyID Key = "s3:x-amz-server-side-encryption-aws-kms-key-id"
+
 	// S3XAmzMetadataDirective - key representing x-amz-metadata-directive HTTP header applicable to
 	// PutObject API only.
 	S3XAmzMetadataDirective Key = "s3:x-amz-metadata-directive"
@@ -125,6 +130,7 @@ var AllSupportedKeys = append([]Key{
 	S3XAmzCopySource,
 	S3XAmzServerSideEncryption,
 	S3XAmzServerSideEncryptionCustomerAlgorithm,
+	S3XAmzServerSideEncryptionAwsKMSKeyID,
 	S3XAmzMetadataDirective,
 	S3XAmzStorageClass,
 	S3XAmzContentSha256,
@@ -19,6 +19,9 @@ package versioning
 import (
 	"encoding/xml"
 	"io"
+	"strings"
+
+	"minio/pkg/wildcard"
 )
 
 // State - enabled/disabled/suspended states
@@ -32,12 +35,28 @@ const (
 	Suspended State = "Suspended"
 )
 
+// maxExcludedPrefixes is the maximum number of excluded prefixes
+// of a versioning configuration.
+const maxExcludedPrefixes = 10
+
+// ExcludedPrefix - a prefix, which may contain wildcards, of the
+// objects that are not versioned in a versioning enabled bucket.
+type ExcludedPrefix struct {
+	Prefix string
+}
+
 // Versioning - Configuration for bucket versioning.
 type Versioning struct {
 	XMLNS   string   `xml:"xmlns,attr,omitempty"`
 	XMLName xml.Name `xml:"VersioningConfiguration"`
 	// MFADelete State    `xml:"MFADelete,omitempty"` // not supported yet.
 	Status State `xml:"Status,omitempty"`
+	// MinIO extension - objects matching any of the excluded
+	// prefixes are not versioned.
+	ExcludedPrefixes []ExcludedPrefix `xml:",omitempty"`
+	// MinIO extension - folder objects, i.e. objects whose
+	// name ends with a slash, are not versioned.
+	ExcludeFolders bool `xml:",omitempty"`
 }
 
 // Validate - validates the versioning configuration
@@ -49,7 +68,19 @@ func (v Versioning) Validate() error {
 	// 	return Errorf("unsupported MFADelete state %s", v.MFADelete)
 	// }
 	switch v.Status {
-	case Enabled, Suspended:
+	case Enabled:
+		if len(v.ExcludedPrefixes) > maxExcludedPrefixes {
+			return Errorf("too many excluded prefixes, at most %d are allowed", maxExcludedPrefixes)
+		}
+		for _, p := range v.ExcludedPrefixes {
+			if p.Prefix == "" {
+				return Errorf("excluded prefix cannot be empty")
+			}
+		}
+	case Suspended:
+		if len(v.ExcludedPrefixes) > 0 || v.ExcludeFolders {
+			return Errorf("excluded prefixes and folders are only supported with versioning enabled")
+		}
 	default:
 		return Errorf("unsupported Versioning status %s", v.Status)
 	}
@@ -66,6 +97,34 @@ func (v Versioning) Suspended() bool {
 	return v.Status == Suspended
 }
 
+// excluded - returns true if object is excluded from versioning.
+func (v Versioning) excluded(object string) bool {
+	if object == "" {
+		return false
+	}
+	if v.ExcludeFolders && strings.HasSuffix(object, "/") {
+		return true
+	}
+	for _, p := range v.ExcludedPrefixes {
+		if wildcard.MatchSimple(p.Prefix+"*", object) {
+			return true
+		}
+	}
+	return false
+}
+
+// PrefixEnabled - returns true if versioning is enabled for object,
+// i.e. versioning is enabled and object is not excluded.
+func (v Versioning) PrefixEnabled(object string) bool {
+	return v.Enabled() && !v.excluded(object)
+}
+
+// PrefixSuspended - returns true if versioning is suspended for object,
+// i.e. versioning is suspended or object is excluded from versioning.
+func (v Versioning) PrefixSuspended(object string) bool {
+	return v.Suspended() || (v.Enabled() && v.excluded(object))
+}
+
 // ParseConfig - parses data in given reader to VersioningConfiguration.
 func ParseConfig(reader io.Reader) (*Versioning, error) {
 	var v Versioning
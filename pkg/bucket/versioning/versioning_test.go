@@ -0,0 +1,133 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package versioning
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseConfig(t *testing.T) {
+	testCases := []struct {
+		input       string
+		excludedLen int
+		excludeDirs bool
+		success     bool
+	}{
+		{
+			input:   `<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Status>Enabled</Status></VersioningConfiguration>`,
+			success: true,
+		},
+		{
+			input:   `<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Status>Unknown</Status></VersioningConfiguration>`,
+			success: false,
+		},
+		{
+			input: `<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+				<Status>Enabled</Status>
+				<ExcludedPrefixes><Prefix>*/_temporary/</Prefix></ExcludedPrefixes>
+				<ExcludedPrefixes><Prefix>staging/</Prefix></ExcludedPrefixes>
+				<ExcludeFolders>true</ExcludeFolders>
+			</VersioningConfiguration>`,
+			excludedLen: 2,
+			excludeDirs: true,
+			success:     true,
+		},
+		{
+			input: `<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+				<Status>Suspended</Status>
+				<ExcludedPrefixes><Prefix>staging/</Prefix></ExcludedPrefixes>
+			</VersioningConfiguration>`,
+			success: false,
+		},
+		{
+			input: `<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+				<Status>Suspended</Status>
+				<ExcludeFolders>true</ExcludeFolders>
+			</VersioningConfiguration>`,
+			success: false,
+		},
+		{
+			input: `<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+				<Status>Enabled</Status>
+				<ExcludedPrefixes><Prefix></Prefix></ExcludedPrefixes>
+			</VersioningConfiguration>`,
+			success: false,
+		},
+		{
+			input: `<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Status>Enabled</Status>` +
+				strings.Repeat(`<ExcludedPrefixes><Prefix>prefix/</Prefix></ExcludedPrefixes>`, maxExcludedPrefixes+1) +
+				`</VersioningConfiguration>`,
+			success: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		v, err := ParseConfig(strings.NewReader(tc.input))
+		if tc.success != (err == nil) {
+			t.Fatalf("Test %d: expected success %v, got %v", i+1, tc.success, err)
+		}
+		if err != nil {
+			continue
+		}
+		if len(v.ExcludedPrefixes) != tc.excludedLen {
+			t.Fatalf("Test %d: expected %d excluded prefixes, got %d", i+1, tc.excludedLen, len(v.ExcludedPrefixes))
+		}
+		if v.ExcludeFolders != tc.excludeDirs {
+			t.Fatalf("Test %d: expected exclude folders %v, got %v", i+1, tc.excludeDirs, v.ExcludeFolders)
+		}
+	}
+}
+
+func TestPrefixEnabled(t *testing.T) {
+	enabled := Versioning{
+		Status: Enabled,
+		ExcludedPrefixes: []ExcludedPrefix{
+			{Prefix: "*/_temporary/"},
+			{Prefix: "staging/"},
+		},
+		ExcludeFolders: true,
+	}
+	suspended := Versioning{Status: Suspended}
+
+	testCases := []struct {
+		v         Versioning
+		object    string
+		enabled   bool
+		suspended bool
+	}{
+		{enabled, "", true, false},
+		{enabled, "data/part-0000.parquet", true, false},
+		{enabled, "out/_temporary/0/task/part-0000.parquet", false, true},
+		{enabled, "out/table/_temporary/0/part-0000.parquet", false, true},
+		{enabled, "staging/object", false, true},
+		{enabled, "data/staging/object", true, false},
+		{enabled, "data/folder/", false, true},
+		{Versioning{Status: Enabled}, "data/folder/", true, false},
+		{suspended, "data/part-0000.parquet", false, true},
+		{suspended, "out/_temporary/0/part-0000.parquet", false, true},
+	}
+
+	for i, tc := range testCases {
+		if got := tc.v.PrefixEnabled(tc.object); got != tc.enabled {
+			t.Errorf("Test %d: expected PrefixEnabled(%q) %v, got %v", i+1, tc.object, tc.enabled, got)
+		}
+		if got := tc.v.PrefixSuspended(tc.object); got != tc.suspended {
+			t.Errorf("Test %d: expected PrefixSuspended(%q) %v, got %v", i+1, tc.object, tc.suspended, got)
+		}
+	}
+}
@@ -18,6 +18,7 @@ package certs
 
 import (
 	"crypto/x509"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path"
@@ -34,8 +35,7 @@ func GetRootCAs(certsCAsDir string) (*x509.CertPool, error) {
 		rootCAs = x509.NewCertPool()
 	}
 
-	fis, err := ioutil.ReadDir(certsCAsDir)
-	if err != nil {
+	if err := appendCAs(rootCAs, certsCAsDir); err != nil {
 		if os.IsNotExist(err) || os.IsPermission(err) {
 			// Return success if CA's directory is missing or permission denied.
 			return rootCAs, nil
@@ -43,14 +43,37 @@ func GetRootCAs(certsCAsDir string) (*x509.CertPool, error) {
 		return rootCAs, err
 	}
 
+	return rootCAs, nil
+}
+
+// GetCAs - returns only the CAs at the input certsCADir, unlike
+// GetRootCAs the system CAs are not included and the directory
+// must contain at least one CA certificate.
+func GetCAs(certsCAsDir string) (*x509.CertPool, error) {
+	cas := x509.NewCertPool()
+	if err := appendCAs(cas, certsCAsDir); err != nil {
+		return nil, err
+	}
+	if len(cas.Subjects()) == 0 {
+		return nil, fmt.Errorf("no CA certificates found in %s", certsCAsDir)
+	}
+	return cas, nil
+}
+
+func appendCAs(certPool *x509.CertPool, certsCAsDir string) error {
+	fis, err := ioutil.ReadDir(certsCAsDir)
+	if err != nil {
+		return err
+	}
+
 	// Load all custom CA files.
 	for _, fi := range fis {
 		caCert, err := ioutil.ReadFile(path.Join(certsCAsDir, fi.Name()))
 		if err == nil {
-			rootCAs.AppendCertsFromPEM(caCert)
+			certPool.AppendCertsFromPEM(caCert)
 		}
 		// ignore files which are not readable.
 	}
 
-	return rootCAs, nil
+	return nil
 }
@@ -76,3 +76,50 @@ func TestGetRootCAs(t *testing.T) {
 		}
 	}
 }
+
+func TestGetCAs(t *testing.T) {
+	emptydir, err := ioutil.TempDir("", "test-get-cas")
+	if err != nil {
+		t.Fatalf("Unable create temp directory. %v", emptydir)
+	}
+	defer os.RemoveAll(emptydir)
+
+	dir, err := ioutil.TempDir("", "test-get-cas")
+	if err != nil {
+		t.Fatalf("Unable create temp directory. %v", dir)
+	}
+	defer os.RemoveAll(dir)
+	caCert, err := ioutil.ReadFile("public.crt")
+	if err != nil {
+		t.Fatalf("Unable read test certificate. %v", err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, "ca.crt"), caCert, 0644); err != nil {
+		t.Fatalf("Unable create test file. %v", err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, "empty-file"), []byte{}, 0644); err != nil {
+		t.Fatalf("Unable create test file. %v", err)
+	}
+
+	testCases := []struct {
+		certCAsDir    string
+		expectedCount int
+		expectedErr   bool
+	}{
+		// non-existent directories are an error.
+		{"nonexistent-dir", 0, true},
+		// directories without certificates are an error.
+		{emptydir, 0, true},
+		// Loads only the certs in the directory.
+		{dir, 1, false},
+	}
+
+	for i, testCase := range testCases {
+		cas, err := GetCAs(testCase.certCAsDir)
+		if testCase.expectedErr != (err != nil) {
+			t.Fatalf("Test %d: expected error %v, got %v", i+1, testCase.expectedErr, err)
+		}
+		if err == nil && len(cas.Subjects()) != testCase.expectedCount {
+			t.Fatalf("Test %d: expected %d CAs, got %d", i+1, testCase.expectedCount, len(cas.Subjects()))
+		}
+	}
+}
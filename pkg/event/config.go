@@ -19,11 +19,14 @@ package event
 import (
 	"encoding/xml"
 	"errors"
+	"fmt"
 	"io"
+	"math"
 	"reflect"
 	"strings"
 	"unicode/utf8"
 
+	humanize "github.com/dustin/go-humanize"
 	"github.com/minio/minio-go/v7/pkg/set"
 )
 
@@ -70,7 +73,7 @@ func (filter *FilterRule) UnmarshalXML(d *xml.Decoder, start xml.StartElement) e
 		return err
 	}
 
-	if rule.Name != "prefix" && rule.Name != "suffix" {
+	if !isValidFilterName(rule.Name) {
 		return &ErrInvalidFilterName{rule.Name}
 	}
 
@@ -78,6 +81,13 @@ func (filter *FilterRule) UnmarshalXML(d *xml.Decoder, start xml.StartElement) e
 		return err
 	}
 
+	if rule.Name == filterNameSizeMin || rule.Name == filterNameSizeMax {
+		size, err := humanize.ParseBytes(rule.Value)
+		if err != nil || size > math.MaxInt64 {
+			return &ErrInvalidFilterValue{rule.Value}
+		}
+	}
+
 	*filter = FilterRule(rule)
 
 	return nil
@@ -97,20 +107,28 @@ func (ruleList *FilterRuleList) UnmarshalXML(d *xml.Decoder, start xml.StartElem
 		return err
 	}
 
-	// FilterRuleList must have only one prefix and/or suffix.
+	// FilterRuleList must have only one rule of each name.
 	nameSet := set.NewStringSet()
 	for _, rule := range rules.Rules {
 		if nameSet.Contains(rule.Name) {
-			if rule.Name == "prefix" {
+			switch rule.Name {
+			case "prefix":
 				return &ErrFilterNamePrefix{}
+			case "suffix":
+				return &ErrFilterNameSuffix{}
 			}
 
-			return &ErrFilterNameSuffix{}
+			return &ErrDuplicateFilterName{rule.Name}
 		}
 
 		nameSet.Add(rule.Name)
 	}
 
+	filter := FilterRuleList(rules).ObjectFilter()
+	if filter.hasMaxSize && filter.minSize > filter.maxSize {
+		return &ErrInvalidFilterValue{fmt.Sprintf("%v > %v", filter.minSize, filter.maxSize)}
+	}
+
 	*ruleList = FilterRuleList(rules)
 	return nil
 }
@@ -219,7 +237,7 @@ func (q *Queue) SetRegion(region string) {
 // ToRulesMap - converts Queue to RulesMap
 func (q Queue) ToRulesMap() RulesMap {
 	pattern := q.Filter.RuleList.Pattern()
-	return NewRulesMap(q.Events, pattern, q.ARN.TargetID)
+	return NewRulesMapWithFilter(q.Events, pattern, q.Filter.RuleList.ObjectFilter(), q.ARN.TargetID)
 }
 
 // Unused.  Available for completion.
@@ -60,6 +60,11 @@ func TestFilterRuleUnmarshalXML(t *testing.T) {
 		{[]byte(`<FilterRule><Name>ends</Name><Value>foo/bar</Value></FilterRule>`), nil, true},
 		{[]byte(`<FilterRule><Name>prefix</Name><Value>Hello/世界</Value></FilterRule>`), &FilterRule{"prefix", "Hello/世界"}, false},
 		{[]byte(`<FilterRule><Name>suffix</Name><Value>foo/bar</Value></FilterRule>`), &FilterRule{"suffix", "foo/bar"}, false},
+		{[]byte(`<FilterRule><Name>size-min</Name><Value>1MiB</Value></FilterRule>`), &FilterRule{"size-min", "1MiB"}, false},
+		{[]byte(`<FilterRule><Name>size-max</Name><Value>huge</Value></FilterRule>`), nil, true},
+		{[]byte(`<FilterRule><Name>content-type</Name><Value>image/*</Value></FilterRule>`), &FilterRule{"content-type", "image/*"}, false},
+		{[]byte(`<FilterRule><Name>metadata:department</Name><Value>finance</Value></FilterRule>`), &FilterRule{"metadata:department", "finance"}, false},
+		{[]byte(`<FilterRule><Name>tag:</Name><Value>alpha</Value></FilterRule>`), nil, true},
 	}
 
 	for i, testCase := range testCases {
@@ -90,6 +95,9 @@ func TestFilterRuleListUnmarshalXML(t *testing.T) {
 		{[]byte(`<S3Key><FilterRule><Name>prefix</Name><Value>Hello/世界</Value></FilterRule></S3Key>`), &FilterRuleList{[]FilterRule{{"prefix", "Hello/世界"}}}, false},
 		{[]byte(`<S3Key><FilterRule><Name>suffix</Name><Value>foo/bar</Value></FilterRule></S3Key>`), &FilterRuleList{[]FilterRule{{"suffix", "foo/bar"}}}, false},
 		{[]byte(`<S3Key><FilterRule><Name>prefix</Name><Value>Hello/世界</Value></FilterRule><FilterRule><Name>suffix</Name><Value>foo/bar</Value></FilterRule></S3Key>`), &FilterRuleList{[]FilterRule{{"prefix", "Hello/世界"}, {"suffix", "foo/bar"}}}, false},
+		{[]byte(`<S3Key><FilterRule><Name>tag:project</Name><Value>alpha</Value></FilterRule><FilterRule><Name>tag:project</Name><Value>beta</Value></FilterRule></S3Key>`), nil, true},
+		{[]byte(`<S3Key><FilterRule><Name>size-min</Name><Value>2KiB</Value></FilterRule><FilterRule><Name>size-max</Name><Value>1KiB</Value></FilterRule></S3Key>`), nil, true},
+		{[]byte(`<S3Key><FilterRule><Name>prefix</Name><Value>logs/</Value></FilterRule><FilterRule><Name>size-max</Name><Value>1KiB</Value></FilterRule></S3Key>`), &FilterRuleList{[]FilterRule{{"prefix", "logs/"}, {"size-max", "1KiB"}}}, false},
 	}
 
 	for i, testCase := range testCases {
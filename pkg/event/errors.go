@@ -30,6 +30,8 @@ func IsEventError(err error) bool {
 		return true
 	case ErrFilterNameSuffix, *ErrFilterNameSuffix:
 		return true
+	case ErrDuplicateFilterName, *ErrDuplicateFilterName:
+		return true
 	case ErrInvalidFilterValue, *ErrInvalidFilterValue:
 		return true
 	case ErrDuplicateEventName, *ErrDuplicateEventName:
@@ -74,6 +76,15 @@ func (err ErrFilterNameSuffix) Error() string {
 	return "more than one suffix in filter rule"
 }
 
+// ErrDuplicateFilterName - more than one usage of a filter rule name error.
+type ErrDuplicateFilterName struct {
+	FilterName string
+}
+
+func (err ErrDuplicateFilterName) Error() string {
+	return fmt.Sprintf("more than one '%v' in filter rule", err.FilterName)
+}
+
 // ErrInvalidFilterValue - invalid filter value error.
 type ErrInvalidFilterValue struct {
 	FilterValue string
@@ -0,0 +1,183 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package event
+
+import (
+	"net/url"
+	"strings"
+
+	humanize "github.com/dustin/go-humanize"
+
+	"minio/pkg/wildcard"
+)
+
+// Filter rule names supported in addition to prefix and suffix.
+const (
+	filterNameSizeMin     = "size-min"
+	filterNameSizeMax     = "size-max"
+	filterNameContentType = "content-type"
+
+	// Followed by the user metadata name or tag key to match.
+	filterNameMetadataPrefix = "metadata:"
+	filterNameTagPrefix      = "tag:"
+
+	userMetadataPrefix = "x-amz-meta-"
+)
+
+// isValidFilterName - checks whether name is a supported filter rule name.
+func isValidFilterName(name string) bool {
+	switch name {
+	case "prefix", "suffix", filterNameSizeMin, filterNameSizeMax, filterNameContentType:
+		return true
+	}
+
+	if key := strings.TrimPrefix(name, filterNameMetadataPrefix); key != name {
+		return key != ""
+	}
+	if key := strings.TrimPrefix(name, filterNameTagPrefix); key != name {
+		return key != ""
+	}
+
+	return false
+}
+
+// ObjectProperties - object properties checked by ObjectFilter.
+type ObjectProperties struct {
+	Name         string
+	Size         int64
+	ContentType  string
+	UserMetadata map[string]string
+	UserTags     string
+}
+
+// ObjectFilter - conditions on object size, content type, user metadata
+// and tags which an object must satisfy in addition to the name pattern.
+// The zero value matches every object. ObjectFilter is comparable so it
+// can be part of a Rule.
+type ObjectFilter struct {
+	minSize     int64
+	maxSize     int64
+	hasMaxSize  bool
+	contentType string
+
+	// URL encoded name to value pattern pairs.
+	metadata string
+	tags     string
+}
+
+// IsEmpty - returns whether the filter has no conditions.
+func (filter ObjectFilter) IsEmpty() bool {
+	return filter == ObjectFilter{}
+}
+
+// Match - returns whether the object satisfies all filter conditions.
+func (filter ObjectFilter) Match(object ObjectProperties) bool {
+	if object.Size < filter.minSize {
+		return false
+	}
+
+	if filter.hasMaxSize && object.Size > filter.maxSize {
+		return false
+	}
+
+	if filter.contentType != "" && !wildcard.MatchSimple(filter.contentType, object.ContentType) {
+		return false
+	}
+
+	if filter.metadata != "" {
+		metadata := make(map[string]string, len(object.UserMetadata))
+		for k, v := range object.UserMetadata {
+			k = strings.ToLower(k)
+			if strings.HasPrefix(k, userMetadataPrefix) {
+				metadata[strings.TrimPrefix(k, userMetadataPrefix)] = v
+			}
+		}
+		if !matchValues(filter.metadata, func(name string) (string, bool) {
+			v, ok := metadata[name]
+			return v, ok
+		}) {
+			return false
+		}
+	}
+
+	if filter.tags != "" {
+		tags, err := url.ParseQuery(object.UserTags)
+		if err != nil {
+			return false
+		}
+		if !matchValues(filter.tags, func(key string) (string, bool) {
+			if _, ok := tags[key]; !ok {
+				return "", false
+			}
+			return tags.Get(key), true
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchValues - returns whether every pattern in encoded matches the
+// value returned by lookup for its name.
+func matchValues(encoded string, lookup func(name string) (string, bool)) bool {
+	patterns, err := url.ParseQuery(encoded)
+	if err != nil {
+		return false
+	}
+
+	for name := range patterns {
+		value, ok := lookup(name)
+		if !ok || !wildcard.MatchSimple(patterns.Get(name), value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ObjectFilter - returns the object filter built from size, content type,
+// metadata and tag rules. Rules are expected to be validated.
+func (ruleList FilterRuleList) ObjectFilter() ObjectFilter {
+	var filter ObjectFilter
+	metadata := make(url.Values)
+	tags := make(url.Values)
+
+	for _, rule := range ruleList.Rules {
+		switch {
+		case rule.Name == filterNameSizeMin:
+			size, _ := humanize.ParseBytes(rule.Value)
+			filter.minSize = int64(size)
+		case rule.Name == filterNameSizeMax:
+			size, _ := humanize.ParseBytes(rule.Value)
+			filter.maxSize = int64(size)
+			filter.hasMaxSize = true
+		case rule.Name == filterNameContentType:
+			filter.contentType = rule.Value
+		case strings.HasPrefix(rule.Name, filterNameMetadataPrefix):
+			name := strings.ToLower(strings.TrimPrefix(rule.Name, filterNameMetadataPrefix))
+			metadata.Set(strings.TrimPrefix(name, userMetadataPrefix), rule.Value)
+		case strings.HasPrefix(rule.Name, filterNameTagPrefix):
+			tags.Set(strings.TrimPrefix(rule.Name, filterNameTagPrefix), rule.Value)
+		}
+	}
+
+	// Encode sorts by name, giving a canonical form.
+	filter.metadata = metadata.Encode()
+	filter.tags = tags.Encode()
+	return filter
+}
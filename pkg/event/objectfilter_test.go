@@ -0,0 +1,112 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package event
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestObjectFilterMatch(t *testing.T) {
+	object := ObjectProperties{
+		Name:        "photos/2021/beach.jpg",
+		Size:        2 << 20,
+		ContentType: "image/jpeg",
+		UserMetadata: map[string]string{
+			"X-Amz-Meta-Department": "finance",
+			"content-type":          "image/jpeg",
+		},
+		UserTags: "project=alpha-1&team=web",
+	}
+
+	testCases := []struct {
+		rules          []FilterRule
+		expectedResult bool
+	}{
+		{nil, true},
+		{[]FilterRule{{"size-min", "1MiB"}}, true},
+		{[]FilterRule{{"size-min", "3MiB"}}, false},
+		{[]FilterRule{{"size-max", "2MiB"}}, true},
+		{[]FilterRule{{"size-max", "1MiB"}}, false},
+		{[]FilterRule{{"content-type", "image/*"}}, true},
+		{[]FilterRule{{"content-type", "video/*"}}, false},
+		{[]FilterRule{{"metadata:department", "finance"}}, true},
+		{[]FilterRule{{"metadata:X-Amz-Meta-Department", "fin*"}}, true},
+		{[]FilterRule{{"metadata:department", "sales"}}, false},
+		{[]FilterRule{{"metadata:owner", "*"}}, false},
+		{[]FilterRule{{"tag:project", "alpha*"}, {"tag:team", "web"}}, true},
+		{[]FilterRule{{"tag:project", "beta*"}}, false},
+		{[]FilterRule{{"tag:owner", "*"}}, false},
+		{[]FilterRule{{"prefix", "videos/"}, {"size-min", "1MiB"}}, true},
+	}
+
+	for i, testCase := range testCases {
+		filter := FilterRuleList{testCase.rules}.ObjectFilter()
+		if result := filter.Match(object); result != testCase.expectedResult {
+			t.Errorf("test %v: result: expected: %v, got: %v", i+1, testCase.expectedResult, result)
+		}
+	}
+}
+
+func TestRulesMatchObject(t *testing.T) {
+	largeImages := FilterRuleList{[]FilterRule{{"size-min", "1MiB"}, {"content-type", "image/*"}}}.ObjectFilter()
+
+	rules := make(Rules)
+	rules.Add(NewPattern("photos/", ""), TargetID{"1", "webhook"})
+	rules.AddRule(Rule{NewPattern("photos/", ""), largeImages}, TargetID{"2", "amqp"})
+
+	testCases := []struct {
+		object         ObjectProperties
+		expectedResult TargetIDSet
+	}{
+		{ObjectProperties{Name: "photos/a.jpg", Size: 10, ContentType: "image/jpeg"}, NewTargetIDSet(TargetID{"1", "webhook"})},
+		{ObjectProperties{Name: "photos/a.jpg", Size: 2 << 20, ContentType: "image/jpeg"}, NewTargetIDSet(TargetID{"1", "webhook"}, TargetID{"2", "amqp"})},
+		{ObjectProperties{Name: "videos/a.mp4", Size: 2 << 20, ContentType: "image/jpeg"}, NewTargetIDSet()},
+	}
+
+	for i, testCase := range testCases {
+		result := rules.MatchObject(testCase.object)
+		if !reflect.DeepEqual(result, testCase.expectedResult) {
+			t.Errorf("test %v: result: expected: %v, got: %v", i+1, testCase.expectedResult, result)
+		}
+	}
+}
+
+func TestRulesMapMatchObjectRemoved(t *testing.T) {
+	largeImages := FilterRuleList{[]FilterRule{{"size-min", "1MiB"}, {"content-type", "image/*"}}}.ObjectFilter()
+	rulesMap := NewRulesMapWithFilter([]Name{ObjectCreatedAll, ObjectRemovedAll}, "photos/*", largeImages, TargetID{"1", "webhook"})
+
+	testCases := []struct {
+		eventName      Name
+		object         ObjectProperties
+		expectedResult TargetIDSet
+	}{
+		{ObjectCreatedPut, ObjectProperties{Name: "photos/a.jpg"}, NewTargetIDSet()},
+		{ObjectCreatedPut, ObjectProperties{Name: "photos/a.jpg", Size: 2 << 20, ContentType: "image/jpeg"}, NewTargetIDSet(TargetID{"1", "webhook"})},
+		// Removal events carry no size or content type, only the name pattern is matched.
+		{ObjectRemovedDelete, ObjectProperties{Name: "photos/a.jpg"}, NewTargetIDSet(TargetID{"1", "webhook"})},
+		{ObjectRemovedDeleteMarkerCreated, ObjectProperties{Name: "photos/a.jpg"}, NewTargetIDSet(TargetID{"1", "webhook"})},
+		{ObjectRemovedDelete, ObjectProperties{Name: "videos/a.mp4"}, NewTargetIDSet()},
+	}
+
+	for i, testCase := range testCases {
+		result := rulesMap.MatchObject(testCase.eventName, testCase.object)
+		if !reflect.DeepEqual(result, testCase.expectedResult) {
+			t.Errorf("test %v: result: expected: %v, got: %v", i+1, testCase.expectedResult, result)
+		}
+	}
+}
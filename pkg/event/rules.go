@@ -45,18 +45,29 @@ func NewPattern(prefix, suffix string) (pattern string) {
 	return pattern
 }
 
+// Rule - object name pattern along with an optional object filter.
+type Rule struct {
+	Pattern string
+	Filter  ObjectFilter
+}
+
 // Rules - event rules
-type Rules map[string]TargetIDSet
+type Rules map[Rule]TargetIDSet
 
 // Add - adds pattern and target ID.
 func (rules Rules) Add(pattern string, targetID TargetID) {
-	rules[pattern] = NewTargetIDSet(targetID).Union(rules[pattern])
+	rules.AddRule(Rule{Pattern: pattern}, targetID)
+}
+
+// AddRule - adds rule and target ID.
+func (rules Rules) AddRule(rule Rule, targetID TargetID) {
+	rules[rule] = NewTargetIDSet(targetID).Union(rules[rule])
 }
 
 // MatchSimple - returns true one of the matching object name in rules.
 func (rules Rules) MatchSimple(objectName string) bool {
-	for pattern := range rules {
-		if wildcard.MatchSimple(pattern, objectName) {
+	for rule := range rules {
+		if wildcard.MatchSimple(rule.Pattern, objectName) {
 			return true
 		}
 	}
@@ -65,10 +76,30 @@ func (rules Rules) MatchSimple(objectName string) bool {
 
 // Match - returns TargetIDSet matching object name in rules.
 func (rules Rules) Match(objectName string) TargetIDSet {
+	return rules.MatchObject(ObjectProperties{Name: objectName})
+}
+
+// MatchObject - returns TargetIDSet of rules matching object name and
+// object filter.
+func (rules Rules) MatchObject(object ObjectProperties) TargetIDSet {
+	targetIDs := NewTargetIDSet()
+
+	for rule, targetIDSet := range rules {
+		if wildcard.MatchSimple(rule.Pattern, object.Name) && rule.Filter.Match(object) {
+			targetIDs = targetIDs.Union(targetIDSet)
+		}
+	}
+
+	return targetIDs
+}
+
+// matchPattern - returns TargetIDSet of rules matching object name,
+// ignoring the object filters of the rules.
+func (rules Rules) matchPattern(objectName string) TargetIDSet {
 	targetIDs := NewTargetIDSet()
 
-	for pattern, targetIDSet := range rules {
-		if wildcard.MatchSimple(pattern, objectName) {
+	for rule, targetIDSet := range rules {
+		if wildcard.MatchSimple(rule.Pattern, objectName) {
 			targetIDs = targetIDs.Union(targetIDSet)
 		}
 	}
@@ -80,8 +111,8 @@ func (rules Rules) Match(objectName string) TargetIDSet {
 func (rules Rules) Clone() Rules {
 	rulesCopy := make(Rules)
 
-	for pattern, targetIDSet := range rules {
-		rulesCopy[pattern] = targetIDSet.Clone()
+	for rule, targetIDSet := range rules {
+		rulesCopy[rule] = targetIDSet.Clone()
 	}
 
 	return rulesCopy
@@ -91,8 +122,8 @@ func (rules Rules) Clone() Rules {
 func (rules Rules) Union(rules2 Rules) Rules {
 	nrules := rules.Clone()
 
-	for pattern, targetIDSet := range rules2 {
-		nrules[pattern] = nrules[pattern].Union(targetIDSet)
+	for rule, targetIDSet := range rules2 {
+		nrules[rule] = nrules[rule].Union(targetIDSet)
 	}
 
 	return nrules
@@ -102,9 +133,9 @@ func (rules Rules) Union(rules2 Rules) Rules {
 func (rules Rules) Difference(rules2 Rules) Rules {
 	nrules := make(Rules)
 
-	for pattern, targetIDSet := range rules {
-		if nv := targetIDSet.Difference(rules2[pattern]); len(nv) > 0 {
-			nrules[pattern] = nv
+	for rule, targetIDSet := range rules {
+		if nv := targetIDSet.Difference(rules2[rule]); len(nv) > 0 {
+			nrules[rule] = nv
 		}
 	}
 
@@ -21,8 +21,13 @@ type RulesMap map[Name]Rules
 
 // add - adds event names, prefixes, suffixes and target ID to rules map.
 func (rulesMap RulesMap) add(eventNames []Name, pattern string, targetID TargetID) {
+	rulesMap.addRule(eventNames, Rule{Pattern: pattern}, targetID)
+}
+
+// addRule - adds event names, rule and target ID to rules map.
+func (rulesMap RulesMap) addRule(eventNames []Name, rule Rule, targetID TargetID) {
 	rules := make(Rules)
-	rules.Add(pattern, targetID)
+	rules.AddRule(rule, targetID)
 
 	for _, eventName := range eventNames {
 		for _, name := range eventName.Expand() {
@@ -70,14 +75,31 @@ func (rulesMap RulesMap) Match(eventName Name, objectName string) TargetIDSet {
 	return rulesMap[eventName].Match(objectName)
 }
 
+// MatchObject - returns TargetIDSet matching object and event name in rules map.
+// Removal events carry no object size, content type, user metadata or tags,
+// so only the name patterns of the rules are matched for them.
+func (rulesMap RulesMap) MatchObject(eventName Name, object ObjectProperties) TargetIDSet {
+	switch eventName {
+	case ObjectRemovedDelete, ObjectRemovedDeleteMarkerCreated:
+		return rulesMap[eventName].matchPattern(object.Name)
+	}
+	return rulesMap[eventName].MatchObject(object)
+}
+
 // NewRulesMap - creates new rules map with given values.
 func NewRulesMap(eventNames []Name, pattern string, targetID TargetID) RulesMap {
+	return NewRulesMapWithFilter(eventNames, pattern, ObjectFilter{}, targetID)
+}
+
+// NewRulesMapWithFilter - creates new rules map with given values whose
+// rules also require objects to match filter.
+func NewRulesMapWithFilter(eventNames []Name, pattern string, filter ObjectFilter, targetID TargetID) RulesMap {
 	// If pattern is empty, add '*' wildcard to match all.
 	if pattern == "" {
 		pattern = "*"
 	}
 
 	rulesMap := make(RulesMap)
-	rulesMap.add(eventNames, pattern, targetID)
+	rulesMap.addRule(eventNames, Rule{Pattern: pattern, Filter: filter}, targetID)
 	return rulesMap
 }
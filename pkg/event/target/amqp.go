@@ -138,6 +138,14 @@ func (target *AMQPTarget) HasQueueStore() bool {
 	return target.store != nil
 }
 
+// Store - returns the queue store of the target, nil if not configured.
+func (target *AMQPTarget) Store() event.TargetStore {
+	if target.store == nil {
+		return nil
+	}
+	return target.store
+}
+
 func (target *AMQPTarget) channel() (*amqp.Channel, error) {
 	var err error
 	var conn *amqp.Connection
@@ -308,7 +316,7 @@ func NewAMQPTarget(id string, args AMQPArgs, doneCh <-chan struct{}, loggerOnce
 		eventKeyCh := replayEvents(target.store, doneCh, target.loggerOnce, target.ID())
 
 		// Start replaying events from the store.
-		go sendEvents(target, eventKeyCh, doneCh, target.loggerOnce)
+		go sendEvents(target, target.store, eventKeyCh, doneCh, target.loggerOnce)
 	}
 
 	return target, nil
@@ -111,6 +111,14 @@ func (target *ElasticsearchTarget) HasQueueStore() bool {
 	return target.store != nil
 }
 
+// Store - returns the queue store of the target, nil if not configured.
+func (target *ElasticsearchTarget) Store() event.TargetStore {
+	if target.store == nil {
+		return nil
+	}
+	return target.store
+}
+
 // IsActive - Return true if target is up and active
 func (target *ElasticsearchTarget) IsActive() (bool, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -309,7 +317,7 @@ func NewElasticsearchTarget(id string, args ElasticsearchArgs, doneCh <-chan str
 		// Replays the events from the store.
 		eventKeyCh := replayEvents(target.store, doneCh, target.loggerOnce, target.ID())
 		// Start replaying events from the store.
-		go sendEvents(target, eventKeyCh, doneCh, target.loggerOnce)
+		go sendEvents(target, target.store, eventKeyCh, doneCh, target.loggerOnce)
 	}
 
 	return target, nil
@@ -138,6 +138,14 @@ func (target *KafkaTarget) HasQueueStore() bool {
 	return target.store != nil
 }
 
+// Store - returns the queue store of the target, nil if not configured.
+func (target *KafkaTarget) Store() event.TargetStore {
+	if target.store == nil {
+		return nil
+	}
+	return target.store
+}
+
 // IsActive - Return true if target is up and active
 func (target *KafkaTarget) IsActive() (bool, error) {
 	if !target.args.pingBrokers() {
@@ -332,7 +340,7 @@ func NewKafkaTarget(id string, args KafkaArgs, doneCh <-chan struct{}, loggerOnc
 		// Replays the events from the store.
 		eventKeyCh := replayEvents(target.store, doneCh, target.loggerOnce, target.ID())
 		// Start replaying events from the store.
-		go sendEvents(target, eventKeyCh, doneCh, target.loggerOnce)
+		go sendEvents(target, target.store, eventKeyCh, doneCh, target.loggerOnce)
 	}
 
 	return target, nil
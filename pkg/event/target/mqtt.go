@@ -124,6 +124,14 @@ func (target *MQTTTarget) HasQueueStore() bool {
 	return target.store != nil
 }
 
+// Store - returns the queue store of the target, nil if not configured.
+func (target *MQTTTarget) Store() event.TargetStore {
+	if target.store == nil {
+		return nil
+	}
+	return target.store
+}
+
 // IsActive - Return true if target is up and active
 func (target *MQTTTarget) IsActive() (bool, error) {
 	if !target.client.IsConnectionOpen() {
@@ -270,7 +278,7 @@ func NewMQTTTarget(id string, args MQTTArgs, doneCh <-chan struct{}, loggerOnce
 			// Replays the events from the store.
 			eventKeyCh := replayEvents(target.store, doneCh, target.loggerOnce, target.ID())
 			// Start replaying events from the store.
-			go sendEvents(target, eventKeyCh, doneCh, target.loggerOnce)
+			go sendEvents(target, target.store, eventKeyCh, doneCh, target.loggerOnce)
 		}
 	} else {
 		if token.Wait() && token.Error() != nil {
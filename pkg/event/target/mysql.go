@@ -196,6 +196,14 @@ func (target *MySQLTarget) HasQueueStore() bool {
 	return target.store != nil
 }
 
+// Store - returns the queue store of the target, nil if not configured.
+func (target *MySQLTarget) Store() event.TargetStore {
+	if target.store == nil {
+		return nil
+	}
+	return target.store
+}
+
 // IsActive - Return true if target is up and active
 func (target *MySQLTarget) IsActive() (bool, error) {
 	if target.db == nil {
@@ -431,7 +439,7 @@ func NewMySQLTarget(id string, args MySQLArgs, doneCh <-chan struct{}, loggerOnc
 		// Replays the events from the store.
 		eventKeyCh := replayEvents(target.store, doneCh, target.loggerOnce, target.ID())
 		// Start replaying events from the store.
-		go sendEvents(target, eventKeyCh, doneCh, target.loggerOnce)
+		go sendEvents(target, target.store, eventKeyCh, doneCh, target.loggerOnce)
 	}
 
 	return target, nil
@@ -0,0 +1,380 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package target
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"minio/pkg/event"
+	xnet "minio/pkg/net"
+)
+
+// Pulsar constants
+const (
+	PulsarEndpoint      = "endpoint"
+	PulsarTopic         = "topic"
+	PulsarToken         = "token"
+	PulsarTLSSkipVerify = "tls_skip_verify"
+	PulsarClientCert    = "client_cert"
+	PulsarClientKey     = "client_key"
+	PulsarQueueDir      = "queue_dir"
+	PulsarQueueLimit    = "queue_limit"
+
+	EnvPulsarEnable        = "MINIO_NOTIFY_PULSAR_ENABLE"
+	EnvPulsarEndpoint      = "MINIO_NOTIFY_PULSAR_ENDPOINT"
+	EnvPulsarTopic         = "MINIO_NOTIFY_PULSAR_TOPIC"
+	EnvPulsarToken         = "MINIO_NOTIFY_PULSAR_TOKEN"
+	EnvPulsarTLSSkipVerify = "MINIO_NOTIFY_PULSAR_TLS_SKIP_VERIFY"
+	EnvPulsarClientCert    = "MINIO_NOTIFY_PULSAR_CLIENT_CERT"
+	EnvPulsarClientKey     = "MINIO_NOTIFY_PULSAR_CLIENT_KEY"
+	EnvPulsarQueueDir      = "MINIO_NOTIFY_PULSAR_QUEUE_DIR"
+	EnvPulsarQueueLimit    = "MINIO_NOTIFY_PULSAR_QUEUE_LIMIT"
+)
+
+const pulsarSendTimeout = 30 * time.Second
+
+// PulsarArgs - Pulsar target arguments.
+type PulsarArgs struct {
+	Enable        bool           `json:"enable"`
+	Endpoint      xnet.URL       `json:"endpoint"`
+	Topic         string         `json:"topic"`
+	Token         string         `json:"token"`
+	TLSSkipVerify bool           `json:"tlsSkipVerify"`
+	ClientCert    string         `json:"clientCert"`
+	ClientKey     string         `json:"clientKey"`
+	RootCAs       *x509.CertPool `json:"-"`
+	QueueDir      string         `json:"queueDir"`
+	QueueLimit    uint64         `json:"queueLimit"`
+}
+
+// Validate PulsarArgs fields
+func (p PulsarArgs) Validate() error {
+	if !p.Enable {
+		return nil
+	}
+	if p.Endpoint.IsEmpty() {
+		return errors.New("endpoint empty")
+	}
+	switch p.Endpoint.Scheme {
+	case "ws", "wss", "http", "https":
+	default:
+		return errors.New("unknown protocol in endpoint, expected ws or wss")
+	}
+	if _, err := p.producerPath(); err != nil {
+		return err
+	}
+	if p.ClientCert != "" && p.ClientKey == "" || p.ClientCert == "" && p.ClientKey != "" {
+		return errors.New("cert and key must be specified as a pair")
+	}
+	if p.QueueDir != "" {
+		if !filepath.IsAbs(p.QueueDir) {
+			return errors.New("queueDir path should be absolute")
+		}
+	}
+	return nil
+}
+
+// producerPath - returns the WebSocket producer path of the topic, the
+// topic is either fully qualified e.g. `persistent://public/default/events`
+// or of the form `tenant/namespace/topic`.
+func (p PulsarArgs) producerPath() (string, error) {
+	domain, name := "persistent", p.Topic
+	if i := strings.Index(p.Topic, "://"); i >= 0 {
+		domain, name = p.Topic[:i], p.Topic[i+len("://"):]
+	}
+	if domain != "persistent" && domain != "non-persistent" {
+		return "", fmt.Errorf("invalid topic domain %s", domain)
+	}
+	parts := strings.Split(name, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", errors.New("topic must be of the form tenant/namespace/topic")
+	}
+	return "/ws/v2/producer/" + domain + "/" + name, nil
+}
+
+// pulsarMessage - message sent to the Pulsar WebSocket producer.
+type pulsarMessage struct {
+	Payload    string            `json:"payload"`
+	Properties map[string]string `json:"properties,omitempty"`
+	Context    string            `json:"context,omitempty"`
+	Key        string            `json:"key,omitempty"`
+}
+
+// pulsarResponse - acknowledgement received for a pulsarMessage.
+type pulsarResponse struct {
+	Result    string `json:"result"`
+	ErrorMsg  string `json:"errorMsg"`
+	MessageID string `json:"messageId"`
+	Context   string `json:"context"`
+}
+
+// PulsarTarget - Pulsar target, events are published through the
+// WebSocket producer API of the broker.
+type PulsarTarget struct {
+	id         event.TargetID
+	args       PulsarArgs
+	dialer     *websocket.Dialer
+	url        string
+	header     http.Header
+	store      Store
+	loggerOnce func(ctx context.Context, err error, id interface{}, errKind ...interface{})
+
+	mu   sync.Mutex // protects conn and seq
+	conn *websocket.Conn
+	seq  uint64
+}
+
+// ID - returns target ID.
+func (target *PulsarTarget) ID() event.TargetID {
+	return target.id
+}
+
+// HasQueueStore - Checks if the queueStore has been configured for the target
+func (target *PulsarTarget) HasQueueStore() bool {
+	return target.store != nil
+}
+
+// Store - returns the queue store of the target, nil if not configured.
+func (target *PulsarTarget) Store() event.TargetStore {
+	if target.store == nil {
+		return nil
+	}
+	return target.store
+}
+
+// connect - opens the producer connection if required. Must be called
+// with target.mu held.
+func (target *PulsarTarget) connect() error {
+	if target.conn != nil {
+		return nil
+	}
+
+	conn, resp, err := target.dialer.Dial(target.url, target.header)
+	if err != nil {
+		if xnet.IsNetworkOrHostDown(err, false) || errors.Is(err, context.DeadlineExceeded) {
+			return errNotConnected
+		}
+		if resp != nil {
+			return fmt.Errorf("pulsar producer connection failed with %v", resp.Status)
+		}
+		return err
+	}
+	target.conn = conn
+	return nil
+}
+
+// closeConn - must be called with target.mu held.
+func (target *PulsarTarget) closeConn() error {
+	if target.conn == nil {
+		return nil
+	}
+	err := target.conn.Close()
+	target.conn = nil
+	return err
+}
+
+// IsActive - Return true if target is up and active
+func (target *PulsarTarget) IsActive() (bool, error) {
+	target.mu.Lock()
+	defer target.mu.Unlock()
+
+	if err := target.connect(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Save - saves the events to the store if queuestore is configured, which will be replayed when the Pulsar connection is active.
+func (target *PulsarTarget) Save(eventData event.Event) error {
+	if target.store != nil {
+		return target.store.Put(eventData)
+	}
+	return target.send(eventData)
+}
+
+// send - publishes an event and waits for the broker acknowledgement.
+func (target *PulsarTarget) send(eventData event.Event) error {
+	objectName, err := url.QueryUnescape(eventData.S3.Object.Key)
+	if err != nil {
+		return err
+	}
+	key := eventData.S3.Bucket.Name + "/" + objectName
+
+	data, err := json.Marshal(event.Log{EventName: eventData.EventName, Key: key, Records: []event.Event{eventData}})
+	if err != nil {
+		return err
+	}
+
+	target.mu.Lock()
+	defer target.mu.Unlock()
+
+	if err = target.connect(); err != nil {
+		return err
+	}
+
+	target.seq++
+	msg := pulsarMessage{
+		Payload:    base64.StdEncoding.EncodeToString(data),
+		Properties: map[string]string{"EventName": eventData.EventName.String()},
+		Context:    strconv.FormatUint(target.seq, 10),
+		Key:        key,
+	}
+
+	target.conn.SetWriteDeadline(time.Now().Add(pulsarSendTimeout))
+	target.conn.SetReadDeadline(time.Now().Add(pulsarSendTimeout))
+
+	var resp pulsarResponse
+	if err = target.conn.WriteJSON(msg); err == nil {
+		err = target.conn.ReadJSON(&resp)
+	}
+	if err != nil {
+		// The connection state is unknown, reconnect on the next send.
+		target.closeConn()
+		if xnet.IsNetworkOrHostDown(err, false) || IsConnResetErr(err) || websocket.IsUnexpectedCloseError(err) {
+			return errNotConnected
+		}
+		return err
+	}
+
+	if resp.Result != "ok" {
+		return fmt.Errorf("pulsar send failed with %s: %s", resp.Result, resp.ErrorMsg)
+	}
+	if resp.Context != msg.Context {
+		target.closeConn()
+		return fmt.Errorf("pulsar acknowledgement for unexpected message %s", resp.Context)
+	}
+	return nil
+}
+
+// Send - reads an event from store and sends it to Pulsar.
+func (target *PulsarTarget) Send(eventKey string) error {
+	eventData, eErr := target.store.Get(eventKey)
+	if eErr != nil {
+		// The last event key in a successful batch will be sent in the channel atmost once by the replayEvents()
+		// Such events will not exist and would've been already been sent successfully.
+		if os.IsNotExist(eErr) {
+			return nil
+		}
+		return eErr
+	}
+
+	if err := target.send(eventData); err != nil {
+		return err
+	}
+
+	// Delete the event from store.
+	return target.store.Del(eventKey)
+}
+
+// Close - closes the producer connection.
+func (target *PulsarTarget) Close() error {
+	target.mu.Lock()
+	defer target.mu.Unlock()
+
+	return target.closeConn()
+}
+
+// NewPulsarTarget - creates new Pulsar target.
+func NewPulsarTarget(id string, args PulsarArgs, doneCh <-chan struct{}, loggerOnce func(ctx context.Context, err error, id interface{}, errKind ...interface{}), test bool) (*PulsarTarget, error) {
+	var store Store
+
+	target := &PulsarTarget{
+		id:         event.TargetID{ID: id, Name: "pulsar"},
+		args:       args,
+		header:     make(http.Header),
+		loggerOnce: loggerOnce,
+	}
+
+	path, err := args.producerPath()
+	if err != nil {
+		return target, err
+	}
+	u := url.URL(args.Endpoint)
+	switch u.Scheme {
+	case "http":
+		u.Scheme = "ws"
+	case "https":
+		u.Scheme = "wss"
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + path
+	u.RawQuery = url.Values{"sendTimeoutMillis": {strconv.FormatInt(pulsarSendTimeout.Milliseconds(), 10)}}.Encode()
+	target.url = u.String()
+
+	if args.Token != "" {
+		target.header.Set("Authorization", "Bearer "+args.Token)
+	}
+
+	tlsConfig := &tls.Config{
+		RootCAs:            args.RootCAs,
+		InsecureSkipVerify: args.TLSSkipVerify,
+	}
+	if args.ClientCert != "" && args.ClientKey != "" {
+		cert, err := tls.LoadX509KeyPair(args.ClientCert, args.ClientKey)
+		if err != nil {
+			return target, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	target.dialer = &websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: 10 * time.Second,
+		TLSClientConfig:  tlsConfig,
+	}
+
+	if args.QueueDir != "" {
+		queueDir := filepath.Join(args.QueueDir, storePrefix+"-pulsar-"+id)
+		store = NewQueueStore(queueDir, args.QueueLimit)
+		if oErr := store.Open(); oErr != nil {
+			target.loggerOnce(context.Background(), oErr, target.ID())
+			return target, oErr
+		}
+		target.store = store
+	}
+
+	_, err = target.IsActive()
+	if err != nil {
+		if target.store == nil || err != errNotConnected {
+			target.loggerOnce(context.Background(), err, target.ID())
+			return target, err
+		}
+	}
+
+	if target.store != nil && !test {
+		// Replays the events from the store.
+		eventKeyCh := replayEvents(target.store, doneCh, target.loggerOnce, target.ID())
+		// Start replaying events from the store.
+		go sendEvents(target, target.store, eventKeyCh, doneCh, target.loggerOnce)
+	}
+
+	return target, nil
+}
@@ -0,0 +1,142 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package target
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/websocket"
+
+	"minio/pkg/event"
+	xnet "minio/pkg/net"
+)
+
+func TestPulsarArgsValidate(t *testing.T) {
+	endpoint, err := xnet.ParseURL("wss://localhost:8443")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		topic string
+		path  string
+		valid bool
+	}{
+		{"persistent://public/default/events", "/ws/v2/producer/persistent/public/default/events", true},
+		{"non-persistent://public/default/events", "/ws/v2/producer/non-persistent/public/default/events", true},
+		{"public/default/events", "/ws/v2/producer/persistent/public/default/events", true},
+		{"events", "", false},
+		{"public//events", "", false},
+		{"local://public/default/events", "", false},
+	}
+
+	for i, tc := range testCases {
+		args := PulsarArgs{Enable: true, Endpoint: *endpoint, Topic: tc.topic}
+		err := args.Validate()
+		if tc.valid != (err == nil) {
+			t.Fatalf("case %d: expected valid %v, got %v", i+1, tc.valid, err)
+		}
+		if path, _ := args.producerPath(); path != tc.path {
+			t.Fatalf("case %d: expected path %s, got %s", i+1, tc.path, path)
+		}
+	}
+}
+
+func TestPulsarTargetSave(t *testing.T) {
+	var upgrader websocket.Upgrader
+	received := make(chan pulsarMessage, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ws/v2/producer/persistent/public/default/events" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer s3cr3t" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			var msg pulsarMessage
+			if err := conn.ReadJSON(&msg); err != nil {
+				return
+			}
+			received <- msg
+			if err := conn.WriteJSON(pulsarResponse{Result: "ok", MessageID: "CAAQAw==", Context: msg.Context}); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	endpoint, err := xnet.ParseURL(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	args := PulsarArgs{
+		Enable:   true,
+		Endpoint: *endpoint,
+		Topic:    "persistent://public/default/events",
+		Token:    "s3cr3t",
+	}
+	if err = args.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	logOnce := func(ctx context.Context, err error, id interface{}, kind ...interface{}) {}
+	target, err := NewPulsarTarget("1", args, nil, logOnce, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer target.Close()
+
+	eventData := event.Event{EventName: event.ObjectCreatedPut}
+	eventData.S3.Bucket.Name = "bucket"
+	eventData.S3.Object.Key = "object"
+	if err = target.Save(eventData); err != nil {
+		t.Fatal(err)
+	}
+
+	msg := <-received
+	if msg.Key != "bucket/object" || msg.Properties["EventName"] != event.ObjectCreatedPut.String() {
+		t.Fatalf("unexpected message %+v", msg)
+	}
+	data, err := base64.StdEncoding.DecodeString(msg.Payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var log event.Log
+	if err = json.Unmarshal(data, &log); err != nil {
+		t.Fatal(err)
+	}
+	if log.Key != "bucket/object" || len(log.Records) != 1 {
+		t.Fatalf("unexpected payload %s", data)
+	}
+
+	args.Token = "wrong"
+	if _, err = NewPulsarTarget("2", args, nil, logOnce, true); err == nil {
+		t.Fatal("expected authentication failure")
+	}
+}
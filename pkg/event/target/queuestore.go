@@ -23,7 +23,10 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"minio/pkg/event"
 	"minio/pkg/sys"
@@ -32,14 +35,33 @@ import (
 const (
 	defaultLimit = 100000 // Default store limit.
 	eventExt     = ".event"
+
+	// Sub-directory of the store holding events which exceeded
+	// their retry budget.
+	deadLetterDir = "deadletter"
+
+	// Duration for which the age of the oldest queued event is
+	// cached, finding it requires listing the whole store.
+	oldestEventTTL = 30 * time.Second
 )
 
 // QueueStore - Filestore for persisting events.
 type QueueStore struct {
 	sync.RWMutex
 	currentEntries uint64
+	deadLettered   int
 	entryLimit     uint64
 	directory      string
+
+	// Delivery statistics, updated atomically.
+	sent    uint64
+	failed  uint64
+	dropped uint64
+
+	// Cached modification time of the oldest queued event.
+	oldestMu        sync.Mutex
+	oldestEvent     time.Time
+	oldestEventTime time.Time
 }
 
 // NewQueueStore - Creates an instance for QueueStore.
@@ -67,7 +89,7 @@ func (store *QueueStore) Open() error {
 	store.Lock()
 	defer store.Unlock()
 
-	if err := os.MkdirAll(store.directory, os.FileMode(0770)); err != nil {
+	if err := os.MkdirAll(filepath.Join(store.directory, deadLetterDir), os.FileMode(0770)); err != nil {
 		return err
 	}
 
@@ -76,6 +98,12 @@ func (store *QueueStore) Open() error {
 		return err
 	}
 
+	deadLettered, err := listEvents(filepath.Join(store.directory, deadLetterDir))
+	if err != nil {
+		return err
+	}
+	store.deadLettered = len(deadLettered)
+
 	currentEntries := uint64(len(names))
 	if currentEntries >= store.entryLimit {
 		return errLimitExceeded
@@ -107,9 +135,14 @@ func (store *QueueStore) write(key string, e event.Event) error {
 }
 
 // Put - puts a event to the store.
-func (store *QueueStore) Put(e event.Event) error {
+func (store *QueueStore) Put(e event.Event) (err error) {
 	store.Lock()
-	defer store.Unlock()
+	defer func() {
+		store.Unlock()
+		if err != nil {
+			atomic.AddUint64(&store.dropped, 1)
+		}
+	}()
 	if store.currentEntries >= store.entryLimit {
 		return errLimitExceeded
 	}
@@ -128,7 +161,7 @@ func (store *QueueStore) Get(key string) (event event.Event, err error) {
 		store.RUnlock()
 		if err != nil {
 			// Upon error we remove the entry.
-			store.Del(key)
+			store.drop(key)
 		}
 	}(store)
 
@@ -149,11 +182,47 @@ func (store *QueueStore) Get(key string) (event event.Event, err error) {
 	return event, nil
 }
 
-// Del - Deletes an entry from the store.
+// Del - Deletes an entry from the store after it was sent.
 func (store *QueueStore) Del(key string) error {
 	store.Lock()
 	defer store.Unlock()
-	return store.del(key)
+	if err := store.del(key); err != nil {
+		return err
+	}
+	atomic.AddUint64(&store.sent, 1)
+	return nil
+}
+
+// drop - Deletes an unreadable entry from the store.
+func (store *QueueStore) drop(key string) {
+	store.Lock()
+	defer store.Unlock()
+	if store.del(key) == nil {
+		atomic.AddUint64(&store.dropped, 1)
+	}
+}
+
+// SendFailed - counts a failed attempt to send an entry.
+func (store *QueueStore) SendFailed() {
+	atomic.AddUint64(&store.failed, 1)
+}
+
+// DeadLetter - Moves an entry to the dead-letter directory.
+func (store *QueueStore) DeadLetter(key string) error {
+	store.Lock()
+	defer store.Unlock()
+
+	if err := os.Rename(filepath.Join(store.directory, key+eventExt),
+		filepath.Join(store.directory, deadLetterDir, key+eventExt)); err != nil {
+		return err
+	}
+
+	store.currentEntries--
+	if store.currentEntries == math.MaxUint64 {
+		store.currentEntries = 0
+	}
+	store.deadLettered++
+	return nil
 }
 
 // lockless call
@@ -188,19 +257,178 @@ func (store *QueueStore) List() ([]string, error) {
 // list lock less.
 func (store *QueueStore) list() ([]string, error) {
 	var names []string
-	files, err := ioutil.ReadDir(store.directory)
+	files, err := listEvents(store.directory)
 	if err != nil {
 		return names, err
 	}
 
+	for _, file := range files {
+		names = append(names, file.Name())
+	}
+
+	return names, nil
+}
+
+// listEvents - lists the event files in directory sorted by
+// modification time.
+func listEvents(directory string) ([]os.FileInfo, error) {
+	files, err := ioutil.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	events := files[:0]
+	for _, file := range files {
+		if file.Mode().IsRegular() && strings.HasSuffix(file.Name(), eventExt) {
+			events = append(events, file)
+		}
+	}
+
 	// Sort the dentries.
-	sort.Slice(files, func(i, j int) bool {
-		return files[i].ModTime().Before(files[j].ModTime())
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].ModTime().Before(events[j].ModTime())
 	})
 
+	return events, nil
+}
+
+// Stats - returns the queue length and delivery statistics.
+func (store *QueueStore) Stats() event.TargetStats {
+	store.RLock()
+
+	stats := event.TargetStats{
+		Queued:       int(store.currentEntries),
+		DeadLettered: store.deadLettered,
+		Sent:         atomic.LoadUint64(&store.sent),
+		Failed:       atomic.LoadUint64(&store.failed),
+		Dropped:      atomic.LoadUint64(&store.dropped),
+	}
+	store.RUnlock()
+
+	if stats.Queued > 0 {
+		stats.OldestEvent = store.oldest()
+	}
+	return stats
+}
+
+// oldest - returns the modification time of the oldest queued event,
+// the store is listed at most once every oldestEventTTL.
+func (store *QueueStore) oldest() time.Time {
+	store.oldestMu.Lock()
+	defer store.oldestMu.Unlock()
+
+	if time.Since(store.oldestEventTime) < oldestEventTTL {
+		return store.oldestEvent
+	}
+
+	store.oldestEvent = time.Time{}
+	if files, err := listEvents(store.directory); err == nil && len(files) > 0 {
+		store.oldestEvent = files[0].ModTime()
+	}
+	store.oldestEventTime = time.Now()
+	return store.oldestEvent
+}
+
+// Events - lists up to max queued or dead-lettered events.
+func (store *QueueStore) Events(deadLetter bool, max int) ([]event.QueuedEvent, error) {
+	store.RLock()
+	defer store.RUnlock()
+
+	directory := store.directory
+	if deadLetter {
+		directory = filepath.Join(directory, deadLetterDir)
+	}
+
+	files, err := listEvents(directory)
+	if err != nil {
+		return nil, err
+	}
+	if max > 0 && len(files) > max {
+		files = files[:max]
+	}
+
+	events := make([]event.QueuedEvent, 0, len(files))
 	for _, file := range files {
-		names = append(names, file.Name())
+		eventData, err := ioutil.ReadFile(filepath.Join(directory, file.Name()))
+		if err != nil {
+			if os.IsNotExist(err) {
+				// Sent in the meantime.
+				continue
+			}
+			return nil, err
+		}
+		qe := event.QueuedEvent{
+			Key:      strings.TrimSuffix(file.Name(), eventExt),
+			QueuedAt: file.ModTime(),
+		}
+		if err = json.Unmarshal(eventData, &qe.Event); err != nil {
+			// Corrupted entries are removed when replayed.
+			continue
+		}
+		events = append(events, qe)
 	}
+	return events, nil
+}
 
-	return names, nil
+// Replay - moves dead-lettered events back to the queue, as many as the
+// store limit allows.
+func (store *QueueStore) Replay() (int, error) {
+	store.Lock()
+	defer store.Unlock()
+
+	files, err := listEvents(filepath.Join(store.directory, deadLetterDir))
+	if err != nil {
+		return 0, err
+	}
+
+	var replayed int
+	for _, file := range files {
+		if store.currentEntries >= store.entryLimit {
+			return replayed, errLimitExceeded
+		}
+		oldPath := filepath.Join(store.directory, deadLetterDir, file.Name())
+		newPath := filepath.Join(store.directory, file.Name())
+		if err = os.Rename(oldPath, newPath); err != nil {
+			return replayed, err
+		}
+		// Queue the event behind the currently queued events.
+		now := time.Now()
+		os.Chtimes(newPath, now, now)
+
+		store.currentEntries++
+		store.deadLettered--
+		replayed++
+	}
+	return replayed, nil
+}
+
+// Purge - deletes all queued or dead-lettered events.
+func (store *QueueStore) Purge(deadLetter bool) (int, error) {
+	store.Lock()
+	defer store.Unlock()
+
+	directory := store.directory
+	if deadLetter {
+		directory = filepath.Join(directory, deadLetterDir)
+	}
+
+	files, err := listEvents(directory)
+	if err != nil {
+		return 0, err
+	}
+
+	var purged int
+	for _, file := range files {
+		if err = os.Remove(filepath.Join(directory, file.Name())); err != nil && !os.IsNotExist(err) {
+			return purged, err
+		}
+		purged++
+	}
+
+	if deadLetter {
+		store.deadLettered = 0
+	} else {
+		store.currentEntries = 0
+	}
+	return purged, nil
 }
@@ -22,6 +22,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"minio/pkg/event"
 )
@@ -211,3 +212,151 @@ func TestQueueStoreListN(t *testing.T) {
 		t.Fatalf("Expected List() to fail with os.ErrNotExist, %s", err)
 	}
 }
+
+// TestQueueStoreDeadLetter - tests for dead-lettering, replaying and purging events.
+func TestQueueStoreDeadLetter(t *testing.T) {
+	defer func() {
+		if err := tearDownStore(); err != nil {
+			t.Fatal("Failed to tear down store ", err)
+		}
+	}()
+	store, err := setUpStore(queueDir, 10)
+	if err != nil {
+		t.Fatal("Failed to create a queue store ", err)
+	}
+	for i := 0; i < 5; i++ {
+		if err = store.Put(testEvent); err != nil {
+			t.Fatal("Failed to put to queue store ", err)
+		}
+	}
+	names, err := store.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Send one event and dead-letter two.
+	if err = store.Del(strings.TrimSuffix(names[0], eventExt)); err != nil {
+		t.Fatal(err)
+	}
+	store.SendFailed()
+	for _, name := range names[1:3] {
+		if err = store.DeadLetter(strings.TrimSuffix(name, eventExt)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The dead-letter directory must not be listed as an event.
+	if names, err = store.List(); err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 2 {
+		t.Fatalf("List() Expected: 2, got %d", len(names))
+	}
+
+	stats := store.Stats()
+	if stats.Queued != 2 || stats.DeadLettered != 2 || stats.Sent != 1 || stats.Failed != 1 || stats.Dropped != 0 {
+		t.Fatalf("Unexpected stats %+v", stats)
+	}
+	if stats.OldestEvent.IsZero() {
+		t.Fatal("Expected the time of the oldest event")
+	}
+
+	events, err := store.Events(true, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || !reflect.DeepEqual(events[0].Event, testEvent) {
+		t.Fatalf("Unexpected dead-lettered events %+v", events)
+	}
+	if events, err = store.Events(false, 1); err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("Events() Expected: 1, got %d", len(events))
+	}
+
+	if n, err := store.Replay(); err != nil || n != 2 {
+		t.Fatalf("Replay() Expected: 2, got %d, %v", n, err)
+	}
+	if stats = store.Stats(); stats.Queued != 4 || stats.DeadLettered != 0 {
+		t.Fatalf("Unexpected stats after replay %+v", stats)
+	}
+
+	if n, err := store.Purge(false); err != nil || n != 4 {
+		t.Fatalf("Purge() Expected: 4, got %d, %v", n, err)
+	}
+	if stats = store.Stats(); stats.Queued != 0 || !stats.OldestEvent.IsZero() {
+		t.Fatalf("Unexpected stats after purge %+v", stats)
+	}
+}
+
+// TestQueueStoreDropped - tests that events rejected by a full store are counted.
+func TestQueueStoreDropped(t *testing.T) {
+	defer func() {
+		if err := tearDownStore(); err != nil {
+			t.Fatal("Failed to tear down store ", err)
+		}
+	}()
+	store, err := setUpStore(queueDir, 1)
+	if err != nil {
+		t.Fatal("Failed to create a queue store ", err)
+	}
+	if err = store.Put(testEvent); err != nil {
+		t.Fatal("Failed to put to queue store ", err)
+	}
+	if err = store.Put(testEvent); err != errLimitExceeded {
+		t.Fatalf("Expected %v, got %v", errLimitExceeded, err)
+	}
+	if stats := store.Stats(); stats.Dropped != 1 {
+		t.Fatalf("Dropped Expected: 1, got %d", stats.Dropped)
+	}
+}
+
+// TestQueueStoreOldestEvent - tests that the oldest event is cached between stats.
+func TestQueueStoreOldestEvent(t *testing.T) {
+	defer func() {
+		if err := tearDownStore(); err != nil {
+			t.Fatal("Failed to tear down store ", err)
+		}
+	}()
+	store, err := setUpStore(queueDir, 10)
+	if err != nil {
+		t.Fatal("Failed to create a queue store ", err)
+	}
+	if err = store.Put(testEvent); err != nil {
+		t.Fatal(err)
+	}
+	oldest := store.Stats().OldestEvent
+	if oldest.IsZero() {
+		t.Fatal("Expected the time of the oldest event")
+	}
+
+	// Events put after the oldest event was listed are not
+	// listed again until the cached time expires.
+	queueStore := store.(*QueueStore)
+	names, err := store.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Del(strings.TrimSuffix(names[0], eventExt)); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Put(testEvent); err != nil {
+		t.Fatal(err)
+	}
+	if names, err = store.List(); err != nil {
+		t.Fatal(err)
+	}
+	newest := oldest.Add(time.Hour)
+	if err = os.Chtimes(filepath.Join(queueDir, names[0]), newest, newest); err != nil {
+		t.Fatal(err)
+	}
+	if got := store.Stats().OldestEvent; !got.Equal(oldest) {
+		t.Fatalf("Expected the cached oldest event %v, got %v", oldest, got)
+	}
+
+	queueStore.oldestEventTime = time.Time{}
+	if got := store.Stats().OldestEvent; !got.Equal(newest) {
+		t.Fatalf("Expected the oldest event %v, got %v", newest, got)
+	}
+}
@@ -35,22 +35,27 @@ import (
 
 // Redis constants
 const (
-	RedisFormat     = "format"
-	RedisAddress    = "address"
-	RedisPassword   = "password"
-	RedisKey        = "key"
-	RedisQueueDir   = "queue_dir"
-	RedisQueueLimit = "queue_limit"
-
-	EnvRedisEnable     = "MINIO_NOTIFY_REDIS_ENABLE"
-	EnvRedisFormat     = "MINIO_NOTIFY_REDIS_FORMAT"
-	EnvRedisAddress    = "MINIO_NOTIFY_REDIS_ADDRESS"
-	EnvRedisPassword   = "MINIO_NOTIFY_REDIS_PASSWORD"
-	EnvRedisKey        = "MINIO_NOTIFY_REDIS_KEY"
-	EnvRedisQueueDir   = "MINIO_NOTIFY_REDIS_QUEUE_DIR"
-	EnvRedisQueueLimit = "MINIO_NOTIFY_REDIS_QUEUE_LIMIT"
+	RedisFormat       = "format"
+	RedisAddress      = "address"
+	RedisPassword     = "password"
+	RedisKey          = "key"
+	RedisQueueDir     = "queue_dir"
+	RedisQueueLimit   = "queue_limit"
+	RedisStreamMaxLen = "stream_maxlen"
+
+	EnvRedisEnable       = "MINIO_NOTIFY_REDIS_ENABLE"
+	EnvRedisFormat       = "MINIO_NOTIFY_REDIS_FORMAT"
+	EnvRedisAddress      = "MINIO_NOTIFY_REDIS_ADDRESS"
+	EnvRedisPassword     = "MINIO_NOTIFY_REDIS_PASSWORD"
+	EnvRedisKey          = "MINIO_NOTIFY_REDIS_KEY"
+	EnvRedisQueueDir     = "MINIO_NOTIFY_REDIS_QUEUE_DIR"
+	EnvRedisQueueLimit   = "MINIO_NOTIFY_REDIS_QUEUE_LIMIT"
+	EnvRedisStreamMaxLen = "MINIO_NOTIFY_REDIS_STREAM_MAXLEN"
 )
 
+// RedisStreamFormat - events are appended to a Redis stream using XADD.
+const RedisStreamFormat = "stream"
+
 // RedisArgs - Redis target arguments.
 type RedisArgs struct {
 	Enable     bool      `json:"enable"`
@@ -60,6 +65,9 @@ type RedisArgs struct {
 	Key        string    `json:"key"`
 	QueueDir   string    `json:"queueDir"`
 	QueueLimit uint64    `json:"queueLimit"`
+	// StreamMaxLen - approximate maximum length of the stream, no
+	// trimming is done if zero.
+	StreamMaxLen uint64 `json:"streamMaxLen"`
 }
 
 // RedisAccessEvent holds event log data and timestamp
@@ -76,11 +84,15 @@ func (r RedisArgs) Validate() error {
 
 	if r.Format != "" {
 		f := strings.ToLower(r.Format)
-		if f != event.NamespaceFormat && f != event.AccessFormat {
+		if f != event.NamespaceFormat && f != event.AccessFormat && f != RedisStreamFormat {
 			return fmt.Errorf("unrecognized format")
 		}
 	}
 
+	if r.StreamMaxLen > 0 && r.Format != RedisStreamFormat {
+		return fmt.Errorf("stream maxlen is only supported with %s format", RedisStreamFormat)
+	}
+
 	if r.Key == "" {
 		return fmt.Errorf("empty key")
 	}
@@ -102,8 +114,11 @@ func (r RedisArgs) validateFormat(c redis.Conn) error {
 
 	if typeAvailable != "none" {
 		expectedType := "hash"
-		if r.Format == event.AccessFormat {
+		switch r.Format {
+		case event.AccessFormat:
 			expectedType = "list"
+		case RedisStreamFormat:
+			expectedType = "stream"
 		}
 
 		if typeAvailable != expectedType {
@@ -134,6 +149,14 @@ func (target *RedisTarget) HasQueueStore() bool {
 	return target.store != nil
 }
 
+// Store - returns the queue store of the target, nil if not configured.
+func (target *RedisTarget) Store() event.TargetStore {
+	if target.store == nil {
+		return nil
+	}
+	return target.store
+}
+
 // IsActive - Return true if target is up and active
 func (target *RedisTarget) IsActive() (bool, error) {
 	conn := target.pool.Get()
@@ -203,9 +226,47 @@ func (target *RedisTarget) send(eventData event.Event) error {
 		}
 	}
 
+	if target.args.Format == RedisStreamFormat {
+		args, err := target.args.xaddArgs(eventData)
+		if err != nil {
+			return err
+		}
+		if _, err := conn.Do("XADD", args...); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// xaddArgs - returns the XADD arguments appending eventData to the
+// stream. Event name, object key and sequencer are separate fields so
+// that consumer groups can route and deduplicate entries without
+// decoding the records.
+func (r RedisArgs) xaddArgs(eventData event.Event) ([]interface{}, error) {
+	objectName, err := url.QueryUnescape(eventData.S3.Object.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal([]event.Event{eventData})
+	if err != nil {
+		return nil, err
+	}
+
+	args := []interface{}{r.Key}
+	if r.StreamMaxLen > 0 {
+		args = append(args, "MAXLEN", "~", r.StreamMaxLen)
+	}
+	return append(args, "*",
+		"EventName", eventData.EventName.String(),
+		"Key", eventData.S3.Bucket.Name+"/"+objectName,
+		"EventTime", eventData.EventTime,
+		"Sequencer", eventData.S3.Object.Sequencer,
+		"Records", data,
+	), nil
+}
+
 // Send - reads an event from store and sends it to redis.
 func (target *RedisTarget) Send(eventKey string) error {
 	conn := target.pool.Get()
@@ -336,7 +397,7 @@ func NewRedisTarget(id string, args RedisArgs, doneCh <-chan struct{}, loggerOnc
 		// Replays the events from the store.
 		eventKeyCh := replayEvents(target.store, doneCh, target.loggerOnce, target.ID())
 		// Start replaying events from the store.
-		go sendEvents(target, eventKeyCh, doneCh, target.loggerOnce)
+		go sendEvents(target, target.store, eventKeyCh, doneCh, target.loggerOnce)
 	}
 
 	return target, nil
@@ -0,0 +1,73 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package target
+
+import (
+	"reflect"
+	"testing"
+
+	"minio/pkg/event"
+)
+
+func TestRedisArgsValidate(t *testing.T) {
+	testCases := []struct {
+		args  RedisArgs
+		valid bool
+	}{
+		{RedisArgs{Enable: true, Key: "events", Format: event.NamespaceFormat}, true},
+		{RedisArgs{Enable: true, Key: "events", Format: RedisStreamFormat}, true},
+		{RedisArgs{Enable: true, Key: "events", Format: RedisStreamFormat, StreamMaxLen: 1000}, true},
+		{RedisArgs{Enable: true, Key: "events", Format: event.AccessFormat, StreamMaxLen: 1000}, false},
+		{RedisArgs{Enable: true, Key: "events", Format: "unknown"}, false},
+	}
+
+	for i, tc := range testCases {
+		if err := tc.args.Validate(); tc.valid != (err == nil) {
+			t.Fatalf("case %d: expected valid %v, got %v", i+1, tc.valid, err)
+		}
+	}
+}
+
+func TestRedisXAddArgs(t *testing.T) {
+	eventData := event.Event{EventName: event.ObjectCreatedPut, EventTime: "2021-03-01T10:00:00.000Z"}
+	eventData.S3.Bucket.Name = "bucket"
+	eventData.S3.Object.Key = "my%20object"
+	eventData.S3.Object.Sequencer = "1664A5D5A9A3E2B0"
+
+	args, err := RedisArgs{Key: "events", StreamMaxLen: 1000}.xaddArgs(eventData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []interface{}{"events", "MAXLEN", "~", uint64(1000), "*",
+		"EventName", "s3:ObjectCreated:Put",
+		"Key", "bucket/my object",
+		"EventTime", "2021-03-01T10:00:00.000Z",
+		"Sequencer", "1664A5D5A9A3E2B0",
+		"Records"}
+	if !reflect.DeepEqual(args[:len(args)-1], expected) {
+		t.Fatalf("expected %v, got %v", expected, args[:len(args)-1])
+	}
+
+	args, err = RedisArgs{Key: "events"}.xaddArgs(eventData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if args[1] != "*" {
+		t.Fatalf("expected no trimming, got %v", args)
+	}
+}
@@ -0,0 +1,153 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package target
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	xnet "minio/pkg/net"
+)
+
+const (
+	signV4Algorithm = "AWS4-HMAC-SHA256"
+	iso8601Format   = "20060102T150405Z"
+	yyyymmdd        = "20060102"
+)
+
+// awsCredentials - static credentials used to sign requests to AWS
+// compatible services. Requests are sent unsigned if accessKey is empty.
+type awsCredentials struct {
+	accessKey string
+	secretKey string
+	region    string
+}
+
+// signV4 - signs req for service using AWS Signature Version 4. All of
+// host, content-type and x-amz-* headers are signed.
+func signV4(req *http.Request, payload []byte, creds awsCredentials, service string, t time.Time) {
+	t = t.UTC()
+	amzDate := t.Format(iso8601Format)
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	headers := map[string]string{"host": host}
+	for k, v := range req.Header {
+		k = strings.ToLower(k)
+		if k == "content-type" || strings.HasPrefix(k, "x-amz-") {
+			headers[k] = strings.Join(v, ",")
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, k := range names {
+		canonicalHeaders.WriteString(k + ":" + strings.TrimSpace(headers[k]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	uri := req.URL.EscapedPath()
+	if uri == "" {
+		uri = "/"
+	}
+	payloadHash := sha256.Sum256(payload)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uri,
+		strings.Replace(req.URL.Query().Encode(), "+", "%20", -1),
+		canonicalHeaders.String(),
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+
+	scope := strings.Join([]string{t.Format(yyyymmdd), creds.region, service, "aws4_request"}, "/")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := signV4Algorithm + "\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalRequestHash[:])
+
+	key := []byte("AWS4" + creds.secretKey)
+	for _, v := range []string{t.Format(yyyymmdd), creds.region, service, "aws4_request"} {
+		key = sumHMAC(key, []byte(v))
+	}
+	signature := hex.EncodeToString(sumHMAC(key, []byte(stringToSign)))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signV4Algorithm, creds.accessKey, scope, signedHeaders, signature))
+}
+
+func sumHMAC(key []byte, data []byte) []byte {
+	hash := hmac.New(sha256.New, key)
+	hash.Write(data)
+	return hash.Sum(nil)
+}
+
+// awsErrorResponse - error returned by AWS query APIs.
+type awsErrorResponse struct {
+	Error struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	} `xml:"Error"`
+}
+
+// postAWSQuery - sends a query API request, i.e. form encoded Action
+// parameters, to endpoint and returns an error for non 2xx responses.
+func postAWSQuery(ctx context.Context, client *http.Client, endpoint xnet.URL, service string, creds awsCredentials, params url.Values) error {
+	payload := []byte(params.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	if creds.accessKey != "" {
+		signV4(req, payload, creds, service, time.Now())
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		io.Copy(ioutil.Discard, resp.Body)
+		return nil
+	}
+
+	var errResp awsErrorResponse
+	if xml.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&errResp) == nil && errResp.Error.Code != "" {
+		return fmt.Errorf("%s %s failed with %v: %s: %s", service, params.Get("Action"), resp.Status, errResp.Error.Code, errResp.Error.Message)
+	}
+	return fmt.Errorf("%s %s failed with %v", service, params.Get("Action"), resp.Status)
+}
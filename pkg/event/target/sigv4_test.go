@@ -0,0 +1,82 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package target
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	xnet "minio/pkg/net"
+)
+
+// Example request from the AWS Signature Version 4 documentation.
+func TestSignV4(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://iam.amazonaws.com/?Action=ListUsers&Version=2010-05-08", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+
+	creds := awsCredentials{
+		accessKey: "AKIDEXAMPLE",
+		secretKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+		region:    "us-east-1",
+	}
+	signV4(req, nil, creds, "iam", time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+
+	expected := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/iam/aws4_request, " +
+		"SignedHeaders=content-type;host;x-amz-date, " +
+		"Signature=5d672d79c15b13162d9279b0855cfba6789a8edb4c82c400e06b5924a6f2b5d7"
+	if got := req.Header.Get("Authorization"); got != expected {
+		t.Fatalf("expected %s, got %s", expected, got)
+	}
+	if got := req.Header.Get("X-Amz-Date"); got != "20150830T123600Z" {
+		t.Fatalf("unexpected X-Amz-Date %s", got)
+	}
+}
+
+// Requests to SQS and SNS must be scoped to their own service.
+func TestPostAWSQuery(t *testing.T) {
+	for _, service := range []string{"sqs", "sns"} {
+		var authorization string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization = r.Header.Get("Authorization")
+		}))
+
+		endpoint, err := xnet.ParseURL(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		creds := awsCredentials{accessKey: "AKIDEXAMPLE", secretKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", region: "us-east-1"}
+		params := url.Values{"Action": {"SendMessage"}}
+		err = postAWSQuery(context.Background(), server.Client(), *endpoint, service, creds, params)
+		server.Close()
+		if err != nil {
+			t.Fatalf("%s: %v", service, err)
+		}
+
+		scope := time.Now().UTC().Format(yyyymmdd) + "/us-east-1/" + service + "/aws4_request"
+		if !strings.HasPrefix(authorization, signV4Algorithm+" Credential=AKIDEXAMPLE/"+scope+",") {
+			t.Fatalf("%s: expected the credential scope %s, got %s", service, scope, authorization)
+		}
+	}
+}
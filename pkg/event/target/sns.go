@@ -0,0 +1,251 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package target
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"minio/pkg/event"
+	xnet "minio/pkg/net"
+)
+
+// SNS constants
+const (
+	SNSEndpoint   = "endpoint"
+	SNSTopicARN   = "topic_arn"
+	SNSRegion     = "region"
+	SNSAccessKey  = "access_key"
+	SNSSecretKey  = "secret_key"
+	SNSQueueDir   = "queue_dir"
+	SNSQueueLimit = "queue_limit"
+
+	EnvSNSEnable     = "MINIO_NOTIFY_SNS_ENABLE"
+	EnvSNSEndpoint   = "MINIO_NOTIFY_SNS_ENDPOINT"
+	EnvSNSTopicARN   = "MINIO_NOTIFY_SNS_TOPIC_ARN"
+	EnvSNSRegion     = "MINIO_NOTIFY_SNS_REGION"
+	EnvSNSAccessKey  = "MINIO_NOTIFY_SNS_ACCESS_KEY"
+	EnvSNSSecretKey  = "MINIO_NOTIFY_SNS_SECRET_KEY"
+	EnvSNSQueueDir   = "MINIO_NOTIFY_SNS_QUEUE_DIR"
+	EnvSNSQueueLimit = "MINIO_NOTIFY_SNS_QUEUE_LIMIT"
+)
+
+const snsAPIVersion = "2010-03-31"
+
+// SNSArgs - SNS target arguments.
+type SNSArgs struct {
+	Enable     bool            `json:"enable"`
+	Endpoint   xnet.URL        `json:"endpoint"`
+	TopicARN   string          `json:"topicARN"`
+	Region     string          `json:"region"`
+	AccessKey  string          `json:"accessKey"`
+	SecretKey  string          `json:"secretKey"`
+	Transport  *http.Transport `json:"-"`
+	QueueDir   string          `json:"queueDir"`
+	QueueLimit uint64          `json:"queueLimit"`
+}
+
+// Validate SNSArgs fields
+func (s SNSArgs) Validate() error {
+	if !s.Enable {
+		return nil
+	}
+	if s.Endpoint.IsEmpty() {
+		return errors.New("endpoint empty")
+	}
+	if s.TopicARN == "" {
+		return errors.New("topicARN empty")
+	}
+	if s.Region == "" {
+		return errors.New("region empty")
+	}
+	if s.AccessKey != "" && s.SecretKey == "" || s.AccessKey == "" && s.SecretKey != "" {
+		return errors.New("access key and secret key must be specified as a pair")
+	}
+	if s.QueueDir != "" {
+		if !filepath.IsAbs(s.QueueDir) {
+			return errors.New("queueDir path should be absolute")
+		}
+	}
+	return nil
+}
+
+// SNSTarget - SNS target.
+type SNSTarget struct {
+	id         event.TargetID
+	args       SNSArgs
+	httpClient *http.Client
+	store      Store
+	loggerOnce func(ctx context.Context, err error, id interface{}, errKind ...interface{})
+}
+
+// ID - returns target ID.
+func (target *SNSTarget) ID() event.TargetID {
+	return target.id
+}
+
+// HasQueueStore - Checks if the queueStore has been configured for the target
+func (target *SNSTarget) HasQueueStore() bool {
+	return target.store != nil
+}
+
+// Store - returns the queue store of the target, nil if not configured.
+func (target *SNSTarget) Store() event.TargetStore {
+	if target.store == nil {
+		return nil
+	}
+	return target.store
+}
+
+func (target *SNSTarget) credentials() awsCredentials {
+	return awsCredentials{
+		accessKey: target.args.AccessKey,
+		secretKey: target.args.SecretKey,
+		region:    target.args.Region,
+	}
+}
+
+// IsActive - Return true if target is up and active
+func (target *SNSTarget) IsActive() (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	params := url.Values{
+		"Action":   {"GetTopicAttributes"},
+		"TopicArn": {target.args.TopicARN},
+		"Version":  {snsAPIVersion},
+	}
+	if err := postAWSQuery(ctx, target.httpClient, target.args.Endpoint, "sns", target.credentials(), params); err != nil {
+		if xnet.IsNetworkOrHostDown(err, false) || errors.Is(err, context.DeadlineExceeded) {
+			return false, errNotConnected
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Save - saves the events to the store if queuestore is configured, which will be replayed when the SNS connection is active.
+func (target *SNSTarget) Save(eventData event.Event) error {
+	if target.store != nil {
+		return target.store.Put(eventData)
+	}
+	err := target.send(eventData)
+	if err != nil {
+		if xnet.IsNetworkOrHostDown(err, false) {
+			return errNotConnected
+		}
+	}
+	return err
+}
+
+// send - publishes an event to the SNS topic.
+func (target *SNSTarget) send(eventData event.Event) error {
+	objectName, err := url.QueryUnescape(eventData.S3.Object.Key)
+	if err != nil {
+		return err
+	}
+	key := eventData.S3.Bucket.Name + "/" + objectName
+
+	data, err := json.Marshal(event.Log{EventName: eventData.EventName, Key: key, Records: []event.Event{eventData}})
+	if err != nil {
+		return err
+	}
+
+	params := url.Values{
+		"Action":   {"Publish"},
+		"TopicArn": {target.args.TopicARN},
+		"Message":  {string(data)},
+		"Version":  {snsAPIVersion},
+	}
+	if err = postAWSQuery(context.Background(), target.httpClient, target.args.Endpoint, "sns", target.credentials(), params); err != nil {
+		target.Close()
+	}
+	return err
+}
+
+// Send - reads an event from store and sends it to SNS.
+func (target *SNSTarget) Send(eventKey string) error {
+	eventData, eErr := target.store.Get(eventKey)
+	if eErr != nil {
+		// The last event key in a successful batch will be sent in the channel atmost once by the replayEvents()
+		// Such events will not exist and would've been already been sent successfully.
+		if os.IsNotExist(eErr) {
+			return nil
+		}
+		return eErr
+	}
+
+	if err := target.send(eventData); err != nil {
+		if xnet.IsNetworkOrHostDown(err, false) {
+			return errNotConnected
+		}
+		return err
+	}
+
+	// Delete the event from store.
+	return target.store.Del(eventKey)
+}
+
+// Close - closes idle connections to the SNS endpoint.
+func (target *SNSTarget) Close() error {
+	target.httpClient.CloseIdleConnections()
+	return nil
+}
+
+// NewSNSTarget - creates new SNS target.
+func NewSNSTarget(ctx context.Context, id string, args SNSArgs, loggerOnce func(ctx context.Context, err error, id interface{}, kind ...interface{}), transport *http.Transport, test bool) (*SNSTarget, error) {
+	var store Store
+	target := &SNSTarget{
+		id:         event.TargetID{ID: id, Name: "sns"},
+		args:       args,
+		httpClient: &http.Client{Transport: transport},
+		loggerOnce: loggerOnce,
+	}
+
+	if args.QueueDir != "" {
+		queueDir := filepath.Join(args.QueueDir, storePrefix+"-sns-"+id)
+		store = NewQueueStore(queueDir, args.QueueLimit)
+		if err := store.Open(); err != nil {
+			target.loggerOnce(context.Background(), err, target.ID())
+			return target, err
+		}
+		target.store = store
+	}
+
+	_, err := target.IsActive()
+	if err != nil {
+		if target.store == nil || err != errNotConnected {
+			target.loggerOnce(ctx, err, target.ID())
+			return target, err
+		}
+	}
+
+	if target.store != nil && !test {
+		// Replays the events from the store.
+		eventKeyCh := replayEvents(target.store, ctx.Done(), target.loggerOnce, target.ID())
+		// Start replaying events from the store.
+		go sendEvents(target, target.store, eventKeyCh, ctx.Done(), target.loggerOnce)
+	}
+
+	return target, nil
+}
@@ -0,0 +1,244 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package target
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"minio/pkg/event"
+	xnet "minio/pkg/net"
+)
+
+// SQS constants
+const (
+	SQSQueueURL   = "queue_url"
+	SQSRegion     = "region"
+	SQSAccessKey  = "access_key"
+	SQSSecretKey  = "secret_key"
+	SQSQueueDir   = "queue_dir"
+	SQSQueueLimit = "queue_limit"
+
+	EnvSQSEnable     = "MINIO_NOTIFY_SQS_ENABLE"
+	EnvSQSQueueURL   = "MINIO_NOTIFY_SQS_QUEUE_URL"
+	EnvSQSRegion     = "MINIO_NOTIFY_SQS_REGION"
+	EnvSQSAccessKey  = "MINIO_NOTIFY_SQS_ACCESS_KEY"
+	EnvSQSSecretKey  = "MINIO_NOTIFY_SQS_SECRET_KEY"
+	EnvSQSQueueDir   = "MINIO_NOTIFY_SQS_QUEUE_DIR"
+	EnvSQSQueueLimit = "MINIO_NOTIFY_SQS_QUEUE_LIMIT"
+)
+
+const sqsAPIVersion = "2012-11-05"
+
+// SQSArgs - SQS target arguments.
+type SQSArgs struct {
+	Enable     bool            `json:"enable"`
+	QueueURL   xnet.URL        `json:"queueURL"`
+	Region     string          `json:"region"`
+	AccessKey  string          `json:"accessKey"`
+	SecretKey  string          `json:"secretKey"`
+	Transport  *http.Transport `json:"-"`
+	QueueDir   string          `json:"queueDir"`
+	QueueLimit uint64          `json:"queueLimit"`
+}
+
+// Validate SQSArgs fields
+func (s SQSArgs) Validate() error {
+	if !s.Enable {
+		return nil
+	}
+	if s.QueueURL.IsEmpty() {
+		return errors.New("queueURL empty")
+	}
+	if s.Region == "" {
+		return errors.New("region empty")
+	}
+	if s.AccessKey != "" && s.SecretKey == "" || s.AccessKey == "" && s.SecretKey != "" {
+		return errors.New("access key and secret key must be specified as a pair")
+	}
+	if s.QueueDir != "" {
+		if !filepath.IsAbs(s.QueueDir) {
+			return errors.New("queueDir path should be absolute")
+		}
+	}
+	return nil
+}
+
+// SQSTarget - SQS target.
+type SQSTarget struct {
+	id         event.TargetID
+	args       SQSArgs
+	httpClient *http.Client
+	store      Store
+	loggerOnce func(ctx context.Context, err error, id interface{}, errKind ...interface{})
+}
+
+// ID - returns target ID.
+func (target *SQSTarget) ID() event.TargetID {
+	return target.id
+}
+
+// HasQueueStore - Checks if the queueStore has been configured for the target
+func (target *SQSTarget) HasQueueStore() bool {
+	return target.store != nil
+}
+
+// Store - returns the queue store of the target, nil if not configured.
+func (target *SQSTarget) Store() event.TargetStore {
+	if target.store == nil {
+		return nil
+	}
+	return target.store
+}
+
+func (target *SQSTarget) credentials() awsCredentials {
+	return awsCredentials{
+		accessKey: target.args.AccessKey,
+		secretKey: target.args.SecretKey,
+		region:    target.args.Region,
+	}
+}
+
+// IsActive - Return true if target is up and active
+func (target *SQSTarget) IsActive() (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	params := url.Values{
+		"Action":          {"GetQueueAttributes"},
+		"AttributeName.1": {"QueueArn"},
+		"Version":         {sqsAPIVersion},
+	}
+	if err := postAWSQuery(ctx, target.httpClient, target.args.QueueURL, "sqs", target.credentials(), params); err != nil {
+		if xnet.IsNetworkOrHostDown(err, false) || errors.Is(err, context.DeadlineExceeded) {
+			return false, errNotConnected
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Save - saves the events to the store if queuestore is configured, which will be replayed when the SQS connection is active.
+func (target *SQSTarget) Save(eventData event.Event) error {
+	if target.store != nil {
+		return target.store.Put(eventData)
+	}
+	err := target.send(eventData)
+	if err != nil {
+		if xnet.IsNetworkOrHostDown(err, false) {
+			return errNotConnected
+		}
+	}
+	return err
+}
+
+// send - sends an event to the SQS queue.
+func (target *SQSTarget) send(eventData event.Event) error {
+	objectName, err := url.QueryUnescape(eventData.S3.Object.Key)
+	if err != nil {
+		return err
+	}
+	key := eventData.S3.Bucket.Name + "/" + objectName
+
+	data, err := json.Marshal(event.Log{EventName: eventData.EventName, Key: key, Records: []event.Event{eventData}})
+	if err != nil {
+		return err
+	}
+
+	params := url.Values{
+		"Action":      {"SendMessage"},
+		"MessageBody": {string(data)},
+		"Version":     {sqsAPIVersion},
+	}
+	if err = postAWSQuery(context.Background(), target.httpClient, target.args.QueueURL, "sqs", target.credentials(), params); err != nil {
+		target.Close()
+	}
+	return err
+}
+
+// Send - reads an event from store and sends it to SQS.
+func (target *SQSTarget) Send(eventKey string) error {
+	eventData, eErr := target.store.Get(eventKey)
+	if eErr != nil {
+		// The last event key in a successful batch will be sent in the channel atmost once by the replayEvents()
+		// Such events will not exist and would've been already been sent successfully.
+		if os.IsNotExist(eErr) {
+			return nil
+		}
+		return eErr
+	}
+
+	if err := target.send(eventData); err != nil {
+		if xnet.IsNetworkOrHostDown(err, false) {
+			return errNotConnected
+		}
+		return err
+	}
+
+	// Delete the event from store.
+	return target.store.Del(eventKey)
+}
+
+// Close - closes idle connections to the SQS endpoint.
+func (target *SQSTarget) Close() error {
+	target.httpClient.CloseIdleConnections()
+	return nil
+}
+
+// NewSQSTarget - creates new SQS target.
+func NewSQSTarget(ctx context.Context, id string, args SQSArgs, loggerOnce func(ctx context.Context, err error, id interface{}, kind ...interface{}), transport *http.Transport, test bool) (*SQSTarget, error) {
+	var store Store
+	target := &SQSTarget{
+		id:         event.TargetID{ID: id, Name: "sqs"},
+		args:       args,
+		httpClient: &http.Client{Transport: transport},
+		loggerOnce: loggerOnce,
+	}
+
+	if args.QueueDir != "" {
+		queueDir := filepath.Join(args.QueueDir, storePrefix+"-sqs-"+id)
+		store = NewQueueStore(queueDir, args.QueueLimit)
+		if err := store.Open(); err != nil {
+			target.loggerOnce(context.Background(), err, target.ID())
+			return target, err
+		}
+		target.store = store
+	}
+
+	_, err := target.IsActive()
+	if err != nil {
+		if target.store == nil || err != errNotConnected {
+			target.loggerOnce(ctx, err, target.ID())
+			return target, err
+		}
+	}
+
+	if target.store != nil && !test {
+		// Replays the events from the store.
+		eventKeyCh := replayEvents(target.store, ctx.Done(), target.loggerOnce, target.ID())
+		// Start replaying events from the store.
+		go sendEvents(target, target.store, eventKeyCh, ctx.Done(), target.loggerOnce)
+	}
+
+	return target, nil
+}
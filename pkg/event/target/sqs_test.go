@@ -0,0 +1,101 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package target
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"minio/pkg/event"
+	xnet "minio/pkg/net"
+)
+
+func TestSQSTargetSave(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		messages []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=minio/") {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`<ErrorResponse><Error><Code>InvalidClientTokenId</Code><Message>denied</Message></Error></ErrorResponse>`))
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.Form.Get("Action") == "SendMessage" {
+			mu.Lock()
+			messages = append(messages, r.Form.Get("MessageBody"))
+			mu.Unlock()
+		}
+	}))
+	defer server.Close()
+
+	queueURL, err := xnet.ParseHTTPURL(server.URL + "/queue/events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	args := SQSArgs{
+		Enable:    true,
+		QueueURL:  *queueURL,
+		Region:    "us-east-1",
+		AccessKey: "minio",
+		SecretKey: "minio123",
+	}
+	if err = args.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	logOnce := func(ctx context.Context, err error, id interface{}, kind ...interface{}) {}
+	target, err := NewSQSTarget(context.Background(), "1", args, logOnce, &http.Transport{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer target.Close()
+
+	eventData := event.Event{EventName: event.ObjectCreatedPut}
+	eventData.S3.Bucket.Name = "bucket"
+	eventData.S3.Object.Key = "object"
+	if err = target.Save(eventData); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(messages) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(messages))
+	}
+	var log event.Log
+	if err = json.Unmarshal([]byte(messages[0]), &log); err != nil {
+		t.Fatal(err)
+	}
+	if log.Key != "bucket/object" || len(log.Records) != 1 {
+		t.Fatalf("unexpected message %s", messages[0])
+	}
+
+	args.AccessKey, args.SecretKey = "wrong", "wrong"
+	if _, err = NewSQSTarget(context.Background(), "2", args, logOnce, &http.Transport{}, true); err == nil || !strings.Contains(err.Error(), "InvalidClientTokenId") {
+		t.Fatalf("expected InvalidClientTokenId error, got %v", err)
+	}
+}
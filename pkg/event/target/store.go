@@ -29,6 +29,11 @@ import (
 
 const retryInterval = 3 * time.Second
 
+// sendRetryBudget - number of times sending a queued event may fail,
+// for reasons other than the target being offline, before the event is
+// moved to the dead-letter directory.
+const sendRetryBudget = 100
+
 // errNotConnected - indicates that the target connection is not active.
 var errNotConnected = errors.New("not connected to target server/service")
 
@@ -42,6 +47,12 @@ type Store interface {
 	List() ([]string, error)
 	Del(key string) error
 	Open() error
+	// DeadLetter - moves an event which exceeded its retry budget out
+	// of the queue.
+	DeadLetter(key string) error
+	// SendFailed - counts a failed attempt to send a queued event.
+	SendFailed()
+	event.TargetStore
 }
 
 // replayEvents - Reads the events from the store and replays.
@@ -97,21 +108,35 @@ func IsConnResetErr(err error) bool {
 }
 
 // sendEvents - Reads events from the store and re-plays.
-func sendEvents(target event.Target, eventKeyCh <-chan string, doneCh <-chan struct{}, loggerOnce func(ctx context.Context, err error, id interface{}, kind ...interface{})) {
+func sendEvents(target event.Target, store Store, eventKeyCh <-chan string, doneCh <-chan struct{}, loggerOnce func(ctx context.Context, err error, id interface{}, kind ...interface{})) {
 	retryTicker := time.NewTicker(retryInterval)
 	defer retryTicker.Stop()
 
 	send := func(eventKey string) bool {
+		var attempts int
 		for {
 			err := target.Send(eventKey)
 			if err == nil {
 				break
 			}
+			store.SendFailed()
 
 			if err != errNotConnected && !IsConnResetErr(err) {
 				loggerOnce(context.Background(),
 					fmt.Errorf("target.Send() failed with '%w'", err),
 					target.ID())
+
+				// Only failures of an online target count
+				// towards the retry budget.
+				attempts++
+				if attempts >= sendRetryBudget {
+					if dErr := store.DeadLetter(eventKey); dErr != nil {
+						loggerOnce(context.Background(),
+							fmt.Errorf("store.DeadLetter() failed with '%w'", dErr),
+							target.ID())
+					}
+					break
+				}
 			}
 
 			// Retrying after 3secs back-off
@@ -50,7 +50,7 @@ func (tid *TargetID) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	targetID, err := parseTargetID(s)
+	targetID, err := ParseTargetID(s)
 	if err != nil {
 		return err
 	}
@@ -59,8 +59,8 @@ func (tid *TargetID) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// parseTargetID - parses string to TargetID.
-func parseTargetID(s string) (*TargetID, error) {
+// ParseTargetID - parses string to TargetID.
+func ParseTargetID(s string) (*TargetID, error) {
 	tokens := strings.Split(s, ":")
 	if len(tokens) != 2 {
 		return nil, fmt.Errorf("invalid TargetID format '%v'", s)
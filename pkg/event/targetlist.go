@@ -19,6 +19,7 @@ package event
 import (
 	"fmt"
 	"sync"
+	"sync/atomic"
 )
 
 // Target - event target interface
@@ -29,12 +30,20 @@ type Target interface {
 	Send(string) error
 	Close() error
 	HasQueueStore() bool
+	Store() TargetStore
+}
+
+// targetCounters - delivery counters of a target without queue store.
+type targetCounters struct {
+	sent   uint64
+	failed uint64
 }
 
 // TargetList - holds list of targets indexed by target ID.
 type TargetList struct {
 	sync.RWMutex
-	targets map[TargetID]Target
+	targets  map[TargetID]Target
+	counters map[TargetID]*targetCounters
 }
 
 // Add - adds unique target to target list.
@@ -47,6 +56,7 @@ func (list *TargetList) Add(targets ...Target) error {
 			return fmt.Errorf("target %v already exists", target.ID())
 		}
 		list.targets[target.ID()] = target
+		list.counters[target.ID()] = &targetCounters{}
 	}
 
 	return nil
@@ -80,6 +90,7 @@ func (list *TargetList) Remove(targetIDSet TargetIDSet) {
 		if ok {
 			target.Close()
 			delete(list.targets, id)
+			delete(list.counters, id)
 		}
 	}
 }
@@ -128,15 +139,26 @@ func (list *TargetList) Send(event Event, targetIDset TargetIDSet, resCh chan<-
 		for id := range targetIDset {
 			list.RLock()
 			target, ok := list.targets[id]
+			counters := list.counters[id]
 			list.RUnlock()
 			if ok {
 				wg.Add(1)
 				go func(id TargetID, target Target) {
 					defer wg.Done()
 					tgtRes := TargetIDResult{ID: id}
-					if err := target.Save(event); err != nil {
+					err := target.Save(event)
+					if err != nil {
 						tgtRes.Err = err
 					}
+					// Targets with a queue store count their own
+					// deliveries.
+					if !target.HasQueueStore() {
+						if err != nil {
+							atomic.AddUint64(&counters.failed, 1)
+						} else {
+							atomic.AddUint64(&counters.sent, 1)
+						}
+					}
 					resCh <- tgtRes
 				}(id, target)
 			} else {
@@ -147,7 +169,32 @@ func (list *TargetList) Send(event Event, targetIDset TargetIDSet, resCh chan<-
 	}()
 }
 
+// Stats - returns delivery statistics of all targets.
+func (list *TargetList) Stats() map[TargetID]TargetStats {
+	list.RLock()
+	defer list.RUnlock()
+
+	stats := make(map[TargetID]TargetStats, len(list.targets))
+	for id, target := range list.targets {
+		if store := target.Store(); store != nil {
+			stats[id] = store.Stats()
+			continue
+		}
+		counters := list.counters[id]
+		failed := atomic.LoadUint64(&counters.failed)
+		stats[id] = TargetStats{
+			Sent:    atomic.LoadUint64(&counters.sent),
+			Failed:  failed,
+			Dropped: failed,
+		}
+	}
+	return stats
+}
+
 // NewTargetList - creates TargetList.
 func NewTargetList() *TargetList {
-	return &TargetList{targets: make(map[TargetID]Target)}
+	return &TargetList{
+		targets:  make(map[TargetID]Target),
+		counters: make(map[TargetID]*targetCounters),
+	}
 }
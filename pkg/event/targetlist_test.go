@@ -76,6 +76,11 @@ func (target ExampleTarget) HasQueueStore() bool {
 	return false
 }
 
+// Store - No-Op. Added for interface compatibility
+func (target ExampleTarget) Store() TargetStore {
+	return nil
+}
+
 func TestTargetListAdd(t *testing.T) {
 	targetListCase1 := NewTargetList()
 
@@ -0,0 +1,65 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package event
+
+import (
+	"time"
+)
+
+// TargetStats - delivery statistics of a target.
+type TargetStats struct {
+	// Queued - number of events waiting in the queue store.
+	Queued int `json:"queued"`
+	// DeadLettered - number of events moved to the dead-letter
+	// directory after exceeding their retry budget.
+	DeadLettered int `json:"deadLettered"`
+	// OldestEvent - time the oldest queued event was stored, zero if
+	// the queue is empty.
+	OldestEvent time.Time `json:"oldestEvent,omitempty"`
+
+	// Sent - number of events delivered.
+	Sent uint64 `json:"sent"`
+	// Failed - number of failed delivery attempts.
+	Failed uint64 `json:"failed"`
+	// Dropped - number of events discarded without being delivered,
+	// i.e. when the queue store is full or when delivery failed and
+	// no queue store is configured.
+	Dropped uint64 `json:"dropped"`
+}
+
+// QueuedEvent - an undelivered event in the queue store of a target.
+type QueuedEvent struct {
+	Key      string    `json:"key"`
+	QueuedAt time.Time `json:"queuedAt"`
+	Event    Event     `json:"event"`
+}
+
+// TargetStore - queue store of a target persisting undelivered events.
+type TargetStore interface {
+	// Stats - returns the queue length and the delivery statistics of
+	// the events sent from the store.
+	Stats() TargetStats
+	// Events - lists up to max queued, or dead-lettered, events in the
+	// order they were stored. All events are listed if max <= 0.
+	Events(deadLetter bool, max int) ([]QueuedEvent, error)
+	// Replay - moves dead-lettered events back to the queue, returns
+	// the number of events moved.
+	Replay() (int, error)
+	// Purge - deletes all queued, or dead-lettered, events, returns
+	// the number of events deleted.
+	Purge(deadLetter bool) (int, error)
+}
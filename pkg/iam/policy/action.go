@@ -183,6 +183,17 @@ const (
 
 	// AllActions - all API actions
 	AllActions = "s3:*"
+
+	// KMSGenerateKeyAction - generate a data key with a KMS master key to
+	// encrypt an object using SSE-KMS.
+	KMSGenerateKeyAction = "kms:GenerateKey"
+
+	// KMSDecryptAction - decrypt the data key of an SSE-KMS encrypted
+	// object with its KMS master key.
+	KMSDecryptAction = "kms:Decrypt"
+
+	// AllKMSActions - all KMS key usage actions
+	AllKMSActions = "kms:*"
 )
 
 // List of all supported actions.
@@ -239,6 +250,9 @@ var supportedActions = map[Action]struct{}{
 	ReplicateTagsAction:                    {},
 	GetObjectVersionForReplicationAction:   {},
 	AllActions:                             {},
+	KMSGenerateKeyAction:                   {},
+	KMSDecryptAction:                       {},
+	AllKMSActions:                          {},
 }
 
 // List of all supported object actions.
@@ -266,6 +280,9 @@ var supportedObjectActions = map[Action]struct{}{
 	ReplicateDeleteAction:                {},
 	ReplicateTagsAction:                  {},
 	GetObjectVersionForReplicationAction: {},
+	KMSGenerateKeyAction:                 {},
+	KMSDecryptAction:                     {},
+	AllKMSActions:                        {},
 }
 
 // isObjectAction - returns whether action is object type or not.
@@ -278,6 +295,11 @@ func (action Action) isObjectAction() bool {
 	return false
 }
 
+// isKMSAction - returns whether action is a KMS key usage action or not.
+func (action Action) isKMSAction() bool {
+	return Action(AllKMSActions).Match(action)
+}
+
 // Match - matches action name with action patter.
 func (action Action) Match(a Action) bool {
 	return wildcard.Match(string(action), string(a))
@@ -309,6 +331,21 @@ func (a actionConditionKeyMap) Lookup(action Action) condition.KeySet {
 var iamActionConditionKeyMap = actionConditionKeyMap{
 	AllActions: condition.NewKeySet(condition.AllSupportedKeys...),
 
+	KMSGenerateKeyAction: condition.NewKeySet(
+		append([]condition.Key{
+			condition.S3XAmzServerSideEncryptionAwsKMSKeyID,
+		}, condition.CommonKeys...)...),
+
+	KMSDecryptAction: condition.NewKeySet(
+		append([]condition.Key{
+			condition.S3XAmzServerSideEncryptionAwsKMSKeyID,
+		}, condition.CommonKeys...)...),
+
+	AllKMSActions: condition.NewKeySet(
+		append([]condition.Key{
+			condition.S3XAmzServerSideEncryptionAwsKMSKeyID,
+		}, condition.CommonKeys...)...),
+
 	GetObjectAction: condition.NewKeySet(
 		append([]condition.Key{
 			condition.S3XAmzServerSideEncryption,
@@ -340,6 +377,7 @@ var iamActionConditionKeyMap = actionConditionKeyMap{
 			condition.S3XAmzCopySource,
 			condition.S3XAmzServerSideEncryption,
 			condition.S3XAmzServerSideEncryptionCustomerAlgorithm,
+			condition.S3XAmzServerSideEncryptionAwsKMSKeyID,
 			condition.S3XAmzMetadataDirective,
 			condition.S3XAmzStorageClass,
 			condition.S3VersionID,
@@ -49,6 +49,16 @@ const (
 	KMSCreateKeyAdminAction = "admin:KMSCreateKey"
 	// KMSKeyStatusAdminAction - allow getting KMS key status
 	KMSKeyStatusAdminAction = "admin:KMSKeyStatus"
+	// KMSRotateKeyAdminAction - allow re-sealing object keys with the current KMS master key version
+	KMSRotateKeyAdminAction = "admin:KMSRotateKey"
+	// KMSMigrateConfigAdminAction - allow re-encrypting the server config, IAM data and bucket metadata with the KMS
+	KMSMigrateConfigAdminAction = "admin:KMSMigrateConfig"
+	// KMSListKeysAdminAction - allow listing KMS master keys
+	KMSListKeysAdminAction = "admin:KMSListKeys"
+	// KMSDeleteKeyAdminAction - allow deleting a KMS master key
+	KMSDeleteKeyAdminAction = "admin:KMSDeleteKey"
+	// KMSImportKeyAdminAction - allow importing external key material as KMS master key
+	KMSImportKeyAdminAction = "admin:KMSImportKey"
 	// ServerInfoAdminAction - allow listing server info
 	ServerInfoAdminAction = "admin:ServerInfo"
 	// HealthInfoAdminAction - allow obtaining cluster health information
@@ -125,6 +135,13 @@ const (
 	// GetBucketQuotaAdminAction - allow getting bucket quota
 	GetBucketQuotaAdminAction = "admin:GetBucketQuota"
 
+	// Bucket storage class Actions
+
+	// SetBucketStorageClassAdminAction - allow setting the default storage classes of a bucket
+	SetBucketStorageClassAdminAction = "admin:SetBucketStorageClass"
+	// GetBucketStorageClassAdminAction - allow getting the default storage classes of a bucket
+	GetBucketStorageClassAdminAction = "admin:GetBucketStorageClass"
+
 	// Bucket Target admin Actions
 
 	// SetBucketTargetAction - allow setting bucket target
@@ -138,48 +155,56 @@ const (
 
 // List of all supported admin actions.
 var supportedAdminActions = map[AdminAction]struct{}{
-	HealAdminAction:                 {},
-	StorageInfoAdminAction:          {},
-	DataUsageInfoAdminAction:        {},
-	TopLocksAdminAction:             {},
-	ProfilingAdminAction:            {},
-	PrometheusAdminAction:           {},
-	TraceAdminAction:                {},
-	ConsoleLogAdminAction:           {},
-	KMSKeyStatusAdminAction:         {},
-	ServerInfoAdminAction:           {},
-	HealthInfoAdminAction:           {},
-	BandwidthMonitorAction:          {},
-	ServerUpdateAdminAction:         {},
-	ServiceRestartAdminAction:       {},
-	ServiceStopAdminAction:          {},
-	ConfigUpdateAdminAction:         {},
-	CreateUserAdminAction:           {},
-	DeleteUserAdminAction:           {},
-	ListUsersAdminAction:            {},
-	EnableUserAdminAction:           {},
-	DisableUserAdminAction:          {},
-	GetUserAdminAction:              {},
-	AddUserToGroupAdminAction:       {},
-	RemoveUserFromGroupAdminAction:  {},
-	GetGroupAdminAction:             {},
-	ListGroupsAdminAction:           {},
-	EnableGroupAdminAction:          {},
-	DisableGroupAdminAction:         {},
-	CreateServiceAccountAdminAction: {},
-	UpdateServiceAccountAdminAction: {},
-	RemoveServiceAccountAdminAction: {},
-	ListServiceAccountsAdminAction:  {},
-	CreatePolicyAdminAction:         {},
-	DeletePolicyAdminAction:         {},
-	GetPolicyAdminAction:            {},
-	AttachPolicyAdminAction:         {},
-	ListUserPoliciesAdminAction:     {},
-	SetBucketQuotaAdminAction:       {},
-	GetBucketQuotaAdminAction:       {},
-	SetBucketTargetAction:           {},
-	GetBucketTargetAction:           {},
-	AllAdminActions:                 {},
+	HealAdminAction:                  {},
+	StorageInfoAdminAction:           {},
+	DataUsageInfoAdminAction:         {},
+	TopLocksAdminAction:              {},
+	ProfilingAdminAction:             {},
+	PrometheusAdminAction:            {},
+	TraceAdminAction:                 {},
+	ConsoleLogAdminAction:            {},
+	KMSCreateKeyAdminAction:          {},
+	KMSKeyStatusAdminAction:          {},
+	KMSRotateKeyAdminAction:          {},
+	KMSMigrateConfigAdminAction:      {},
+	KMSListKeysAdminAction:           {},
+	KMSDeleteKeyAdminAction:          {},
+	KMSImportKeyAdminAction:          {},
+	ServerInfoAdminAction:            {},
+	HealthInfoAdminAction:            {},
+	BandwidthMonitorAction:           {},
+	ServerUpdateAdminAction:          {},
+	ServiceRestartAdminAction:        {},
+	ServiceStopAdminAction:           {},
+	ConfigUpdateAdminAction:          {},
+	CreateUserAdminAction:            {},
+	DeleteUserAdminAction:            {},
+	ListUsersAdminAction:             {},
+	EnableUserAdminAction:            {},
+	DisableUserAdminAction:           {},
+	GetUserAdminAction:               {},
+	AddUserToGroupAdminAction:        {},
+	RemoveUserFromGroupAdminAction:   {},
+	GetGroupAdminAction:              {},
+	ListGroupsAdminAction:            {},
+	EnableGroupAdminAction:           {},
+	DisableGroupAdminAction:          {},
+	CreateServiceAccountAdminAction:  {},
+	UpdateServiceAccountAdminAction:  {},
+	RemoveServiceAccountAdminAction:  {},
+	ListServiceAccountsAdminAction:   {},
+	CreatePolicyAdminAction:          {},
+	DeletePolicyAdminAction:          {},
+	GetPolicyAdminAction:             {},
+	AttachPolicyAdminAction:          {},
+	ListUserPoliciesAdminAction:      {},
+	SetBucketQuotaAdminAction:        {},
+	GetBucketQuotaAdminAction:        {},
+	SetBucketStorageClassAdminAction: {},
+	GetBucketStorageClassAdminAction: {},
+	SetBucketTargetAction:            {},
+	GetBucketTargetAction:            {},
+	AllAdminActions:                  {},
 }
 
 // IsValid - checks if action is valid or not.
@@ -201,7 +226,13 @@ var adminActionConditionKeyMap = map[Action]condition.KeySet{
 	ProfilingAdminAction:            condition.NewKeySet(condition.AllSupportedAdminKeys...),
 	TraceAdminAction:                condition.NewKeySet(condition.AllSupportedAdminKeys...),
 	ConsoleLogAdminAction:           condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	KMSCreateKeyAdminAction:         condition.NewKeySet(condition.AllSupportedAdminKeys...),
 	KMSKeyStatusAdminAction:         condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	KMSRotateKeyAdminAction:         condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	KMSMigrateConfigAdminAction:     condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	KMSListKeysAdminAction:          condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	KMSDeleteKeyAdminAction:         condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	KMSImportKeyAdminAction:         condition.NewKeySet(condition.AllSupportedAdminKeys...),
 	ServerUpdateAdminAction:         condition.NewKeySet(condition.AllSupportedAdminKeys...),
 	ServiceRestartAdminAction:       condition.NewKeySet(condition.AllSupportedAdminKeys...),
 	ServiceStopAdminAction:          condition.NewKeySet(condition.AllSupportedAdminKeys...),
@@ -222,13 +253,15 @@ var adminActionConditionKeyMap = map[Action]condition.KeySet{
 	RemoveServiceAccountAdminAction: condition.NewKeySet(condition.AllSupportedAdminKeys...),
 	ListServiceAccountsAdminAction:  condition.NewKeySet(condition.AllSupportedAdminKeys...),
 
-	CreatePolicyAdminAction:     condition.NewKeySet(condition.AllSupportedAdminKeys...),
-	DeletePolicyAdminAction:     condition.NewKeySet(condition.AllSupportedAdminKeys...),
-	GetPolicyAdminAction:        condition.NewKeySet(condition.AllSupportedAdminKeys...),
-	AttachPolicyAdminAction:     condition.NewKeySet(condition.AllSupportedAdminKeys...),
-	ListUserPoliciesAdminAction: condition.NewKeySet(condition.AllSupportedAdminKeys...),
-	SetBucketQuotaAdminAction:   condition.NewKeySet(condition.AllSupportedAdminKeys...),
-	GetBucketQuotaAdminAction:   condition.NewKeySet(condition.AllSupportedAdminKeys...),
-	SetBucketTargetAction:       condition.NewKeySet(condition.AllSupportedAdminKeys...),
-	GetBucketTargetAction:       condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	CreatePolicyAdminAction:          condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	DeletePolicyAdminAction:          condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	GetPolicyAdminAction:             condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	AttachPolicyAdminAction:          condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	ListUserPoliciesAdminAction:      condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	SetBucketQuotaAdminAction:        condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	GetBucketQuotaAdminAction:        condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	SetBucketStorageClassAdminAction: condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	GetBucketStorageClassAdminAction: condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	SetBucketTargetAction:            condition.NewKeySet(condition.AllSupportedAdminKeys...),
+	GetBucketTargetAction:            condition.NewKeySet(condition.AllSupportedAdminKeys...),
 }
@@ -34,7 +34,7 @@ var ReadWrite = Policy{
 		{
 			SID:       policy.ID(""),
 			Effect:    policy.Allow,
-			Actions:   NewActionSet(AllActions),
+			Actions:   NewActionSet(AllActions, AllKMSActions),
 			Resources: NewResourceSet(NewResource("*", "")),
 		},
 	},
@@ -47,7 +47,7 @@ var ReadOnly = Policy{
 		{
 			SID:       policy.ID(""),
 			Effect:    policy.Allow,
-			Actions:   NewActionSet(GetBucketLocationAction, GetObjectAction),
+			Actions:   NewActionSet(GetBucketLocationAction, GetObjectAction, KMSDecryptAction),
 			Resources: NewResourceSet(NewResource("*", "")),
 		},
 	},
@@ -60,7 +60,7 @@ var WriteOnly = Policy{
 		{
 			SID:       policy.ID(""),
 			Effect:    policy.Allow,
-			Actions:   NewActionSet(PutObjectAction),
+			Actions:   NewActionSet(PutObjectAction, KMSGenerateKeyAction),
 			Resources: NewResourceSet(NewResource("*", "")),
 		},
 	},
@@ -98,7 +98,7 @@ var Admin = Policy{
 		{
 			SID:        policy.ID(""),
 			Effect:     policy.Allow,
-			Actions:    NewActionSet(AllActions),
+			Actions:    NewActionSet(AllActions, AllKMSActions),
 			Resources:  NewResourceSet(NewResource("*", "")),
 			Conditions: condition.NewFunctions(),
 		},
@@ -99,6 +99,12 @@ type Policy struct {
 
 // IsAllowed - checks given policy args is allowed to continue the Rest API.
 func (iamp Policy) IsAllowed(args Args) bool {
+	// A policy which does not name any KMS action does not restrict
+	// the use of KMS master keys, its S3 actions imply them.
+	if args.Action.isKMSAction() && !iamp.hasKMSActions() {
+		return true
+	}
+
 	// Check all deny statements. If any one statement denies, return false.
 	for _, statement := range iamp.Statements {
 		if statement.Effect == policy.Deny {
@@ -134,6 +140,19 @@ func (iamp Policy) IsAllowed(args Args) bool {
 	return false
 }
 
+// hasKMSActions - returns whether any statement of the policy names
+// a KMS action.
+func (iamp Policy) hasKMSActions() bool {
+	for _, statement := range iamp.Statements {
+		for action := range statement.Actions {
+			if action.isKMSAction() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // IsEmpty - returns whether policy is empty or not.
 func (iamp Policy) IsEmpty() bool {
 	return len(iamp.Statements) == 0
@@ -17,6 +17,7 @@
 package iampolicy
 
 import (
+	"bytes"
 	"encoding/json"
 	"net"
 	"reflect"
@@ -226,6 +227,77 @@ func TestPolicyIsAllowed(t *testing.T) {
 	}
 }
 
+func TestPolicyIsAllowedKMSKeyID(t *testing.T) {
+	data := []byte(`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["kms:GenerateKey", "kms:Decrypt"],
+      "Resource": ["arn:aws:s3:::mybucket/*"],
+      "Condition": {
+        "StringEquals": {
+          "s3:x-amz-server-side-encryption-aws-kms-key-id": ["tenant-a-key"]
+        }
+      }
+    }
+  ]
+}`)
+	p, err := ParseConfig(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	args := func(action Action, bucket, keyID string) Args {
+		return Args{
+			AccountName:     "Q3AM3UQ867SPQQA43P2F",
+			Action:          action,
+			BucketName:      bucket,
+			ConditionValues: map[string][]string{"X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id": {keyID}},
+			ObjectName:      "myobject",
+		}
+	}
+	testCases := []struct {
+		args           Args
+		expectedResult bool
+	}{
+		{args(KMSGenerateKeyAction, "mybucket", "tenant-a-key"), true},
+		{args(KMSDecryptAction, "mybucket", "tenant-a-key"), true},
+		{args(KMSDecryptAction, "mybucket", "tenant-b-key"), false},
+		{args(KMSDecryptAction, "yourbucket", "tenant-a-key"), false},
+		{args(GetObjectAction, "mybucket", "tenant-a-key"), false},
+	}
+	for i, testCase := range testCases {
+		if result := p.IsAllowed(testCase.args); result != testCase.expectedResult {
+			t.Errorf("case %v: expected: %v, got: %v\n", i+1, testCase.expectedResult, result)
+		}
+	}
+
+	// Canned policies grant key usage along with their S3 actions.
+	if !ReadWrite.IsAllowed(args(KMSDecryptAction, "mybucket", "tenant-a-key")) {
+		t.Error("readwrite policy does not allow kms:Decrypt")
+	}
+	if WriteOnly.IsAllowed(args(KMSDecryptAction, "mybucket", "tenant-a-key")) {
+		t.Error("writeonly policy allows kms:Decrypt")
+	}
+
+	// Policies without any KMS action do not restrict key usage.
+	s3Only := Policy{
+		Version: DefaultVersion,
+		Statements: []Statement{
+			NewStatement(
+				policy.Allow,
+				NewActionSet(GetObjectAction),
+				NewResourceSet(NewResource("mybucket", "/*")),
+				condition.NewFunctions(),
+			),
+		},
+	}
+	if !s3Only.IsAllowed(args(KMSDecryptAction, "yourbucket", "tenant-b-key")) {
+		t.Error("policy without KMS actions denies kms:Decrypt")
+	}
+}
+
 func TestPolicyIsEmpty(t *testing.T) {
 	case1Policy := Policy{
 		Version: DefaultVersion,
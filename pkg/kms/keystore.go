@@ -0,0 +1,512 @@
+// MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kms
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/secure-io/sio-go/sioutil"
+
+	"minio/pkg/lock"
+)
+
+// NewKeyStore returns a KMS that uses the named master keys
+// stored in the given file. The file is created if it does
+// not exist.
+//
+// The defaultKeyID is used when no explicit key ID is specified.
+// It must not be empty.
+//
+// Keys created or rotated by the KMS are written to the file. The
+// file is re-read whenever it has been modified such that it can
+// be shared by multiple servers. Changes are serialized by an
+// advisory lock on the file with the ".lock" suffix and merged
+// with the keys on disk, so versions are never lost.
+func NewKeyStore(filename, defaultKeyID string) (KMS, error) {
+	if defaultKeyID == "" {
+		return nil, errors.New("kms: missing default key ID")
+	}
+	store := &keyStore{
+		filename:     filename,
+		defaultKeyID: defaultKeyID,
+		keys:         map[string][]keyVersion{},
+		deleted:      map[string]time.Time{},
+	}
+
+	store.lock.Lock()
+	defer store.lock.Unlock()
+
+	unlock, err := store.lockFile()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if _, err = os.Stat(filename); os.IsNotExist(err) {
+		if err = store.write(); err != nil {
+			return nil, err
+		}
+	}
+	return store, nil
+}
+
+// keyStore is a KMS implementation that keeps multiple
+// named and versioned master keys in a file.
+type keyStore struct {
+	filename     string
+	defaultKeyID string
+
+	lock     sync.Mutex
+	fileInfo os.FileInfo             // file the keys were last read from or written to
+	keys     map[string][]keyVersion // sorted by version
+	deleted  map[string]time.Time    // deletion time of deleted keys
+}
+
+var (
+	_ KMS         = (*keyStore)(nil) // compiler check
+	_ KeyRotator  = (*keyStore)(nil)
+	_ KeyLister   = (*keyStore)(nil)
+	_ KeyDeleter  = (*keyStore)(nil)
+	_ KeyImporter = (*keyStore)(nil)
+)
+
+// keyVersion is a version of a master key.
+type keyVersion struct {
+	Version int       `json:"version"`
+	Created time.Time `json:"created"`
+	Bytes   []byte    `json:"bytes"`
+}
+
+// keyStoreFile is the on-disk format of a keyStore.
+type keyStoreFile struct {
+	Keys map[string][]keyVersion `json:"keys"`
+
+	// Deleted keys are kept such that other servers sharing
+	// the file do not write them back.
+	Deleted map[string]time.Time `json:"deleted,omitempty"`
+}
+
+// keyStoreCiphertext is the ciphertext of a DEK. It contains
+// the version of the master key used to encrypt the DEK.
+type keyStoreCiphertext struct {
+	Version    int             `json:"version"`
+	Ciphertext json.RawMessage `json:"ciphertext"`
+}
+
+func (kms *keyStore) Stat() (Status, error) {
+	return Status{
+		Name:       "KeyStore",
+		Endpoints:  []string{kms.filename},
+		DefaultKey: kms.defaultKeyID,
+	}, nil
+}
+
+func (kms *keyStore) CreateKey(keyID string) error {
+	if keyID == "" {
+		return errors.New("kms: key ID is empty")
+	}
+
+	kms.lock.Lock()
+	defer kms.lock.Unlock()
+
+	unlock, err := kms.lockFile()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if _, ok := kms.keys[keyID]; ok {
+		return ErrKeyExists
+	}
+	return kms.addVersion(keyID)
+}
+
+// RotateKey adds a new version to the key referenced by the
+// key ID. New DEKs are encrypted with the new version.
+func (kms *keyStore) RotateKey(keyID string) error {
+	kms.lock.Lock()
+	defer kms.lock.Unlock()
+
+	unlock, err := kms.lockFile()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if _, ok := kms.keys[keyID]; !ok {
+		return fmt.Errorf("kms: key %q does not exist", keyID)
+	}
+	return kms.addVersion(keyID)
+}
+
+// ListKeys returns all keys whose key ID matches the pattern.
+// The creation time of a key is the creation time of its
+// first version.
+func (kms *keyStore) ListKeys(pattern string) ([]KeyInfo, error) {
+	kms.lock.Lock()
+	defer kms.lock.Unlock()
+
+	if err := kms.reload(); err != nil {
+		return nil, err
+	}
+	var keys []KeyInfo
+	for keyID, versions := range kms.keys {
+		if pattern != "" {
+			if ok, err := path.Match(pattern, keyID); err != nil {
+				return nil, err
+			} else if !ok {
+				continue
+			}
+		}
+		info := KeyInfo{KeyID: keyID, Versions: len(versions)}
+		if len(versions) > 0 {
+			info.CreatedAt = versions[0].Created
+		}
+		keys = append(keys, info)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].KeyID < keys[j].KeyID })
+	return keys, nil
+}
+
+// DeleteKey removes all versions of the key referenced by
+// the key ID from the file.
+func (kms *keyStore) DeleteKey(keyID string) error {
+	kms.lock.Lock()
+	defer kms.lock.Unlock()
+
+	unlock, err := kms.lockFile()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	versions, ok := kms.keys[keyID]
+	if !ok {
+		return ErrKeyNotFound
+	}
+	deletedAt, wasDeleted := kms.deleted[keyID]
+	delete(kms.keys, keyID)
+	kms.deleted[keyID] = time.Now().UTC()
+	if err = kms.write(); err != nil {
+		kms.keys[keyID] = versions
+		if wasDeleted {
+			kms.deleted[keyID] = deletedAt
+		} else {
+			delete(kms.deleted, keyID)
+		}
+		return err
+	}
+	return nil
+}
+
+// ImportKey adds a new key with the given key material as
+// its first version.
+func (kms *keyStore) ImportKey(keyID string, key []byte) error {
+	if keyID == "" {
+		return errors.New("kms: key ID is empty")
+	}
+	if len(key) != 32 {
+		return fmt.Errorf("kms: invalid key length %d", len(key))
+	}
+
+	kms.lock.Lock()
+	defer kms.lock.Unlock()
+
+	unlock, err := kms.lockFile()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if _, ok := kms.keys[keyID]; ok {
+		return ErrKeyExists
+	}
+	kms.keys[keyID] = []keyVersion{{
+		Version: 1,
+		Created: time.Now().UTC(),
+		Bytes:   append([]byte(nil), key...),
+	}}
+	if err = kms.write(); err != nil {
+		delete(kms.keys, keyID)
+		return err
+	}
+	return nil
+}
+
+func (kms *keyStore) GenerateKey(keyID string, context Context) (DEK, error) {
+	if keyID == "" {
+		keyID = kms.defaultKeyID
+	}
+	version, err := kms.lookup(keyID, 0)
+	if err != nil {
+		return DEK{}, err
+	}
+
+	key, err := New(keyID, version.Bytes)
+	if err != nil {
+		return DEK{}, err
+	}
+	dek, err := key.GenerateKey(keyID, context)
+	if err != nil {
+		return DEK{}, err
+	}
+	dek.Ciphertext, err = json.Marshal(keyStoreCiphertext{
+		Version:    version.Version,
+		Ciphertext: dek.Ciphertext,
+	})
+	if err != nil {
+		return DEK{}, err
+	}
+	return dek, nil
+}
+
+func (kms *keyStore) DecryptKey(keyID string, ciphertext []byte, context Context) ([]byte, error) {
+	var encryptedKey keyStoreCiphertext
+	if err := json.Unmarshal(ciphertext, &encryptedKey); err != nil {
+		return nil, err
+	}
+	if encryptedKey.Version <= 0 {
+		return nil, fmt.Errorf("kms: invalid key version %d", encryptedKey.Version)
+	}
+	version, err := kms.lookup(keyID, encryptedKey.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := New(keyID, version.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	return key.DecryptKey(keyID, encryptedKey.Ciphertext, context)
+}
+
+// lookup returns the given version of the key referenced by
+// the key ID, or the latest version if version is 0.
+func (kms *keyStore) lookup(keyID string, version int) (keyVersion, error) {
+	kms.lock.Lock()
+	defer kms.lock.Unlock()
+
+	find := func() (keyVersion, bool) {
+		versions := kms.keys[keyID]
+		if len(versions) == 0 {
+			return keyVersion{}, false
+		}
+		if version == 0 {
+			return versions[len(versions)-1], true
+		}
+		i := sort.Search(len(versions), func(i int) bool { return versions[i].Version >= version })
+		if i < len(versions) && versions[i].Version == version {
+			return versions[i], true
+		}
+		return keyVersion{}, false
+	}
+
+	if v, ok := find(); ok {
+		return v, nil
+	}
+	// The key may have been created or rotated
+	// by another server sharing the file.
+	if err := kms.reload(); err != nil {
+		return keyVersion{}, err
+	}
+	if v, ok := find(); ok {
+		return v, nil
+	}
+	if version == 0 {
+		return keyVersion{}, fmt.Errorf("kms: key %q does not exist", keyID)
+	}
+	return keyVersion{}, fmt.Errorf("kms: version %d of key %q does not exist", version, keyID)
+}
+
+// addVersion generates a new version of the key referenced by
+// the key ID and writes the keys to the file. Must be called
+// with the lock held.
+func (kms *keyStore) addVersion(keyID string) error {
+	bytes, err := sioutil.Random(32)
+	if err != nil {
+		return err
+	}
+
+	versions := kms.keys[keyID]
+	version := 1
+	if len(versions) > 0 {
+		version = versions[len(versions)-1].Version + 1
+	}
+	kms.keys[keyID] = append(versions, keyVersion{
+		Version: version,
+		Created: time.Now().UTC(),
+		Bytes:   bytes,
+	})
+	if err = kms.write(); err != nil {
+		kms.keys[keyID] = versions
+		return err
+	}
+	return nil
+}
+
+// lockFile acquires the advisory lock serializing changes of the
+// file by all servers sharing it and loads the keys from the file.
+// The returned function releases the lock. Must be called with the
+// lock held.
+func (kms *keyStore) lockFile() (func(), error) {
+	lk, err := lock.LockedOpenFile(kms.filename+".lock", os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	if err = kms.load(); err != nil {
+		lk.Close()
+		return nil, err
+	}
+	return func() { lk.Close() }, nil
+}
+
+// reload reads the keys from the file if it has been modified.
+// Every write replaces the file, so a file written by another
+// server within the resolution of the modification time is still
+// detected. Must be called with the lock held.
+func (kms *keyStore) reload() error {
+	fi, err := os.Stat(kms.filename)
+	if err != nil {
+		return err
+	}
+	if kms.fileInfo != nil && os.SameFile(fi, kms.fileInfo) && fi.ModTime().Equal(kms.fileInfo.ModTime()) {
+		return nil
+	}
+	return kms.load()
+}
+
+// load reads the keys from the file, if it exists, and merges
+// them with the keys in memory. Must be called with the lock held.
+func (kms *keyStore) load() error {
+	fi, err := os.Stat(kms.filename)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	data, err := ioutil.ReadFile(kms.filename)
+	if err != nil {
+		return err
+	}
+	var file keyStoreFile
+	if err = json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("kms: invalid keystore %q: %v", kms.filename, err)
+	}
+	for keyID, versions := range file.Keys {
+		for _, v := range versions {
+			if len(v.Bytes) != 32 {
+				return fmt.Errorf("kms: invalid length of version %d of key %q", v.Version, keyID)
+			}
+		}
+	}
+	kms.merge(file)
+	kms.fileInfo = fi
+	return nil
+}
+
+// merge merges the keys of the file into the keys in memory.
+// Versions only known in memory, e.g. because a concurrent write
+// replaced the file, are kept such that DEKs sealed with them
+// remain decryptable. Versions of keys deleted after they were
+// created are dropped. Must be called with the lock held.
+func (kms *keyStore) merge(file keyStoreFile) {
+	for keyID, deletedAt := range file.Deleted {
+		if kms.deleted[keyID].Before(deletedAt) {
+			kms.deleted[keyID] = deletedAt
+		}
+	}
+
+	keys := make(map[string][]keyVersion, len(file.Keys))
+	for keyID, versions := range file.Keys {
+		keys[keyID] = versions
+	}
+	for keyID, versions := range kms.keys {
+		deletedAt, deleted := kms.deleted[keyID]
+		for _, v := range versions {
+			if deleted && !v.Created.After(deletedAt) {
+				continue
+			}
+			if !hasVersion(keys[keyID], v.Version) {
+				keys[keyID] = append(keys[keyID], v)
+			}
+		}
+	}
+	for _, versions := range keys {
+		sort.Slice(versions, func(i, j int) bool { return versions[i].Version < versions[j].Version })
+	}
+	kms.keys = keys
+}
+
+// hasVersion returns true if versions contains the given version.
+func hasVersion(versions []keyVersion, version int) bool {
+	for _, v := range versions {
+		if v.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+// write replaces the file with the current keys. Must be
+// called with the lock held.
+func (kms *keyStore) write() error {
+	data, err := json.MarshalIndent(keyStoreFile{Keys: kms.keys, Deleted: kms.deleted}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := ioutil.TempFile(filepath.Dir(kms.filename), filepath.Base(kms.filename)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if err = tmpFile.Chmod(0600); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if _, err = tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err = tmpFile.Sync(); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err = tmpFile.Close(); err != nil {
+		return err
+	}
+	if err = os.Rename(tmpFile.Name(), kms.filename); err != nil {
+		return err
+	}
+
+	fi, err := os.Stat(kms.filename)
+	if err != nil {
+		return err
+	}
+	kms.fileInfo = fi
+	return nil
+}
@@ -0,0 +1,238 @@
+// MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kms
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestKeyStoreRoundtrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "minio-keystore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "keys.json")
+
+	KMS, err := NewKeyStore(filename, "my-key")
+	if err != nil {
+		t.Fatalf("Failed to initialize KMS: %v", err)
+	}
+	if _, err = KMS.GenerateKey("", Context{}); err == nil {
+		t.Fatal("Generated a key with a non-existing master key")
+	}
+	if err = KMS.CreateKey("my-key"); err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+	if err = KMS.CreateKey("my-key"); err != ErrKeyExists {
+		t.Fatalf("Expected %v, got %v", ErrKeyExists, err)
+	}
+
+	context := Context{"bucket": "object"}
+	key, err := KMS.GenerateKey("", context)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	if key.KeyID != "my-key" {
+		t.Fatalf("Expected the default key, got %q", key.KeyID)
+	}
+
+	// Keys encrypted with previous versions remain decryptable.
+	if err = KMS.(KeyRotator).RotateKey("my-key"); err != nil {
+		t.Fatalf("Failed to rotate key: %v", err)
+	}
+	rotatedKey, err := KMS.GenerateKey("my-key", context)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	// Another server sharing the file sees all versions.
+	KMS2, err := NewKeyStore(filename, "my-key")
+	if err != nil {
+		t.Fatalf("Failed to initialize KMS: %v", err)
+	}
+	for _, dek := range []DEK{key, rotatedKey} {
+		plaintext, err := KMS2.DecryptKey(dek.KeyID, dek.Ciphertext, context)
+		if err != nil {
+			t.Fatalf("Failed to decrypt key: %v", err)
+		}
+		if !bytes.Equal(dek.Plaintext, plaintext) {
+			t.Fatalf("Decrypted key does not match generated one: got %x - want %x", plaintext, dek.Plaintext)
+		}
+	}
+
+	if _, err = KMS2.DecryptKey(key.KeyID, key.Ciphertext, Context{"bucket": "other"}); err == nil {
+		t.Fatal("Decrypted key with a different context")
+	}
+	if _, err = KMS2.DecryptKey("other-key", key.Ciphertext, context); err == nil {
+		t.Fatal("Decrypted key with a non-existing master key")
+	}
+}
+
+func TestKeyStoreManageKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("", "minio-keystore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err = NewKeyStore(filepath.Join(dir, "keys.json"), ""); err == nil {
+		t.Fatal("Initialized KMS without a default key")
+	}
+	KMS, err := NewKeyStore(filepath.Join(dir, "keys.json"), "my-key")
+	if err != nil {
+		t.Fatalf("Failed to initialize KMS: %v", err)
+	}
+	if err = KMS.CreateKey("tenant-a"); err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+	material := bytes.Repeat([]byte{1}, 32)
+	if err = KMS.(KeyImporter).ImportKey("tenant-b", material); err != nil {
+		t.Fatalf("Failed to import key: %v", err)
+	}
+	if err = KMS.(KeyImporter).ImportKey("tenant-b", material); err != ErrKeyExists {
+		t.Fatalf("Expected %v, got %v", ErrKeyExists, err)
+	}
+	if err = KMS.(KeyImporter).ImportKey("short-key", material[:16]); err == nil {
+		t.Fatal("Imported a key with an invalid length")
+	}
+
+	// An imported key is used like any other key.
+	dek, err := KMS.GenerateKey("tenant-b", Context{})
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	imported, err := New("tenant-b", material)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ciphertext keyStoreCiphertext
+	if err = json.Unmarshal(dek.Ciphertext, &ciphertext); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = imported.DecryptKey("tenant-b", ciphertext.Ciphertext, Context{}); err != nil {
+		t.Fatalf("Imported key material has not been used: %v", err)
+	}
+
+	keys, err := KMS.(KeyLister).ListKeys("tenant-*")
+	if err != nil {
+		t.Fatalf("Failed to list keys: %v", err)
+	}
+	if len(keys) != 2 || keys[0].KeyID != "tenant-a" || keys[1].KeyID != "tenant-b" {
+		t.Fatalf("Unexpected keys: %v", keys)
+	}
+	if keys[0].Versions != 1 || keys[0].CreatedAt.IsZero() {
+		t.Fatalf("Unexpected key info: %v", keys[0])
+	}
+
+	if err = KMS.(KeyDeleter).DeleteKey("tenant-a"); err != nil {
+		t.Fatalf("Failed to delete key: %v", err)
+	}
+	if err = KMS.(KeyDeleter).DeleteKey("tenant-a"); err != ErrKeyNotFound {
+		t.Fatalf("Expected %v, got %v", ErrKeyNotFound, err)
+	}
+	if keys, err = KMS.(KeyLister).ListKeys(""); err != nil || len(keys) != 1 {
+		t.Fatalf("Unexpected keys after deletion: %v %v", keys, err)
+	}
+}
+
+func TestKeyStoreSharedFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "minio-keystore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "keys.json")
+
+	KMS1, err := NewKeyStore(filename, "my-key")
+	if err != nil {
+		t.Fatalf("Failed to initialize KMS: %v", err)
+	}
+	KMS2, err := NewKeyStore(filename, "my-key")
+	if err != nil {
+		t.Fatalf("Failed to initialize KMS: %v", err)
+	}
+	if err = KMS1.CreateKey("my-key"); err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+
+	// Concurrent rotations by both servers must not lose versions.
+	const rotations = 10
+	var wg sync.WaitGroup
+	for _, kms := range []KMS{KMS1, KMS2} {
+		wg.Add(1)
+		go func(kms KMS) {
+			defer wg.Done()
+			for i := 0; i < rotations; i++ {
+				if err := kms.(KeyRotator).RotateKey("my-key"); err != nil {
+					t.Errorf("Failed to rotate key: %v", err)
+				}
+			}
+		}(kms)
+	}
+	wg.Wait()
+
+	for _, kms := range []KMS{KMS1, KMS2} {
+		keys, err := kms.(KeyLister).ListKeys("my-key")
+		if err != nil {
+			t.Fatalf("Failed to list keys: %v", err)
+		}
+		if len(keys) != 1 || keys[0].Versions != 1+2*rotations {
+			t.Fatalf("Expected %d versions, got %+v", 1+2*rotations, keys)
+		}
+	}
+
+	// Versions missing from the file, e.g. because another
+	// writer replaced it, remain in memory.
+	dek, err := KMS1.GenerateKey("my-key", Context{})
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	if err = ioutil.WriteFile(filename, []byte(`{"keys":{}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = KMS1.DecryptKey("my-key", dek.Ciphertext, Context{}); err != nil {
+		t.Fatalf("Failed to decrypt key: %v", err)
+	}
+	if err = KMS1.CreateKey("other-key"); err != nil {
+		t.Fatalf("Failed to create key: %v", err)
+	}
+	if _, err = KMS2.DecryptKey("my-key", dek.Ciphertext, Context{}); err != nil {
+		t.Fatalf("Failed to decrypt key: %v", err)
+	}
+
+	// Keys deleted by one server are not written back by another.
+	if err = KMS1.(KeyDeleter).DeleteKey("my-key"); err != nil {
+		t.Fatalf("Failed to delete key: %v", err)
+	}
+	if err = KMS2.(KeyRotator).RotateKey("other-key"); err != nil {
+		t.Fatalf("Failed to rotate key: %v", err)
+	}
+	for _, kms := range []KMS{KMS1, KMS2} {
+		keys, err := kms.(KeyLister).ListKeys("")
+		if err != nil {
+			t.Fatalf("Failed to list keys: %v", err)
+		}
+		if len(keys) != 1 || keys[0].KeyID != "other-key" {
+			t.Fatalf("Expected only other-key, got %+v", keys)
+		}
+	}
+}
@@ -17,6 +17,18 @@ package kms
 import (
 	"encoding"
 	"encoding/json"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrKeyExists is returned by CreateKey if a key
+	// with the given key ID already exists.
+	ErrKeyExists = errors.New("kms: key already exists")
+
+	// ErrKeyNotFound is returned if the referenced
+	// key does not exist.
+	ErrKeyNotFound = errors.New("kms: key does not exist")
 )
 
 // KMS is the generic interface that abstracts over
@@ -48,6 +60,52 @@ type KMS interface {
 	DecryptKey(keyID string, ciphertext []byte, context Context) ([]byte, error)
 }
 
+// KeyRotator is implemented by KMS implementations that
+// support rotating master keys.
+//
+// After a master key has been rotated, new DEKs are encrypted
+// with the new key version while DEKs encrypted with previous
+// versions can still be decrypted.
+type KeyRotator interface {
+	// RotateKey creates a new version of the key
+	// referenced by the key ID.
+	RotateKey(keyID string) error
+}
+
+// KeyLister is implemented by KMS implementations that
+// support listing their master keys.
+type KeyLister interface {
+	// ListKeys returns information about all keys whose
+	// key ID matches the glob pattern. An empty pattern
+	// matches all keys.
+	ListKeys(pattern string) ([]KeyInfo, error)
+}
+
+// KeyDeleter is implemented by KMS implementations that
+// support deleting master keys.
+type KeyDeleter interface {
+	// DeleteKey deletes the key referenced by the key ID.
+	// DEKs generated with the key can no longer be
+	// decrypted once the key has been deleted.
+	DeleteKey(keyID string) error
+}
+
+// KeyImporter is implemented by KMS implementations that
+// support importing externally generated key material.
+type KeyImporter interface {
+	// ImportKey creates a new key with the given key ID
+	// from the 256 bit key material. It returns ErrKeyExists
+	// if a key with the key ID already exists.
+	ImportKey(keyID string, key []byte) error
+}
+
+// KeyInfo describes a master key.
+type KeyInfo struct {
+	KeyID     string    // The ID of the key
+	CreatedAt time.Time // The creation time of the key; zero if unknown
+	Versions  int       // The number of key versions; 0 if unknown
+}
+
 // Status describes the current state of a KMS.
 type Status struct {
 	Name      string   // The name of the KMS
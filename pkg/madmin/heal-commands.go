@@ -25,6 +25,7 @@ import (
 	"net/http"
 	"net/url"
 	"sort"
+	"strings"
 	"time"
 )
 
@@ -343,6 +344,17 @@ type HealingDisk struct {
 
 	// Filled during heal.
 	HealedBuckets []string `json:"healed_buckets"`
+
+	// Buckets healed first, set when the drive was replaced.
+	PriorityBuckets []string `json:"priority_buckets,omitempty"`
+
+	// Healing paused by the admin.
+	Paused bool `json:"paused"`
+
+	// Estimated objects and bytes to heal, and time left.
+	ObjectsTotal uint64        `json:"objects_total"`
+	BytesTotal   uint64        `json:"bytes_total"`
+	ETA          time.Duration `json:"eta"`
 	// future add more tracking capabilities
 }
 
@@ -420,3 +432,45 @@ func (adm *AdminClient) BackgroundHealStatus(ctx context.Context) (BgHealState,
 	}
 	return healState, nil
 }
+
+// HealDriveAction - admin action on the healing of a drive.
+type HealDriveAction string
+
+// HealDriveAction constants
+const (
+	// HealDriveReplace marks the drive as replaced and heals it right away.
+	HealDriveReplace HealDriveAction = "replace"
+	// HealDrivePause pauses healing of the drive.
+	HealDrivePause HealDriveAction = "pause"
+	// HealDriveResume resumes healing of the drive.
+	HealDriveResume HealDriveAction = "resume"
+)
+
+// HealDrive performs the action on the healing of the drive at endpoint,
+// as reported in the server info. When the drive is replaced, the buckets
+// given are healed first in the given order.
+func (adm *AdminClient) HealDrive(ctx context.Context, endpoint string, action HealDriveAction, buckets []string) error {
+	queryValues := url.Values{}
+	queryValues.Set("endpoint", endpoint)
+	queryValues.Set("action", string(action))
+	if len(buckets) > 0 {
+		queryValues.Set("buckets", strings.Join(buckets, ","))
+	}
+
+	// Execute POST request to heal drive api
+	resp, err := adm.executeMethod(ctx,
+		http.MethodPost,
+		requestData{
+			relPath:     adminAPIPrefix + "/heal-drive",
+			queryValues: queryValues,
+		})
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return httpRespToErrorResponse(resp)
+	}
+	return nil
+}
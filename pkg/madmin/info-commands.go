@@ -143,6 +143,10 @@ type BucketUsageInfo struct {
 	ReplicaSize             uint64 `json:"objectReplicaTotalSize"`
 	ReplicationPendingCount uint64 `json:"objectsPendingReplicationCount"`
 	ReplicationFailedCount  uint64 `json:"objectsFailedReplicationCount"`
+	InlineCount             uint64 `json:"objectsInlineCount"`
+	InlineSize              uint64 `json:"objectsInlineTotalSize"`
+	PartsCount              uint64 `json:"objectsPartsCount"`
+	PartsSize               uint64 `json:"objectsPartsTotalSize"`
 
 	ObjectsCount         uint64            `json:"objectsCount"`
 	ObjectSizesHistogram map[string]uint64 `json:"objectsSizesHistogram"`
@@ -178,6 +182,18 @@ type DataUsageInfo struct {
 	// Total number of objects that failed replication
 	ReplicationFailedCount uint64 `json:"objectsFailedReplicationCount"`
 
+	// Total number of object versions with data inlined in xl.meta
+	InlineCount uint64 `json:"objectsInlineCount"`
+
+	// Total size of object versions with data inlined in xl.meta
+	InlineSize uint64 `json:"objectsInlineTotalSize"`
+
+	// Total number of object versions with data in part files
+	PartsCount uint64 `json:"objectsPartsCount"`
+
+	// Total size of object versions with data in part files
+	PartsSize uint64 `json:"objectsPartsTotalSize"`
+
 	// Total number of buckets in this cluster
 	BucketsCount uint64 `json:"bucketsCount"`
 
@@ -322,10 +338,13 @@ type ServerProperties struct {
 
 // DiskMetrics has the information about XL Storage APIs
 // the number of calls of each API and the moving average of
-// the duration of each API.
+// the duration of each API, along with the health of the disk.
 type DiskMetrics struct {
-	APILatencies map[string]string `json:"apiLatencies,omitempty"`
-	APICalls     map[string]uint64 `json:"apiCalls,omitempty"`
+	APILatencies  map[string]string `json:"apiLatencies,omitempty"`
+	APICalls      map[string]uint64 `json:"apiCalls,omitempty"`
+	Health        string            `json:"health,omitempty"`
+	TotalErrors   uint64            `json:"totalErrors,omitempty"`
+	TotalTimeouts uint64            `json:"totalTimeouts,omitempty"`
 }
 
 // Disk holds Disk information
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 // CreateKey tries to create a new master key with the given keyID
@@ -82,3 +83,251 @@ type KMSKeyStatus struct {
 	EncryptionErr string `json:"encryption-error,omitempty"` // An empty error == success
 	DecryptionErr string `json:"decryption-error,omitempty"` // An empty error == success
 }
+
+// KMSKeyInfo contains information about a KMS master key.
+type KMSKeyInfo struct {
+	KeyID     string    `json:"key-id"`
+	CreatedAt time.Time `json:"created-at,omitempty"` // Zero if not known by the KMS
+	Versions  int       `json:"versions,omitempty"`   // 0 if not known by the KMS
+	Default   bool      `json:"default,omitempty"`    // The key is the default key of the server
+	Buckets   []string  `json:"buckets,omitempty"`    // Buckets whose default encryption uses the key
+}
+
+// ListKeys returns the master keys of the KMS connected to a MinIO
+// server whose key ID matches the glob pattern. An empty pattern
+// matches all keys.
+func (adm *AdminClient) ListKeys(ctx context.Context, pattern string) ([]KMSKeyInfo, error) {
+	// GET /minio/admin/v3/kms/key/list?pattern=<pattern>
+	qv := url.Values{}
+	qv.Set("pattern", pattern)
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/kms/key/list",
+		queryValues: qv,
+	}
+
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponse(resp)
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+	var keys []KMSKeyInfo
+	if err = json.NewDecoder(resp.Body).Decode(&keys); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// DescribeKey returns information about the master key referenced by
+// keyID, or the default key if keyID is empty.
+func (adm *AdminClient) DescribeKey(ctx context.Context, keyID string) (*KMSKeyInfo, error) {
+	// GET /minio/admin/v3/kms/key/describe?key-id=<keyID>
+	qv := url.Values{}
+	qv.Set("key-id", keyID)
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/kms/key/describe",
+		queryValues: qv,
+	}
+
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponse(resp)
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+	var keyInfo KMSKeyInfo
+	if err = json.NewDecoder(resp.Body).Decode(&keyInfo); err != nil {
+		return nil, err
+	}
+	return &keyInfo, nil
+}
+
+// DeleteKey deletes the master key referenced by keyID at the KMS
+// connected to a MinIO server. Objects encrypted with the key can
+// no longer be decrypted once the key has been deleted.
+func (adm *AdminClient) DeleteKey(ctx context.Context, keyID string) error {
+	// DELETE /minio/admin/v3/kms/key/delete?key-id=<keyID>
+	qv := url.Values{}
+	qv.Set("key-id", keyID)
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/kms/key/delete",
+		queryValues: qv,
+	}
+
+	resp, err := adm.executeMethod(ctx, http.MethodDelete, reqData)
+	if err != nil {
+		return err
+	}
+	defer closeResponse(resp)
+	if resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp)
+	}
+	return nil
+}
+
+// ImportKey creates a new master key with the given keyID from the
+// externally generated 256 bit key material at the KMS connected to a
+// MinIO server. The key material is sent encrypted.
+func (adm *AdminClient) ImportKey(ctx context.Context, keyID string, key []byte) error {
+	// POST /minio/admin/v3/kms/key/import?key-id=<keyID>
+	ekey, err := EncryptData(adm.getSecretKey(), key)
+	if err != nil {
+		return err
+	}
+
+	qv := url.Values{}
+	qv.Set("key-id", keyID)
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/kms/key/import",
+		queryValues: qv,
+		content:     ekey,
+	}
+
+	resp, err := adm.executeMethod(ctx, http.MethodPost, reqData)
+	if err != nil {
+		return err
+	}
+	defer closeResponse(resp)
+	if resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp)
+	}
+	return nil
+}
+
+// KMSKeyRotationStatus contains the progress of re-sealing the object
+// keys of a bucket with the current version of their KMS master keys.
+type KMSKeyRotationStatus struct {
+	Bucket     string    `json:"bucket"`
+	Prefix     string    `json:"prefix,omitempty"`
+	KeyID      string    `json:"key-id,omitempty"` // Only objects encrypted with this master key, all if empty
+	Running    bool      `json:"running"`
+	Finished   bool      `json:"finished"`
+	Started    time.Time `json:"started"`
+	LastUpdate time.Time `json:"last-update"`
+	LastObject string    `json:"last-object,omitempty"`
+	Rotated    uint64    `json:"rotated"`
+	Skipped    uint64    `json:"skipped"`
+	Failed     uint64    `json:"failed"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// RotateKeys starts re-sealing the object keys of all objects in bucket
+// under prefix with the current version of their KMS master key. If
+// keyID is not empty only objects encrypted with that master key are
+// considered. An unfinished rotation of the same bucket, prefix and key
+// is resumed. The object data is not re-encrypted.
+func (adm *AdminClient) RotateKeys(ctx context.Context, bucket, prefix, keyID string) (*KMSKeyRotationStatus, error) {
+	// POST /minio/admin/v3/kms/key/rotate?bucket=<bucket>&prefix=<prefix>&key-id=<keyID>
+	qv := url.Values{}
+	qv.Set("bucket", bucket)
+	qv.Set("prefix", prefix)
+	qv.Set("key-id", keyID)
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/kms/key/rotate",
+		queryValues: qv,
+	}
+
+	resp, err := adm.executeMethod(ctx, http.MethodPost, reqData)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponse(resp)
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+	var status KMSKeyRotationStatus
+	if err = json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// GetKeyRotationStatus returns the progress of the latest key rotation
+// started for bucket.
+func (adm *AdminClient) GetKeyRotationStatus(ctx context.Context, bucket string) (*KMSKeyRotationStatus, error) {
+	// GET /minio/admin/v3/kms/key/rotate/status?bucket=<bucket>
+	qv := url.Values{}
+	qv.Set("bucket", bucket)
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/kms/key/rotate/status",
+		queryValues: qv,
+	}
+
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponse(resp)
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+	var status KMSKeyRotationStatus
+	if err = json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// KMSConfigMigrationStatus contains the progress of re-encrypting the
+// server config, IAM data and bucket metadata with the KMS.
+type KMSConfigMigrationStatus struct {
+	Running    bool      `json:"running"`
+	Finished   bool      `json:"finished"`
+	Started    time.Time `json:"started"`
+	LastUpdate time.Time `json:"last-update"`
+	Migrated   uint64    `json:"migrated"`
+	Skipped    uint64    `json:"skipped"`
+	Failed     uint64    `json:"failed"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// MigrateConfigEncryption starts re-encrypting the server config, IAM
+// data and bucket metadata, which is encrypted with the root credentials
+// or stored in plaintext, with the KMS connected to a MinIO server.
+func (adm *AdminClient) MigrateConfigEncryption(ctx context.Context) (*KMSConfigMigrationStatus, error) {
+	// POST /minio/admin/v3/kms/config/migrate
+	reqData := requestData{
+		relPath: adminAPIPrefix + "/kms/config/migrate",
+	}
+
+	resp, err := adm.executeMethod(ctx, http.MethodPost, reqData)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponse(resp)
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+	var status KMSConfigMigrationStatus
+	if err = json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// GetConfigMigrationStatus returns the progress of the latest config
+// encryption migration.
+func (adm *AdminClient) GetConfigMigrationStatus(ctx context.Context) (*KMSConfigMigrationStatus, error) {
+	// GET /minio/admin/v3/kms/config/migrate/status
+	reqData := requestData{
+		relPath: adminAPIPrefix + "/kms/config/migrate/status",
+	}
+
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponse(resp)
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+	var status KMSConfigMigrationStatus
+	if err = json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
@@ -0,0 +1,107 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// MaintenanceAction - type to restrict maintenance action values
+type MaintenanceAction string
+
+const (
+	// MaintenanceStart puts a node in maintenance, the node becomes
+	// read-only once its in-flight requests are drained and its drives
+	// are offline for new writes.
+	MaintenanceStart MaintenanceAction = "start"
+	// MaintenanceStop takes a node out of maintenance and heals
+	// the objects written while it was in maintenance.
+	MaintenanceStop MaintenanceAction = "stop"
+)
+
+// MaintenanceNode is a node in maintenance.
+type MaintenanceNode struct {
+	Node  string    `json:"node"`
+	Since time.Time `json:"since"`
+}
+
+// MaintenanceStatus lists the nodes in maintenance.
+type MaintenanceStatus struct {
+	Nodes []MaintenanceNode `json:"nodes"`
+}
+
+// Maintenance - applies a maintenance action for a node, given as host:port
+// as reported in the server info. Starting maintenance waits up to
+// drainTimeout for the in-flight requests of the node to complete,
+// 0 uses the server default.
+func (adm *AdminClient) Maintenance(ctx context.Context, node string, action MaintenanceAction, drainTimeout time.Duration) error {
+	queryValues := url.Values{}
+	queryValues.Set("node", node)
+	queryValues.Set("action", string(action))
+	if drainTimeout > 0 {
+		queryValues.Set("drain-timeout", drainTimeout.String())
+	}
+
+	// Execute POST on /minio/admin/v3/maintenance
+	resp, err := adm.executeMethod(ctx,
+		http.MethodPost, requestData{
+			relPath:     adminAPIPrefix + "/maintenance",
+			queryValues: queryValues,
+		})
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusNoContent {
+		return httpRespToErrorResponse(resp)
+	}
+	return nil
+}
+
+// MaintenanceStatus - returns the nodes in maintenance.
+func (adm *AdminClient) MaintenanceStatus(ctx context.Context) (MaintenanceStatus, error) {
+	// Execute GET on /minio/admin/v3/maintenance
+	resp, err := adm.executeMethod(ctx,
+		http.MethodGet,
+		requestData{relPath: adminAPIPrefix + "/maintenance"})
+	if err != nil {
+		return MaintenanceStatus{}, err
+	}
+	defer closeResponse(resp)
+
+	if resp.StatusCode != http.StatusOK {
+		return MaintenanceStatus{}, httpRespToErrorResponse(resp)
+	}
+
+	respBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return MaintenanceStatus{}, err
+	}
+
+	var status MaintenanceStatus
+	if err = json.Unmarshal(respBytes, &status); err != nil {
+		return MaintenanceStatus{}, err
+	}
+	return status, nil
+}
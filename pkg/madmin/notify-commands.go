@@ -0,0 +1,116 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// NotifyTargetStats - delivery statistics of a notification target.
+type NotifyTargetStats struct {
+	Queued       int       `json:"queued"`
+	DeadLettered int       `json:"deadLettered"`
+	OldestEvent  time.Time `json:"oldestEvent,omitempty"`
+	Sent         uint64    `json:"sent"`
+	Failed       uint64    `json:"failed"`
+	Dropped      uint64    `json:"dropped"`
+}
+
+// NotifyQueuedEvent - an undelivered event in the queue store of a
+// notification target, Event holds the JSON encoded event record.
+type NotifyQueuedEvent struct {
+	Key      string          `json:"key"`
+	QueuedAt time.Time       `json:"queuedAt"`
+	Event    json.RawMessage `json:"event"`
+}
+
+// NotifyTargetQueue - state of the queue store of a notification target
+// on a server. Count is the number of events replayed or purged.
+type NotifyTargetQueue struct {
+	Endpoint string              `json:"endpoint"`
+	Stats    NotifyTargetStats   `json:"stats"`
+	Events   []NotifyQueuedEvent `json:"events,omitempty"`
+	Count    int                 `json:"count,omitempty"`
+	Error    string              `json:"error,omitempty"`
+}
+
+// NotifyTargetEventsOpts - options to list queued events.
+type NotifyTargetEventsOpts struct {
+	// DeadLetter - list events which exceeded their retry budget.
+	DeadLetter bool
+	// Max - maximum number of events listed per server, 0 for all.
+	Max int
+}
+
+// ListNotifyTargetEvents - lists the undelivered events of the target,
+// identified by `<id>:<name>` e.g. `1:webhook`, on all servers.
+func (adm *AdminClient) ListNotifyTargetEvents(ctx context.Context, targetID string, opts NotifyTargetEventsOpts) ([]NotifyTargetQueue, error) {
+	queryValues := url.Values{}
+	queryValues.Set("target", targetID)
+	queryValues.Set("deadletter", strconv.FormatBool(opts.DeadLetter))
+	queryValues.Set("max", strconv.Itoa(opts.Max))
+	return adm.notifyTargetQueue(ctx, http.MethodGet, "/notify/target/events", queryValues)
+}
+
+// ReplayNotifyTargetEvents - moves the dead-lettered events of the target
+// back to its queue on all servers.
+func (adm *AdminClient) ReplayNotifyTargetEvents(ctx context.Context, targetID string) ([]NotifyTargetQueue, error) {
+	queryValues := url.Values{}
+	queryValues.Set("target", targetID)
+	return adm.notifyTargetQueue(ctx, http.MethodPost, "/notify/target/replay", queryValues)
+}
+
+// PurgeNotifyTargetEvents - deletes the queued, or dead-lettered, events
+// of the target on all servers.
+func (adm *AdminClient) PurgeNotifyTargetEvents(ctx context.Context, targetID string, deadLetter bool) ([]NotifyTargetQueue, error) {
+	queryValues := url.Values{}
+	queryValues.Set("target", targetID)
+	queryValues.Set("deadletter", strconv.FormatBool(deadLetter))
+	return adm.notifyTargetQueue(ctx, http.MethodDelete, "/notify/target/events", queryValues)
+}
+
+func (adm *AdminClient) notifyTargetQueue(ctx context.Context, method, path string, queryValues url.Values) ([]NotifyTargetQueue, error) {
+	resp, err := adm.executeMethod(ctx, method, requestData{
+		relPath:     adminAPIPrefix + path,
+		queryValues: queryValues,
+	})
+	defer closeResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, httpRespToErrorResponse(resp)
+	}
+
+	respBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var queues []NotifyTargetQueue
+	if err = json.Unmarshal(respBytes, &queues); err != nil {
+		return nil, err
+	}
+	return queues, nil
+}
@@ -0,0 +1,121 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// ScrubShard is a corrupted shard found by bitrot scrubbing.
+type ScrubShard struct {
+	Bucket    string    `json:"bucket"`
+	Object    string    `json:"object"`
+	VersionID string    `json:"version_id,omitempty"`
+	Found     time.Time `json:"found"`
+	Error     string    `json:"error"`
+	Repaired  bool      `json:"repaired"`
+}
+
+// ScrubDisk is the bitrot scrub progress and report of a disk.
+type ScrubDisk struct {
+	// Copied from cmd/bitrot-scrub.go
+	// When adding new field, update (*scrubTracker).toScrubDisk
+
+	ID        string `json:"id"`
+	PoolIndex int    `json:"pool_index"`
+	SetIndex  int    `json:"set_index"`
+	DiskIndex int    `json:"disk_index"`
+	Endpoint  string `json:"endpoint"`
+	Path      string `json:"path"`
+
+	// Current or last cycle.
+	Started    time.Time `json:"started"`
+	LastUpdate time.Time `json:"last_update"`
+	// Completion of the last full cycle.
+	LastCycle time.Time `json:"last_cycle"`
+	Cycles    uint64    `json:"cycles"`
+
+	ObjectsScanned  uint64 `json:"objects_scanned"`
+	BytesScanned    uint64 `json:"bytes_scanned"`
+	ShardsCorrupted uint64 `json:"shards_corrupted"`
+	ShardsRepaired  uint64 `json:"shards_repaired"`
+	ShardsFailed    uint64 `json:"shards_failed"`
+
+	// Last object scanned, empty between cycles.
+	Bucket string `json:"current_bucket"`
+	Object string `json:"current_object"`
+
+	// Corrupted shards found during the cycle.
+	CorruptedShards []ScrubShard `json:"corrupted_shards,omitempty"`
+}
+
+// BitrotScrubStatus is the bitrot scrub report of all disks.
+type BitrotScrubStatus struct {
+	// Time between full cycles, 0 if scrubbing is disabled.
+	Cycle time.Duration `json:"cycle"`
+	Disks []ScrubDisk   `json:"disks"`
+}
+
+// Merge the disks of other reports into this one.
+func (s *BitrotScrubStatus) Merge(o ...BitrotScrubStatus) {
+	for _, other := range o {
+		s.Disks = append(s.Disks, other.Disks...)
+	}
+	sort.Slice(s.Disks, func(i, j int) bool {
+		a, b := s.Disks[i], s.Disks[j]
+		if a.PoolIndex != b.PoolIndex {
+			return a.PoolIndex < b.PoolIndex
+		}
+		if a.SetIndex != b.SetIndex {
+			return a.SetIndex < b.SetIndex
+		}
+		return a.DiskIndex < b.DiskIndex
+	})
+}
+
+// BitrotScrubStatus - returns the bitrot scrub report of all disks.
+func (adm *AdminClient) BitrotScrubStatus(ctx context.Context) (BitrotScrubStatus, error) {
+	// Execute GET on /minio/admin/v3/scrub/status
+	resp, err := adm.executeMethod(ctx,
+		http.MethodGet,
+		requestData{relPath: adminAPIPrefix + "/scrub/status"})
+	if err != nil {
+		return BitrotScrubStatus{}, err
+	}
+	defer closeResponse(resp)
+
+	if resp.StatusCode != http.StatusOK {
+		return BitrotScrubStatus{}, httpRespToErrorResponse(resp)
+	}
+
+	respBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return BitrotScrubStatus{}, err
+	}
+
+	var status BitrotScrubStatus
+	if err = json.Unmarshal(respBytes, &status); err != nil {
+		return BitrotScrubStatus{}, err
+	}
+	return status, nil
+}
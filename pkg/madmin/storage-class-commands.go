@@ -0,0 +1,133 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package madmin
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// PrefixStorageClass holds the default storage class of
+// the objects with a name starting with the prefix.
+type PrefixStorageClass struct {
+	Prefix       string `json:"prefix"`
+	StorageClass string `json:"storageClass"`
+}
+
+// BucketStorageClass holds the default storage class of the objects of
+// a bucket, applied to uploads which do not set a storage class.
+type BucketStorageClass struct {
+	StorageClass string               `json:"storageClass,omitempty"`
+	Prefixes     []PrefixStorageClass `json:"prefixes,omitempty"`
+}
+
+// IsValid returns false if a prefix is empty or set more than once.
+func (c BucketStorageClass) IsValid() bool {
+	prefixes := make(map[string]struct{}, len(c.Prefixes))
+	for _, p := range c.Prefixes {
+		if p.Prefix == "" || p.StorageClass == "" {
+			return false
+		}
+		if _, ok := prefixes[p.Prefix]; ok {
+			return false
+		}
+		prefixes[p.Prefix] = struct{}{}
+	}
+	return true
+}
+
+// For returns the default storage class of the object, the storage
+// class of the longest matching prefix or of the bucket otherwise.
+func (c BucketStorageClass) For(object string) string {
+	sc, matched := c.StorageClass, -1
+	for _, p := range c.Prefixes {
+		if len(p.Prefix) > matched && strings.HasPrefix(object, p.Prefix) {
+			sc, matched = p.StorageClass, len(p.Prefix)
+		}
+	}
+	return sc
+}
+
+// GetBucketStorageClass - get the default storage classes of a bucket.
+func (adm *AdminClient) GetBucketStorageClass(ctx context.Context, bucket string) (c BucketStorageClass, err error) {
+	queryValues := url.Values{}
+	queryValues.Set("bucket", bucket)
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/get-bucket-storage-class",
+		queryValues: queryValues,
+	}
+
+	// Execute GET on /minio/admin/v3/get-bucket-storage-class
+	resp, err := adm.executeMethod(ctx, http.MethodGet, reqData)
+
+	defer closeResponse(resp)
+	if err != nil {
+		return c, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return c, httpRespToErrorResponse(resp)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return c, err
+	}
+	if err = json.Unmarshal(b, &c); err != nil {
+		return c, err
+	}
+
+	return c, nil
+}
+
+// SetBucketStorageClass - sets the default storage classes of a bucket,
+// an empty configuration removes the defaults.
+func (adm *AdminClient) SetBucketStorageClass(ctx context.Context, bucket string, c *BucketStorageClass) error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	queryValues := url.Values{}
+	queryValues.Set("bucket", bucket)
+
+	reqData := requestData{
+		relPath:     adminAPIPrefix + "/set-bucket-storage-class",
+		queryValues: queryValues,
+		content:     data,
+	}
+
+	// Execute PUT on /minio/admin/v3/set-bucket-storage-class to set the default storage classes of a bucket.
+	resp, err := adm.executeMethod(ctx, http.MethodPut, reqData)
+
+	defer closeResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return httpRespToErrorResponse(resp)
+	}
+
+	return nil
+}
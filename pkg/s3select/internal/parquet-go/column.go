@@ -33,13 +33,24 @@ func getColumns(
 	schemaElements []*parquet.SchemaElement,
 	getReaderFunc GetReaderFunc,
 ) (nameColumnMap map[string]*column, err error) {
+	if columnNames != nil && !columnNames.IsEmpty() && !hasColumn(rowGroup, columnNames) {
+		// None of the requested columns exist, e.g. a missing or
+		// nested path, read the first one to iterate over the rows.
+		columnNames = set.NewStringSet()
+	}
+
 	nameIndexMap := make(map[string]int)
 	for colIndex, columnChunk := range rowGroup.GetColumns() {
 		meta := columnChunk.GetMetaData()
 		if meta == nil {
 			return nil, errors.New("parquet: column metadata missing")
 		}
-		columnName := strings.Join(meta.GetPathInSchema(), ".")
+		columnName := columnPath(meta)
+		if columnNames != nil && columnNames.IsEmpty() {
+			// No column is requested, read the first one to
+			// iterate over the rows.
+			columnNames = set.CreateStringSet(columnName)
+		}
 		if columnNames != nil && !columnNames.Contains(columnName) {
 			continue
 		}
@@ -68,18 +79,10 @@ func getColumns(
 		if nameColumnMap == nil {
 			nameColumnMap = make(map[string]*column)
 		}
-		var se *parquet.SchemaElement
-		for _, schema := range schemaElements {
-			if schema != nil && schema.Name == columnName {
-				se = schema
-				break
-			}
-		}
-
 		nameColumnMap[columnName] = &column{
 			name:           columnName,
 			metadata:       meta,
-			schema:         se,
+			schema:         getSchemaElement(schemaElements, columnName),
 			schemaElements: schemaElements,
 			rc:             rc,
 			thriftReader:   thriftReader,
@@ -98,6 +101,30 @@ func getColumns(
 	return nameColumnMap, nil
 }
 
+// hasColumn returns true if the row group has any of the columns.
+func hasColumn(rowGroup *parquet.RowGroup, columnNames set.StringSet) bool {
+	for _, columnChunk := range rowGroup.GetColumns() {
+		if meta := columnChunk.GetMetaData(); meta != nil && columnNames.Contains(columnPath(meta)) {
+			return true
+		}
+	}
+	return false
+}
+
+func columnPath(meta *parquet.ColumnMetaData) string {
+	return strings.Join(meta.GetPathInSchema(), ".")
+}
+
+func getSchemaElement(schemaElements []*parquet.SchemaElement, name string) *parquet.SchemaElement {
+	for _, schema := range schemaElements {
+		if schema != nil && schema.Name == name {
+			return schema
+		}
+	}
+
+	return nil
+}
+
 type column struct {
 	name           string
 	endOfValues    bool
@@ -111,6 +138,7 @@ type column struct {
 	dataTable      *table
 	rc             io.ReadCloser
 	thriftReader   *thrift.TBufferedTransport
+	filter         StatsFilter
 }
 
 func (column *column) close() (err error) {
@@ -128,8 +156,14 @@ func (column *column) readPage() {
 		column.metadata,
 		column.nameIndexMap,
 		column.schemaElements,
+		column.skipPage,
 	)
 
+	if err == errPageSkipped {
+		column.readPage()
+		return
+	}
+
 	if err != nil {
 		column.endOfValues = true
 		return
@@ -150,6 +184,27 @@ func (column *column) readPage() {
 	column.dataTable.Merge(page.DataTable)
 }
 
+// skipPage - returns true if the page statistics show that none of
+// its values can be selected by the column filter.
+func (column *column) skipPage(header *parquet.PageHeader) bool {
+	if column.filter == nil {
+		return false
+	}
+
+	statistics := pageStatistics(header)
+	if statistics == nil {
+		return false
+	}
+
+	return !column.filter(map[string]ColumnStats{
+		column.name: {
+			Type:       column.valueType,
+			Schema:     column.schema,
+			Statistics: statistics,
+		},
+	})
+}
+
 func (column *column) read() (value interface{}, valueType parquet.Type, cnv *parquet.SchemaElement) {
 	if column.dataTable == nil {
 		column.readPage()
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"math"
 	"strings"
 
@@ -30,6 +31,10 @@ import (
 	"minio/pkg/s3select/internal/parquet-go/gen-go/parquet"
 )
 
+// errPageSkipped - returned by readPage when the page was discarded
+// without decoding.
+var errPageSkipped = errors.New("parquet: page skipped")
+
 // getBitWidth - returns bits required to place num e.g.
 //
 //    num | width
@@ -93,6 +98,7 @@ func readPage(
 	metadata *parquet.ColumnMetaData,
 	columnNameIndexMap map[string]int,
 	schemaElements []*parquet.SchemaElement,
+	skipPage func(*parquet.PageHeader) bool,
 ) (page *page, definitionLevels, numRows int64, err error) {
 
 	pageHeader, err := readPageHeader(thriftReader)
@@ -100,6 +106,13 @@ func readPage(
 		return nil, 0, 0, err
 	}
 
+	if skipPage != nil && pageHeader.GetType() != parquet.PageType_DICTIONARY_PAGE && skipPage(pageHeader) {
+		if _, err = io.CopyN(ioutil.Discard, thriftReader, int64(pageHeader.GetCompressedPageSize())); err != nil {
+			return nil, 0, 0, err
+		}
+		return nil, 0, 0, errPageSkipped
+	}
+
 	read := func() (data []byte, err error) {
 		var repLevelsLen, defLevelsLen int32
 		var repLevelsBuf, defLevelsBuf []byte
@@ -94,9 +94,10 @@ type Reader struct {
 	columnNames set.StringSet
 	columns     map[string]*column
 	rowIndex    int64
+	filter      StatsFilter
 }
 
-// NewReader - creates new parquet reader. Reader calls getReaderFunc to get required data range for given columnNames. If columnNames is empty, all columns are used.
+// NewReader - creates new parquet reader. Reader calls getReaderFunc to get required data range for given columnNames. If columnNames is nil, all columns are used; if it is empty, only the first column is read to iterate over the rows.
 func NewReader(getReaderFunc GetReaderFunc, columnNames set.StringSet) (*Reader, error) {
 	fileMeta, err := fileMetadata(getReaderFunc)
 	if err != nil {
@@ -118,6 +119,13 @@ func NewReader(getReaderFunc GetReaderFunc, columnNames set.StringSet) (*Reader,
 	}, nil
 }
 
+// SetStatsFilter - sets the filter used to skip row groups whose column
+// statistics show that none of their rows can be selected. When a single
+// column is read, data pages are skipped the same way.
+func (reader *Reader) SetStatsFilter(filter StatsFilter) {
+	reader.filter = filter
+}
+
 // Read - reads single record.
 func (reader *Reader) Read() (record *Record, err error) {
 	if reader.rowGroupIndex >= len(reader.rowGroups) {
@@ -125,6 +133,12 @@ func (reader *Reader) Read() (record *Record, err error) {
 	}
 
 	if reader.columns == nil {
+		rowGroup := reader.rowGroups[reader.rowGroupIndex]
+		if reader.filter != nil && !reader.filter(rowGroupStats(rowGroup, reader.schemaElements)) {
+			reader.rowGroupIndex++
+			return reader.Read()
+		}
+
 		reader.columns, err = getColumns(
 			reader.rowGroups[reader.rowGroupIndex],
 			reader.columnNames,
@@ -134,6 +148,19 @@ func (reader *Reader) Read() (record *Record, err error) {
 		if err != nil {
 			return nil, err
 		}
+		if len(reader.columns) == 0 {
+			// No column of this row group can be read.
+			reader.rowGroupIndex++
+			return reader.Read()
+		}
+
+		// Page statistics can only be used when a single column is
+		// read, otherwise skipping pages would misalign the rows.
+		if len(reader.columns) == 1 {
+			for _, col := range reader.columns {
+				col.filter = reader.filter
+			}
+		}
 
 		reader.rowIndex = 0
 	}
@@ -148,6 +175,12 @@ func (reader *Reader) Read() (record *Record, err error) {
 	for name := range reader.columns {
 		col := reader.columns[name]
 		value, valueType, schema := col.read()
+		if col.filter != nil && col.endOfValues {
+			// Remaining pages of this row group were skipped.
+			reader.rowGroupIndex++
+			reader.Close()
+			return reader.Read()
+		}
 		record.set(name, Value{Value: value, Type: valueType, Schema: schema})
 	}
 
@@ -88,3 +88,76 @@ func TestReader(t *testing.T) {
 
 	reader.Close()
 }
+
+func TestReaderStatsFilter(t *testing.T) {
+	name := "example.parquet"
+	for _, selected := range []bool{true, false} {
+		reader, err := NewReader(
+			func(offset, length int64) (io.ReadCloser, error) {
+				return getReader(name, offset, length)
+			},
+			set.CreateStringSet("one"),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		reader.SetStatsFilter(func(stats map[string]ColumnStats) bool {
+			min, max, ok := stats["one"].MinMax()
+			if !ok || min != float64(-1) || max != float64(2.5) {
+				t.Errorf("unexpected statistics of column one: %v %v %v", min, max, ok)
+			}
+			return selected
+		})
+
+		count := 0
+		for {
+			if _, err = reader.Read(); err != nil {
+				if err != io.EOF {
+					t.Error(err)
+				}
+				break
+			}
+			count++
+		}
+		reader.Close()
+
+		expected := 0
+		if selected {
+			expected = 3
+		}
+		if count != expected {
+			t.Errorf("expected %v records, got %v", expected, count)
+		}
+	}
+}
+
+func TestReaderMissingColumn(t *testing.T) {
+	name := "example.parquet"
+	for _, columnName := range []string{"missing", "one.nested"} {
+		reader, err := NewReader(
+			func(offset, length int64) (io.ReadCloser, error) {
+				return getReader(name, offset, length)
+			},
+			set.CreateStringSet(columnName),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		count := 0
+		for ; count <= 3; count++ {
+			if _, err = reader.Read(); err != nil {
+				if err != io.EOF {
+					t.Error(err)
+				}
+				break
+			}
+		}
+		reader.Close()
+
+		if count != 3 {
+			t.Errorf("%s: expected 3 records, got %v", columnName, count)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package parquet
+
+import (
+	"encoding/binary"
+	"math"
+
+	"minio/pkg/s3select/internal/parquet-go/gen-go/parquet"
+)
+
+// ColumnStats - statistics of a column chunk or of a single data page.
+type ColumnStats struct {
+	Type       parquet.Type
+	Schema     *parquet.SchemaElement
+	Statistics *parquet.Statistics
+}
+
+// StatsFilter - returns false if none of the rows described by stats,
+// keyed by column name, can be selected; the reader then skips them.
+type StatsFilter func(stats map[string]ColumnStats) bool
+
+// MinMax - returns the decoded minimum and maximum values. Values are
+// bool, int32, int64, float32, float64 or []byte as per column type.
+// ok is false if the statistics do not carry usable bounds.
+func (stats ColumnStats) MinMax() (min, max interface{}, ok bool) {
+	if stats.Statistics == nil {
+		return nil, nil, false
+	}
+
+	minBytes, maxBytes := stats.Statistics.GetMinValue(), stats.Statistics.GetMaxValue()
+	if !stats.Statistics.IsSetMinValue() || !stats.Statistics.IsSetMaxValue() {
+		// Deprecated min/max fields were written using signed
+		// comparison, they are only valid for non byte array types.
+		switch stats.Type {
+		case parquet.Type_BYTE_ARRAY, parquet.Type_FIXED_LEN_BYTE_ARRAY:
+			return nil, nil, false
+		}
+		if !stats.Statistics.IsSetMin() || !stats.Statistics.IsSetMax() {
+			return nil, nil, false
+		}
+		minBytes, maxBytes = stats.Statistics.GetMin(), stats.Statistics.GetMax()
+	}
+
+	if min, ok = decodeStatValue(stats.Type, minBytes); !ok {
+		return nil, nil, false
+	}
+	if max, ok = decodeStatValue(stats.Type, maxBytes); !ok {
+		return nil, nil, false
+	}
+	return min, max, true
+}
+
+// decodeStatValue - decodes a PLAIN encoded statistics value.
+func decodeStatValue(dataType parquet.Type, data []byte) (interface{}, bool) {
+	switch dataType {
+	case parquet.Type_BOOLEAN:
+		if len(data) != 1 {
+			return nil, false
+		}
+		return data[0] != 0, true
+	case parquet.Type_INT32:
+		if len(data) != 4 {
+			return nil, false
+		}
+		return int32(binary.LittleEndian.Uint32(data)), true
+	case parquet.Type_INT64:
+		if len(data) != 8 {
+			return nil, false
+		}
+		return int64(binary.LittleEndian.Uint64(data)), true
+	case parquet.Type_FLOAT:
+		if len(data) != 4 {
+			return nil, false
+		}
+		return math.Float32frombits(binary.LittleEndian.Uint32(data)), true
+	case parquet.Type_DOUBLE:
+		if len(data) != 8 {
+			return nil, false
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(data)), true
+	case parquet.Type_BYTE_ARRAY, parquet.Type_FIXED_LEN_BYTE_ARRAY:
+		return data, true
+	}
+
+	return nil, false
+}
+
+// rowGroupStats - returns statistics of all column chunks of rowGroup.
+func rowGroupStats(rowGroup *parquet.RowGroup, schemaElements []*parquet.SchemaElement) map[string]ColumnStats {
+	stats := make(map[string]ColumnStats)
+	for _, columnChunk := range rowGroup.GetColumns() {
+		meta := columnChunk.GetMetaData()
+		if meta == nil || meta.Statistics == nil {
+			continue
+		}
+
+		columnName := columnPath(meta)
+		stats[columnName] = ColumnStats{
+			Type:       meta.GetType(),
+			Schema:     getSchemaElement(schemaElements, columnName),
+			Statistics: meta.Statistics,
+		}
+	}
+
+	return stats
+}
+
+// pageStatistics - returns statistics of a data page, if present.
+func pageStatistics(header *parquet.PageHeader) *parquet.Statistics {
+	switch header.GetType() {
+	case parquet.PageType_DATA_PAGE:
+		if header.DataPageHeader != nil {
+			return header.DataPageHeader.Statistics
+		}
+	case parquet.PageType_DATA_PAGE_V2:
+		if header.DataPageHeaderV2 != nil {
+			return header.DataPageHeaderV2.Statistics
+		}
+	}
+
+	return nil
+}
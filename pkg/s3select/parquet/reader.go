@@ -19,9 +19,11 @@ package parquet
 import (
 	"fmt"
 	"io"
+	"math"
 	"time"
 
 	"github.com/bcicen/jstream"
+	"github.com/minio/minio-go/v7/pkg/set"
 
 	parquetgo "minio/pkg/s3select/internal/parquet-go"
 	parquetgen "minio/pkg/s3select/internal/parquet-go/gen-go/parquet"
@@ -110,19 +112,86 @@ func (r *Reader) Read(dst sql.Record) (rec sql.Record, rerr error) {
 	return dstRec, nil
 }
 
+// columnRange - converts column statistics into the value range seen by
+// SQL evaluation. Only types whose statistics order matches the SQL
+// comparison of the values produced by Read are converted.
+func columnRange(stats parquetgo.ColumnStats) (colRange sql.ColumnRange, ok bool) {
+	if stats.Schema == nil || stats.Schema.LogicalType != nil {
+		return colRange, false
+	}
+	if stats.Schema.ConvertedType != nil {
+		switch *stats.Schema.ConvertedType {
+		case parquetgen.ConvertedType_UTF8, parquetgen.ConvertedType_INT_8,
+			parquetgen.ConvertedType_INT_16, parquetgen.ConvertedType_INT_32,
+			parquetgen.ConvertedType_INT_64:
+		default:
+			return colRange, false
+		}
+	}
+
+	min, max, ok := stats.MinMax()
+	if !ok {
+		return colRange, false
+	}
+
+	toValue := func(v interface{}) *sql.Value {
+		switch val := v.(type) {
+		case bool:
+			return sql.FromBool(val)
+		case int32:
+			return sql.FromInt(int64(val))
+		case int64:
+			return sql.FromInt(val)
+		case float32:
+			if math.IsNaN(float64(val)) {
+				return nil
+			}
+			return sql.FromFloat(float64(val))
+		case float64:
+			if math.IsNaN(val) {
+				return nil
+			}
+			return sql.FromFloat(val)
+		case []byte:
+			if stats.Type != parquetgen.Type_BYTE_ARRAY {
+				return nil
+			}
+			return sql.FromString(string(val))
+		}
+		return nil
+	}
+
+	colRange.Min, colRange.Max = toValue(min), toValue(max)
+	if colRange.Min == nil || colRange.Max == nil {
+		return colRange, false
+	}
+	colRange.HasNulls = !stats.Statistics.IsSetNullCount() || stats.Statistics.GetNullCount() > 0
+	return colRange, true
+}
+
 // Close - closes underlying readers.
 func (r *Reader) Close() error {
 	return r.reader.Close()
 }
 
-// NewReader - creates new Parquet reader using readerFunc callback.
-func NewReader(getReaderFunc func(offset, length int64) (io.ReadCloser, error), args *ReaderArgs) (r *Reader, err error) {
+// NewReader - creates new Parquet reader using readerFunc callback. If
+// stmt is not nil, only the columns it references are decoded and row
+// groups and pages that cannot match its WHERE clause are skipped.
+func NewReader(getReaderFunc func(offset, length int64) (io.ReadCloser, error), args *ReaderArgs, stmt *sql.SelectStatement) (r *Reader, err error) {
 	defer func() {
 		if rec := recover(); rec != nil {
 			err = fmt.Errorf("panic reading parquet header: %v", rec)
 		}
 	}()
-	reader, err := parquetgo.NewReader(getReaderFunc, nil)
+
+	var columnNames set.StringSet
+	if stmt != nil {
+		if names, ok := stmt.ReferencedColumns(); ok {
+			columnNames = set.CreateStringSet(names...)
+		}
+	}
+
+	reader, err := parquetgo.NewReader(getReaderFunc, columnNames)
 	if err != nil {
 		if err != io.EOF {
 			return nil, errParquetParsingError(err)
@@ -131,6 +200,18 @@ func NewReader(getReaderFunc func(offset, length int64) (io.ReadCloser, error),
 		return nil, err
 	}
 
+	if stmt != nil {
+		reader.SetStatsFilter(func(stats map[string]parquetgo.ColumnStats) bool {
+			ranges := make(map[string]sql.ColumnRange, len(stats))
+			for name, columnStats := range stats {
+				if colRange, ok := columnRange(columnStats); ok {
+					ranges[name] = colRange
+				}
+			}
+			return stmt.MayMatch(ranges)
+		})
+	}
+
 	return &Reader{
 		args:   args,
 		reader: reader,
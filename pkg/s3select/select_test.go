@@ -1512,3 +1512,121 @@ func TestParquetInputSchemaCSV(t *testing.T) {
 		})
 	}
 }
+
+func TestParquetInputPushdown(t *testing.T) {
+	os.Setenv("MINIO_API_SELECT_PARQUET", "on")
+	defer os.Setenv("MINIO_API_SELECT_PARQUET", "off")
+
+	var testTable = []struct {
+		query      string
+		wantResult string
+	}{
+		{
+			query:      "SELECT two FROM S3Object WHERE two = 'baz'",
+			wantResult: `{"two":"baz"}`,
+		},
+		{
+			query:      "SELECT two FROM S3Object WHERE two > 'zzz'",
+			wantResult: ``,
+		},
+		{
+			query:      "SELECT COUNT(*) AS total FROM S3Object WHERE three = true",
+			wantResult: `{"total":2}`,
+		},
+		{
+			query:      "SELECT COUNT(*) AS total FROM S3Object WHERE one = 100",
+			wantResult: `{"total":0}`,
+		},
+		{
+			query:      "SELECT s.two, s.three FROM S3Object s WHERE s.two IN ('bar', 'foo')",
+			wantResult: "{\"two\":\"foo\",\"three\":true}\n{\"two\":\"bar\",\"three\":false}",
+		},
+		{
+			query:      "SELECT s.missing FROM S3Object s",
+			wantResult: "{\"missing\":null}\n{\"missing\":null}\n{\"missing\":null}",
+		},
+		{
+			query:      "SELECT COUNT(s.missing) AS total FROM S3Object s",
+			wantResult: `{"total":0}`,
+		},
+	}
+
+	for i, testCase := range testTable {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			getReader := func(offset int64, length int64) (io.ReadCloser, error) {
+				testdataFile := "testdata/testdata.parquet"
+				file, err := os.Open(testdataFile)
+				if err != nil {
+					return nil, err
+				}
+
+				fi, err := file.Stat()
+				if err != nil {
+					return nil, err
+				}
+
+				if offset < 0 {
+					offset = fi.Size() + offset
+				}
+
+				if _, err = file.Seek(offset, io.SeekStart); err != nil {
+					return nil, err
+				}
+
+				return file, nil
+			}
+
+			requestXML := []byte(`
+<?xml version="1.0" encoding="UTF-8"?>
+<SelectObjectContentRequest>
+    <Expression>` + testCase.query + `</Expression>
+    <ExpressionType>SQL</ExpressionType>
+    <InputSerialization>
+        <CompressionType>NONE</CompressionType>
+        <Parquet>
+        </Parquet>
+    </InputSerialization>
+    <OutputSerialization>
+        <JSON>
+        </JSON>
+    </OutputSerialization>
+    <RequestProgress>
+        <Enabled>FALSE</Enabled>
+    </RequestProgress>
+</SelectObjectContentRequest>
+`)
+
+			s3Select, err := NewS3Select(bytes.NewReader(requestXML))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err = s3Select.Open(getReader); err != nil {
+				t.Fatal(err)
+			}
+
+			w := &testResponseWriter{}
+			s3Select.Evaluate(w)
+			s3Select.Close()
+			resp := http.Response{
+				StatusCode:    http.StatusOK,
+				Body:          ioutil.NopCloser(bytes.NewReader(w.response)),
+				ContentLength: int64(len(w.response)),
+			}
+			res, err := minio.NewSelectResults(&resp, "testbucket")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			got, err := ioutil.ReadAll(res)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			gotS := strings.TrimSpace(string(got))
+			if gotS != testCase.wantResult {
+				t.Errorf("received response does not match with expected reply. Query: %s\ngot: %s\nwant:%s", testCase.query, gotS, testCase.wantResult)
+			}
+		})
+	}
+}
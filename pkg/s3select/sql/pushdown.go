@@ -0,0 +1,348 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sql
+
+import (
+	"reflect"
+)
+
+// Pushdown - Input formats that store data in blocks with per column
+// statistics (Parquet row groups and pages) can use the statement to
+// read only the referenced columns and to skip blocks that cannot
+// contain matching rows.
+//
+// Block skipping is conservative: a block is only skipped when every
+// row in it would evaluate the WHERE clause to false without error, so
+// the query output is unchanged.
+
+// ColumnRange - describes the values of a column in a block of rows.
+// HasNulls must be true unless the block is known not to contain NULL
+// values for the column.
+type ColumnRange struct {
+	Min, Max *Value
+	HasNulls bool
+}
+
+// ReferencedColumns - returns the names of the top level columns used by
+// the select expression and the WHERE clause. ok is false if all columns
+// are required, e.g. for `SELECT *`.
+func (e *SelectStatement) ReferencedColumns() (names []string, ok bool) {
+	if e.selectAST.Expression.All {
+		return nil, false
+	}
+
+	seen := make(map[string]struct{})
+	ok = true
+	collect := func(node interface{}) bool {
+		jpath, isPath := node.(*JSONPath)
+		if !isPath {
+			return ok
+		}
+		name, isColumn := jpath.columnName(e.tableAlias)
+		if !isColumn {
+			ok = false
+			return false
+		}
+		if _, found := seen[name]; !found {
+			seen[name] = struct{}{}
+			names = append(names, name)
+		}
+		return false
+	}
+
+	walkAST(e.selectAST.Expression, collect)
+	if e.selectAST.Where != nil {
+		walkAST(e.selectAST.Where, collect)
+	}
+
+	if !ok {
+		return nil, false
+	}
+	return names, true
+}
+
+// MayMatch - returns false if no row whose column values lie within the
+// given ranges can pass the WHERE clause. Columns missing from ranges
+// may take any value.
+func (e *SelectStatement) MayMatch(ranges map[string]ColumnRange) bool {
+	if e.selectAST.Where == nil || len(ranges) == 0 {
+		return true
+	}
+	return e.selectAST.Where.mayMatch(ranges, e.tableAlias)
+}
+
+func (e *Expression) mayMatch(ranges map[string]ColumnRange, tableAlias string) bool {
+	for _, ac := range e.And {
+		if ac.mayMatch(ranges, tableAlias) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *AndCondition) mayMatch(ranges map[string]ColumnRange, tableAlias string) bool {
+	for _, c := range e.Condition {
+		if !c.mayMatch(ranges, tableAlias) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *Condition) mayMatch(ranges map[string]ColumnRange, tableAlias string) bool {
+	if e.Not != nil {
+		return true
+	}
+	return e.Operand.mayMatch(ranges, tableAlias)
+}
+
+func (e *ConditionOperand) mayMatch(ranges map[string]ColumnRange, tableAlias string) bool {
+	if e.ConditionRHS == nil {
+		primary := e.Operand.primary()
+		switch {
+		case primary == nil:
+		case primary.SubExpression != nil:
+			return primary.SubExpression.mayMatch(ranges, tableAlias)
+		case primary.ListExpr != nil && len(primary.ListExpr.Elements) == 1:
+			// A parenthesized expression parses as a single
+			// element list.
+			return primary.ListExpr.Elements[0].mayMatch(ranges, tableAlias)
+		}
+		return true
+	}
+
+	switch {
+	case e.ConditionRHS.Compare != nil:
+		op := e.ConditionRHS.Compare.Operator
+		if colRange, ok := e.Operand.columnRange(ranges, tableAlias); ok {
+			if lit, ok := literalValue(e.ConditionRHS.Compare.Operand, tableAlias); ok {
+				return colRange.mayCompare(op, lit)
+			}
+		}
+		if colRange, ok := e.ConditionRHS.Compare.Operand.columnRange(ranges, tableAlias); ok {
+			if lit, ok := literalValue(e.Operand, tableAlias); ok {
+				return colRange.mayCompare(flipComparisonOperator(op), lit)
+			}
+		}
+
+	case e.ConditionRHS.Between != nil:
+		between := e.ConditionRHS.Between
+		colRange, ok := e.Operand.columnRange(ranges, tableAlias)
+		if !ok || between.Not || colRange.HasNulls {
+			return true
+		}
+		start, ok1 := literalValue(between.Start, tableAlias)
+		end, ok2 := literalValue(between.End, tableAlias)
+		if !ok1 || !ok2 {
+			return true
+		}
+		return colRange.mayCompare(opGte, start) && colRange.mayCompare(opLte, end)
+
+	case e.ConditionRHS.In != nil:
+		colRange, ok := e.Operand.columnRange(ranges, tableAlias)
+		if !ok {
+			return true
+		}
+		list, ok := literalValue(e.ConditionRHS.In.ListExpression, tableAlias)
+		if !ok {
+			return true
+		}
+		elements, isArray := list.ToArray()
+		if !isArray {
+			elements = []Value{*list}
+		}
+		for i := range elements {
+			if colRange.mayCompare(opEq, &elements[i]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+// mayCompare - returns true if any value in the range may satisfy
+// `value op lit`. Any comparison error is treated as a possible match.
+func (r ColumnRange) mayCompare(op string, lit *Value) bool {
+	cmp := func(v *Value, op string) (bool, bool) {
+		a, b := *v, *lit
+		res, err := a.compareOp(op, &b)
+		return res, err == nil
+	}
+
+	switch op {
+	case opEq:
+		// Equality on floats uses a tolerance, so check the
+		// bounds themselves before the strict comparisons.
+		if eq, ok := cmp(r.Min, opEq); !ok || eq {
+			return true
+		}
+		if eq, ok := cmp(r.Max, opEq); !ok || eq {
+			return true
+		}
+		above, ok1 := cmp(r.Min, opGt)
+		below, ok2 := cmp(r.Max, opLt)
+		return !ok1 || !ok2 || (!above && !below)
+
+	case opIneq:
+		if r.HasNulls {
+			return true
+		}
+		eqMin, ok1 := cmp(r.Min, opEq)
+		eqMax, ok2 := cmp(r.Max, opEq)
+		return !ok1 || !ok2 || !eqMin || !eqMax
+	}
+
+	// Ordering comparisons fail on NULL values, keep the block to
+	// preserve the error.
+	if r.HasNulls {
+		return true
+	}
+
+	var res, ok bool
+	switch op {
+	case opLt, opLte:
+		res, ok = cmp(r.Min, op)
+	case opGt, opGte:
+		res, ok = cmp(r.Max, op)
+	default:
+		return true
+	}
+	return !ok || res
+}
+
+func flipComparisonOperator(op string) string {
+	switch op {
+	case opLt:
+		return opGt
+	case opLte:
+		return opGte
+	case opGt:
+		return opLt
+	case opGte:
+		return opLte
+	}
+	return op
+}
+
+// primary - returns the primary term if the operand consists of a
+// single term.
+func (e *Operand) primary() *PrimaryTerm {
+	if len(e.Right) > 0 || len(e.Left.Right) > 0 || e.Left.Left.Primary == nil {
+		return nil
+	}
+	return e.Left.Left.Primary
+}
+
+// columnRange - returns the range of the column if the operand is a
+// plain column reference.
+func (e *Operand) columnRange(ranges map[string]ColumnRange, tableAlias string) (ColumnRange, bool) {
+	primary := e.primary()
+	if primary == nil || primary.JPathExpr == nil {
+		return ColumnRange{}, false
+	}
+	name, ok := primary.JPathExpr.columnName(tableAlias)
+	if !ok {
+		return ColumnRange{}, false
+	}
+	r, ok := ranges[name]
+	if !ok || r.Min == nil || r.Max == nil {
+		return ColumnRange{}, false
+	}
+	return r, true
+}
+
+// columnName - returns the top level column name referenced by the path.
+func (e *JSONPath) columnName(tableAlias string) (string, bool) {
+	if tableAlias == "" {
+		tableAlias = baseTableName
+	}
+	pathExpr := e.StripTableAlias(tableAlias)
+	if len(pathExpr) == 0 {
+		return e.BaseKey.String(), true
+	}
+	if pathExpr[0].Key == nil {
+		return "", false
+	}
+	return pathExpr[0].Key.keyString(), true
+}
+
+// literalValue - evaluates node if it does not depend on the input
+// record.
+func literalValue(node interface{}, tableAlias string) (*Value, bool) {
+	isLiteral := true
+	walkAST(node, func(n interface{}) bool {
+		switch n.(type) {
+		case *JSONPath, *FuncExpr:
+			isLiteral = false
+		}
+		return isLiteral
+	})
+	if !isLiteral {
+		return nil, false
+	}
+
+	var (
+		v   *Value
+		err error
+	)
+	switch n := node.(type) {
+	case *Operand:
+		v, err = n.evalNode(nil, tableAlias)
+	case *Expression:
+		v, err = n.evalNode(nil, tableAlias)
+	default:
+		return nil, false
+	}
+	if err != nil || v.IsNull() {
+		return nil, false
+	}
+	return v, true
+}
+
+// walkAST - calls fn for every AST node reachable from node, descending
+// into a node only while fn returns true.
+func walkAST(node interface{}, fn func(node interface{}) bool) {
+	walkValue(reflect.ValueOf(node), fn)
+}
+
+func walkValue(v reflect.Value, fn func(node interface{}) bool) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return
+		}
+		if !fn(v.Interface()) {
+			return
+		}
+		walkValue(v.Elem(), fn)
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			// Skip unexported fields, they hold cached values.
+			if t.Field(i).PkgPath != "" {
+				continue
+			}
+			walkValue(v.Field(i), fn)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			walkValue(v.Index(i), fn)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package smart
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Health contains the health attributes of a drive, that are polled
+// periodically. Attributes not reported by the drive are nil.
+type Health struct {
+	Device string `json:"device"`
+
+	// Temperature in degrees Celsius.
+	Temperature *int64 `json:"temperature,omitempty"`
+	// ReallocatedSectors is the number of sectors remapped to spare sectors.
+	ReallocatedSectors *uint64 `json:"reallocatedSectors,omitempty"`
+	// MediaErrors is the number of unrecovered read or write errors.
+	MediaErrors *uint64 `json:"mediaErrors,omitempty"`
+	// WearLevel is the percentage of the rated endurance used, it may exceed 100.
+	WearLevel *uint64 `json:"wearLevel,omitempty"`
+}
+
+// ATA SMART attribute IDs, as found in the drive database of smartmontools.
+const (
+	ataAttrReallocatedSectors    = 5
+	ataAttrWearLevelingCount     = 177
+	ataAttrReportedUncorrectable = 187
+	ataAttrAirflowTemperature    = 190
+	ataAttrTemperature           = 194
+	ataAttrOfflineUncorrectable  = 198
+	ataAttrSSDLifeLeft           = 231
+	ataAttrMediaWearout          = 233
+)
+
+// SCSI log pages and their parameters.
+const (
+	scsiLogPageReadErrors          = 0x03
+	scsiLogPageTemperature         = 0x0d
+	scsiLogParamTotalUncorrected   = 0x0006
+	scsiLogParamCurrentTemperature = 0x0000
+	scsiTemperatureNotAvailable    = 0xff
+)
+
+// Layout of the health data returned by the drives.
+const (
+	nvmeSMARTLogSize         = 512
+	ataSMARTDataSize         = 512
+	ataSMARTAttributesOffset = 2
+	ataSMARTAttributeSize    = 12
+	ataSMARTAttributeCount   = 30
+	scsiLogPageHeaderSize    = 4
+	scsiLogParamHeaderSize   = 4
+	scsiLogPageMaxSize       = 1024
+
+	kelvinToCelsius = 273
+)
+
+var errShortBuffer = errors.New("smart: buffer too short")
+
+func uint64Ptr(v uint64) *uint64 { return &v }
+func int64Ptr(v int64) *int64    { return &v }
+
+// le128ToUint64 converts a little-endian 128 bit counter,
+// saturating at the maximum of an uint64.
+func le128ToUint64(buf [16]byte) uint64 {
+	for _, b := range buf[8:] {
+		if b != 0 {
+			return ^uint64(0)
+		}
+	}
+	return binary.LittleEndian.Uint64(buf[:8])
+}
+
+// parseNvmeHealth parses the NVMe SMART / Health Information log page.
+func parseNvmeHealth(device string, buf []byte) (Health, error) {
+	h := Health{Device: device}
+	if len(buf) < nvmeSMARTLogSize {
+		return h, errShortBuffer
+	}
+
+	var sl nvmeSMARTLog
+	if err := binary.Read(bytes.NewReader(buf[:nvmeSMARTLogSize]), binary.LittleEndian, &sl); err != nil {
+		return h, err
+	}
+
+	// Composite temperature is reported in Kelvin.
+	if kelvin := uint16(sl.Temperature[1])<<8 | uint16(sl.Temperature[0]); kelvin != 0 {
+		h.Temperature = int64Ptr(int64(kelvin) - int64(kelvinToCelsius))
+	}
+	h.MediaErrors = uint64Ptr(le128ToUint64(sl.MediaErrors))
+	h.WearLevel = uint64Ptr(uint64(sl.PercentUsed))
+	return h, nil
+}
+
+// parseAtaHealth parses the data returned by ATA SMART READ DATA.
+func parseAtaHealth(device string, buf []byte) (Health, error) {
+	h := Health{Device: device}
+	if len(buf) < ataSMARTDataSize {
+		return h, errShortBuffer
+	}
+
+	type attr struct {
+		value uint8
+		raw   uint64
+	}
+	attrs := make(map[uint8]attr, ataSMARTAttributeCount)
+	for i := 0; i < ataSMARTAttributeCount; i++ {
+		b := buf[ataSMARTAttributesOffset+i*ataSMARTAttributeSize:]
+		if b[0] == 0 {
+			continue
+		}
+		var raw [8]byte
+		copy(raw[:], b[5:11])
+		attrs[b[0]] = attr{value: b[3], raw: binary.LittleEndian.Uint64(raw[:])}
+	}
+
+	if a, ok := attrs[ataAttrReallocatedSectors]; ok {
+		h.ReallocatedSectors = uint64Ptr(a.raw & 0xffff_ffff)
+	}
+	for _, id := range []uint8{ataAttrTemperature, ataAttrAirflowTemperature} {
+		if a, ok := attrs[id]; ok {
+			// The lowest byte is the current temperature.
+			h.Temperature = int64Ptr(int64(a.raw & 0xff))
+			break
+		}
+	}
+	for _, id := range []uint8{ataAttrReportedUncorrectable, ataAttrOfflineUncorrectable} {
+		if a, ok := attrs[id]; ok {
+			h.MediaErrors = uint64Ptr(a.raw & 0xffff_ffff)
+			break
+		}
+	}
+	// The normalized value of wear attributes counts down from 100.
+	for _, id := range []uint8{ataAttrMediaWearout, ataAttrWearLevelingCount, ataAttrSSDLifeLeft} {
+		if a, ok := attrs[id]; ok && a.value <= 100 {
+			h.WearLevel = uint64Ptr(uint64(100 - a.value))
+			break
+		}
+	}
+	return h, nil
+}
+
+// parseScsiLogPage parses the parameters of a page returned by SCSI LOG SENSE.
+func parseScsiLogPage(page uint8, buf []byte) (map[uint16][]byte, error) {
+	if len(buf) < scsiLogPageHeaderSize {
+		return nil, errShortBuffer
+	}
+	if got := buf[0] & 0x3f; got != page {
+		return nil, fmt.Errorf("smart: expected log page %#02x, got %#02x", page, got)
+	}
+	end := scsiLogPageHeaderSize + int(binary.BigEndian.Uint16(buf[2:4]))
+	if end > len(buf) {
+		return nil, errShortBuffer
+	}
+
+	params := make(map[uint16][]byte)
+	for off := scsiLogPageHeaderSize; off+scsiLogParamHeaderSize <= end; {
+		code := binary.BigEndian.Uint16(buf[off:])
+		n := int(buf[off+3])
+		off += scsiLogParamHeaderSize
+		if off+n > end {
+			return nil, errShortBuffer
+		}
+		params[code] = buf[off : off+n]
+		off += n
+	}
+	return params, nil
+}
+
+// parseScsiHealth parses the temperature and read error counter
+// log pages returned by SCSI LOG SENSE, either may be nil.
+func parseScsiHealth(device string, temperaturePage, readErrorsPage []byte) (Health, error) {
+	h := Health{Device: device}
+	if temperaturePage != nil {
+		params, err := parseScsiLogPage(scsiLogPageTemperature, temperaturePage)
+		if err != nil {
+			return h, err
+		}
+		if v := params[scsiLogParamCurrentTemperature]; len(v) >= 2 && v[1] != scsiTemperatureNotAvailable {
+			h.Temperature = int64Ptr(int64(v[1]))
+		}
+	}
+	if readErrorsPage != nil {
+		params, err := parseScsiLogPage(scsiLogPageReadErrors, readErrorsPage)
+		if err != nil {
+			return h, err
+		}
+		if v := params[scsiLogParamTotalUncorrected]; len(v) > 0 && len(v) <= 8 {
+			var counter [8]byte
+			copy(counter[8-len(v):], v)
+			h.MediaErrors = uint64Ptr(binary.BigEndian.Uint64(counter[:]))
+		}
+	}
+	return h, nil
+}
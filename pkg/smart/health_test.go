@@ -0,0 +1,120 @@
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package smart
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	buf, err := ioutil.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf
+}
+
+func healthString(t *testing.T, h Health) string {
+	t.Helper()
+	buf, err := json.Marshal(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(buf)
+}
+
+func TestParseHealth(t *testing.T) {
+	testCases := []struct {
+		name  string
+		parse func() (Health, error)
+		want  string
+	}{
+		{
+			name: "nvme",
+			parse: func() (Health, error) {
+				return parseNvmeHealth("/dev/nvme0n1", readFixture(t, "nvme-smart-log.bin"))
+			},
+			want: `{"device":"/dev/nvme0n1","temperature":43,"mediaErrors":3,"wearLevel":7}`,
+		},
+		{
+			name: "ata",
+			parse: func() (Health, error) {
+				return parseAtaHealth("/dev/sda", readFixture(t, "ata-smart-data.bin"))
+			},
+			want: `{"device":"/dev/sda","temperature":38,"reallocatedSectors":12,"mediaErrors":2,"wearLevel":7}`,
+		},
+		{
+			name: "scsi",
+			parse: func() (Health, error) {
+				return parseScsiHealth("/dev/sdb", readFixture(t, "scsi-log-temperature.bin"), readFixture(t, "scsi-log-read-errors.bin"))
+			},
+			want: `{"device":"/dev/sdb","temperature":41,"mediaErrors":5}`,
+		},
+		{
+			name: "scsi-no-read-errors",
+			parse: func() (Health, error) {
+				return parseScsiHealth("/dev/sdb", readFixture(t, "scsi-log-temperature.bin"), nil)
+			},
+			want: `{"device":"/dev/sdb","temperature":41}`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			h, err := testCase.parse()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := healthString(t, h); got != testCase.want {
+				t.Errorf("expected %s, got %s", testCase.want, got)
+			}
+		})
+	}
+}
+
+func TestParseHealthInvalid(t *testing.T) {
+	if _, err := parseNvmeHealth("/dev/nvme0n1", make([]byte, 64)); err == nil {
+		t.Error("expected an error for a short NVMe log page")
+	}
+	if _, err := parseAtaHealth("/dev/sda", make([]byte, 64)); err == nil {
+		t.Error("expected an error for short ATA SMART data")
+	}
+	// Pages must not be confused with each other.
+	if _, err := parseScsiHealth("/dev/sdb", readFixture(t, "scsi-log-read-errors.bin"), nil); err == nil {
+		t.Error("expected an error for an unexpected log page")
+	}
+	// The page length must fit in the buffer.
+	page := readFixture(t, "scsi-log-temperature.bin")
+	if _, err := parseScsiHealth("/dev/sdb", page[:len(page)-1], nil); err == nil {
+		t.Error("expected an error for a truncated log page")
+	}
+}
+
+func TestLe128ToUint64(t *testing.T) {
+	var buf [16]byte
+	buf[0], buf[1] = 0x01, 0x02
+	if v := le128ToUint64(buf); v != 0x0201 {
+		t.Errorf("expected 0x0201, got %#x", v)
+	}
+	buf[8] = 1
+	if v := le128ToUint64(buf); v != ^uint64(0) {
+		t.Errorf("expected the counter to saturate, got %#x", v)
+	}
+}
@@ -0,0 +1,150 @@
+//go:build linux
+// +build linux
+
+/*
+ * MinIO Cloud Storage, (C) 2021 MinIO, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * This file has been adopted and then modified from Daniel Swarbrick's smart
+ * project residing at https://github.com/dswarbrick/smart
+ *
+ */
+
+package smart
+
+import (
+	"encoding/binary"
+	"fmt"
+	"unsafe"
+
+	"github.com/dswarbrick/smart/ioctl"
+	"github.com/dswarbrick/smart/scsi"
+	"golang.org/x/sys/unix"
+)
+
+// SCSI commands used to read the health of SCSI and SATA drives.
+const (
+	scsiLogSense       = 0x4d
+	scsiATAPassThru16  = 0x85
+	ataSMART           = 0xb0
+	ataSMARTReadData   = 0xd0
+	sgTimeoutMillis    = 20000
+	sgInfoOkMask       = 0x1
+	sgSenseBufferBytes = 32
+)
+
+// SCSI generic ioctl header, defined as sg_io_hdr_t in <scsi/sg.h>
+//
+//nolint:structcheck,deadcode
+type sgIoHdr struct {
+	interfaceID    int32
+	dxferDirection int32
+	cmdLen         uint8
+	mxSbLen        uint8
+	iovecCount     uint16
+	dxferLen       uint32
+	dxferp         uintptr
+	cmdp           uintptr
+	sbp            uintptr
+	timeout        uint32
+	flags          uint32
+	packID         int32
+	usrPtr         uintptr
+	status         uint8
+	maskedStatus   uint8
+	msgStatus      uint8
+	sbLenWr        uint8
+	hostStatus     uint16
+	driverStatus   uint16
+	resid          int32
+	duration       uint32
+	info           uint32
+}
+
+// sgDevice sends SCSI commands to a drive using the SG_IO ioctl.
+type sgDevice struct {
+	name string
+	fd   int
+}
+
+func openSGDevice(name string) (*sgDevice, error) {
+	fd, err := unix.Open(name, unix.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &sgDevice{name: name, fd: fd}, nil
+}
+
+func (d *sgDevice) Close() error {
+	return unix.Close(d.fd)
+}
+
+// sendCDB sends a SCSI Command Descriptor Block to the device and
+// reads the response into buf.
+func (d *sgDevice) sendCDB(cdb []byte, buf []byte) error {
+	senseBuf := make([]byte, sgSenseBufferBytes)
+	hdr := sgIoHdr{
+		interfaceID:    'S',
+		dxferDirection: scsi.SG_DXFER_FROM_DEV,
+		timeout:        sgTimeoutMillis,
+		cmdLen:         uint8(len(cdb)),
+		mxSbLen:        uint8(len(senseBuf)),
+		dxferLen:       uint32(len(buf)),
+		dxferp:         uintptr(unsafe.Pointer(&buf[0])),
+		cmdp:           uintptr(unsafe.Pointer(&cdb[0])),
+		sbp:            uintptr(unsafe.Pointer(&senseBuf[0])),
+	}
+	if err := ioctl.Ioctl(uintptr(d.fd), scsi.SG_IO, uintptr(unsafe.Pointer(&hdr))); err != nil {
+		return err
+	}
+	if hdr.info&sgInfoOkMask != 0 {
+		return fmt.Errorf("SCSI status: %#02x, host status: %#02x, driver status: %#02x",
+			hdr.status, hdr.hostStatus, hdr.driverStatus)
+	}
+	return nil
+}
+
+// ataSMARTData reads the SMART attributes of a SATA drive
+// with an ATA PASS-THROUGH (16) command.
+func (d *sgDevice) ataSMARTData() ([]byte, error) {
+	buf := make([]byte, ataSMARTDataSize)
+	var cdb [16]byte
+	cdb[0] = scsiATAPassThru16
+	cdb[1] = 0x08 // PIO data-in
+	cdb[2] = 0x0e // T_DIR = from device, BYT_BLOK = 1, T_LENGTH = sector count
+	cdb[4] = ataSMARTReadData
+	cdb[6] = 0x01 // one sector
+	cdb[10] = 0x4f
+	cdb[12] = 0xc2
+	cdb[14] = ataSMART
+	if err := d.sendCDB(cdb[:], buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// scsiLogPage reads a log page of a SCSI drive with a LOG SENSE command.
+func (d *sgDevice) scsiLogPage(page uint8) ([]byte, error) {
+	buf := make([]byte, scsiLogPageMaxSize)
+	var cdb [10]byte
+	cdb[0] = scsiLogSense
+	cdb[2] = 0x40 | page&0x3f // Current cumulative values
+	binary.BigEndian.PutUint16(cdb[7:], uint16(len(buf)))
+	if err := d.sendCDB(cdb[:], buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
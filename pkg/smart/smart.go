@@ -152,3 +152,55 @@ func getScsiInfo(d *scsi.SCSIDevice) (*ScsiInfo, error) {
 func getAtaInfo(d *scsi.SATDevice) (*AtaInfo, error) {
 	return &AtaInfo{}, nil
 }
+
+// GetHealth - gets the health attributes of a device, unlike GetInfo
+// it is cheap enough to be polled periodically.
+func GetHealth(device string) (Health, error) {
+	if strings.HasPrefix(device, "/dev/nvme") {
+		d := NewNVMeDevice(device)
+		if err := d.Open(); err != nil {
+			return Health{Device: device}, err
+		}
+		defer d.Close()
+
+		buf := make([]byte, nvmeSMARTLogSize)
+		if err := d.readLogPage(0x02, &buf); err != nil {
+			return Health{Device: device}, err
+		}
+		return parseNvmeHealth(device, buf)
+	}
+
+	d, err := scsi.OpenSCSIAutodetect(device)
+	if err != nil {
+		return Health{Device: device}, err
+	}
+	d.Close()
+
+	sg, err := openSGDevice(device)
+	if err != nil {
+		return Health{Device: device}, err
+	}
+	defer sg.Close()
+
+	if _, ok := d.(*scsi.SATDevice); ok {
+		buf, err := sg.ataSMARTData()
+		if err != nil {
+			return Health{Device: device}, err
+		}
+		return parseAtaHealth(device, buf)
+	}
+
+	// Not all SCSI drives support both log pages.
+	temperaturePage, err := sg.scsiLogPage(scsiLogPageTemperature)
+	if err != nil {
+		temperaturePage = nil
+	}
+	readErrorsPage, err := sg.scsiLogPage(scsiLogPageReadErrors)
+	if err != nil {
+		readErrorsPage = nil
+	}
+	if temperaturePage == nil && readErrorsPage == nil {
+		return Health{Device: device}, err
+	}
+	return parseScsiHealth(device, temperaturePage, readErrorsPage)
+}